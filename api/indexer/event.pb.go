@@ -37,6 +37,11 @@ const (
 	Operate_ProxyUnStake        Operate = 10
 	Operate_Modify              Operate = 11
 	Operate_ClaimAirdrop        Operate = 12
+	Operate_Burn                Operate = 13
+	Operate_Approve             Operate = 14
+	Operate_TransferFrom        Operate = 15
+	Operate_Vest                Operate = 16
+	Operate_ClaimVested         Operate = 17
 )
 
 // Enum value maps for Operate.
@@ -55,6 +60,11 @@ var (
 		10: "ProxyUnStake",
 		11: "Modify",
 		12: "ClaimAirdrop",
+		13: "Burn",
+		14: "Approve",
+		15: "TransferFrom",
+		16: "Vest",
+		17: "ClaimVested",
 	}
 	Operate_value = map[string]int32{
 		"OPERATE_UNSPECIFIED": 0,
@@ -70,6 +80,11 @@ var (
 		"ProxyUnStake":        10,
 		"Modify":              11,
 		"ClaimAirdrop":        12,
+		"Burn":                13,
+		"Approve":             14,
+		"TransferFrom":        15,
+		"Vest":                16,
+		"ClaimVested":         17,
 	}
 )
 
@@ -789,6 +804,409 @@ func (x *TickTransferred) GetSign() string {
 	return ""
 }
 
+// IERC20 Tick 销毁事件
+type IERC20Burned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 协议类型. 冗余字段
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// 操作类型
+	Operate Operate `protobuf:"varint,2,opt,name=operate,proto3,enum=api.indexer.Operate" json:"operate,omitempty"`
+	// ierc20 tick
+	Tick string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 销毁者. ETH地址
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// 销毁数量. 浮点字符串
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *IERC20Burned) Reset() {
+	*x = IERC20Burned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_event_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERC20Burned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERC20Burned) ProtoMessage() {}
+
+func (x *IERC20Burned) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_event_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERC20Burned.ProtoReflect.Descriptor instead.
+func (*IERC20Burned) Descriptor() ([]byte, []int) {
+	return file_indexer_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IERC20Burned) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *IERC20Burned) GetOperate() Operate {
+	if x != nil {
+		return x.Operate
+	}
+	return Operate_OPERATE_UNSPECIFIED
+}
+
+func (x *IERC20Burned) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERC20Burned) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *IERC20Burned) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// IERC20 Tick 授权事件
+type IERC20Approved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 协议类型. 冗余字段
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// 操作类型
+	Operate Operate `protobuf:"varint,2,opt,name=operate,proto3,enum=api.indexer.Operate" json:"operate,omitempty"`
+	// ierc20 tick
+	Tick string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 资产所有者. ETH地址
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// 被授权者. ETH地址
+	Spender string `protobuf:"bytes,5,opt,name=spender,proto3" json:"spender,omitempty"`
+	// 授权额度. 浮点字符串
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 签名nonce. 链上授权时为空
+	SignNonce string `protobuf:"bytes,7,opt,name=sign_nonce,json=signNonce,proto3" json:"sign_nonce,omitempty"`
+	// 签名. 链上授权时为空
+	Sign string `protobuf:"bytes,8,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *IERC20Approved) Reset() {
+	*x = IERC20Approved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_event_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERC20Approved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERC20Approved) ProtoMessage() {}
+
+func (x *IERC20Approved) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_event_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERC20Approved.ProtoReflect.Descriptor instead.
+func (*IERC20Approved) Descriptor() ([]byte, []int) {
+	return file_indexer_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IERC20Approved) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *IERC20Approved) GetOperate() Operate {
+	if x != nil {
+		return x.Operate
+	}
+	return Operate_OPERATE_UNSPECIFIED
+}
+
+func (x *IERC20Approved) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERC20Approved) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *IERC20Approved) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *IERC20Approved) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *IERC20Approved) GetSignNonce() string {
+	if x != nil {
+		return x.SignNonce
+	}
+	return ""
+}
+
+func (x *IERC20Approved) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+// IERC20 锁仓创建事件
+type IERC20VestingCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 协议类型. 冗余字段
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// 操作类型
+	Operate Operate `protobuf:"varint,2,opt,name=operate,proto3,enum=api.indexer.Operate" json:"operate,omitempty"`
+	// ierc20 tick
+	Tick string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 锁仓发起人. ETH地址
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// 接收者. ETH地址
+	To string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// 锁仓数量. 浮点字符串
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 开始释放区块
+	StartBlock uint64 `protobuf:"varint,7,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// 锁定期结束区块, 之前不释放
+	CliffBlock uint64 `protobuf:"varint,8,opt,name=cliff_block,json=cliffBlock,proto3" json:"cliff_block,omitempty"`
+	// 全部释放区块
+	EndBlock uint64 `protobuf:"varint,9,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (x *IERC20VestingCreated) Reset() {
+	*x = IERC20VestingCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_event_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERC20VestingCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERC20VestingCreated) ProtoMessage() {}
+
+func (x *IERC20VestingCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_event_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERC20VestingCreated.ProtoReflect.Descriptor instead.
+func (*IERC20VestingCreated) Descriptor() ([]byte, []int) {
+	return file_indexer_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IERC20VestingCreated) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *IERC20VestingCreated) GetOperate() Operate {
+	if x != nil {
+		return x.Operate
+	}
+	return Operate_OPERATE_UNSPECIFIED
+}
+
+func (x *IERC20VestingCreated) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERC20VestingCreated) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *IERC20VestingCreated) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *IERC20VestingCreated) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *IERC20VestingCreated) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *IERC20VestingCreated) GetCliffBlock() uint64 {
+	if x != nil {
+		return x.CliffBlock
+	}
+	return 0
+}
+
+func (x *IERC20VestingCreated) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+// IERC20 锁仓领取事件
+type IERC20VestingClaimed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 协议类型. 冗余字段
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// 操作类型
+	Operate Operate `protobuf:"varint,2,opt,name=operate,proto3,enum=api.indexer.Operate" json:"operate,omitempty"`
+	// ierc20 tick
+	Tick string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 接收者. ETH地址
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// 本次领取数量. 浮点字符串
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *IERC20VestingClaimed) Reset() {
+	*x = IERC20VestingClaimed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_event_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IERC20VestingClaimed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IERC20VestingClaimed) ProtoMessage() {}
+
+func (x *IERC20VestingClaimed) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_event_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IERC20VestingClaimed.ProtoReflect.Descriptor instead.
+func (*IERC20VestingClaimed) Descriptor() ([]byte, []int) {
+	return file_indexer_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IERC20VestingClaimed) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *IERC20VestingClaimed) GetOperate() Operate {
+	if x != nil {
+		return x.Operate
+	}
+	return Operate_OPERATE_UNSPECIFIED
+}
+
+func (x *IERC20VestingClaimed) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *IERC20VestingClaimed) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *IERC20VestingClaimed) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 type StakingPoolUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -821,7 +1239,7 @@ type StakingPoolUpdated struct {
 func (x *StakingPoolUpdated) Reset() {
 	*x = StakingPoolUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_event_proto_msgTypes[5]
+		mi := &file_indexer_event_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -834,7 +1252,7 @@ func (x *StakingPoolUpdated) String() string {
 func (*StakingPoolUpdated) ProtoMessage() {}
 
 func (x *StakingPoolUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_event_proto_msgTypes[5]
+	mi := &file_indexer_event_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +1265,7 @@ func (x *StakingPoolUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakingPoolUpdated.ProtoReflect.Descriptor instead.
 func (*StakingPoolUpdated) Descriptor() ([]byte, []int) {
-	return file_indexer_event_proto_rawDescGZIP(), []int{5}
+	return file_indexer_event_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StakingPoolUpdated) GetProtocol() string {
@@ -959,13 +1377,17 @@ type Event struct {
 	//	*Event_PowMinted
 	//	*Event_TickTransferred
 	//	*Event_PoolUpdated
+	//	*Event_TickBurned
+	//	*Event_TickApproved
+	//	*Event_VestingCreated
+	//	*Event_VestingClaimed
 	Event isEvent_Event `protobuf_oneof:"event"`
 }
 
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_event_proto_msgTypes[6]
+		mi := &file_indexer_event_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -978,7 +1400,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_event_proto_msgTypes[6]
+	mi := &file_indexer_event_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,7 +1413,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_indexer_event_proto_rawDescGZIP(), []int{6}
+	return file_indexer_event_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Event) GetBlockNumber() uint64 {
@@ -1106,6 +1528,34 @@ func (x *Event) GetPoolUpdated() *StakingPoolUpdated {
 	return nil
 }
 
+func (x *Event) GetTickBurned() *IERC20Burned {
+	if x, ok := x.GetEvent().(*Event_TickBurned); ok {
+		return x.TickBurned
+	}
+	return nil
+}
+
+func (x *Event) GetTickApproved() *IERC20Approved {
+	if x, ok := x.GetEvent().(*Event_TickApproved); ok {
+		return x.TickApproved
+	}
+	return nil
+}
+
+func (x *Event) GetVestingCreated() *IERC20VestingCreated {
+	if x, ok := x.GetEvent().(*Event_VestingCreated); ok {
+		return x.VestingCreated
+	}
+	return nil
+}
+
+func (x *Event) GetVestingClaimed() *IERC20VestingClaimed {
+	if x, ok := x.GetEvent().(*Event_VestingClaimed); ok {
+		return x.VestingClaimed
+	}
+	return nil
+}
+
 type isEvent_Event interface {
 	isEvent_Event()
 }
@@ -1138,6 +1588,25 @@ type Event_PoolUpdated struct {
 	PoolUpdated *StakingPoolUpdated `protobuf:"bytes,25,opt,name=pool_updated,json=poolUpdated,proto3,oneof"`
 }
 
+type Event_TickBurned struct {
+	// ierc20 burn
+	TickBurned *IERC20Burned `protobuf:"bytes,26,opt,name=tick_burned,json=tickBurned,proto3,oneof"`
+}
+
+type Event_TickApproved struct {
+	// ierc20 approve
+	TickApproved *IERC20Approved `protobuf:"bytes,27,opt,name=tick_approved,json=tickApproved,proto3,oneof"`
+}
+
+type Event_VestingCreated struct {
+	// ierc20 vesting
+	VestingCreated *IERC20VestingCreated `protobuf:"bytes,28,opt,name=vesting_created,json=vestingCreated,proto3,oneof"`
+}
+
+type Event_VestingClaimed struct {
+	VestingClaimed *IERC20VestingClaimed `protobuf:"bytes,29,opt,name=vesting_claimed,json=vestingClaimed,proto3,oneof"`
+}
+
 func (*Event_TickCreated) isEvent_Event() {}
 
 func (*Event_Minted) isEvent_Event() {}
@@ -1150,6 +1619,14 @@ func (*Event_TickTransferred) isEvent_Event() {}
 
 func (*Event_PoolUpdated) isEvent_Event() {}
 
+func (*Event_TickBurned) isEvent_Event() {}
+
+func (*Event_TickApproved) isEvent_Event() {}
+
+func (*Event_VestingCreated) isEvent_Event() {}
+
+func (*Event_VestingClaimed) isEvent_Event() {}
+
 type IERCPoWTickCreated_TokenomicsDetail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1162,7 +1639,7 @@ type IERCPoWTickCreated_TokenomicsDetail struct {
 func (x *IERCPoWTickCreated_TokenomicsDetail) Reset() {
 	*x = IERCPoWTickCreated_TokenomicsDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_event_proto_msgTypes[7]
+		mi := &file_indexer_event_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1175,7 +1652,7 @@ func (x *IERCPoWTickCreated_TokenomicsDetail) String() string {
 func (*IERCPoWTickCreated_TokenomicsDetail) ProtoMessage() {}
 
 func (x *IERCPoWTickCreated_TokenomicsDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_event_proto_msgTypes[7]
+	mi := &file_indexer_event_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +1697,7 @@ type IERCPoWTickCreated_Rule struct {
 func (x *IERCPoWTickCreated_Rule) Reset() {
 	*x = IERCPoWTickCreated_Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_event_proto_msgTypes[8]
+		mi := &file_indexer_event_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1233,7 +1710,7 @@ func (x *IERCPoWTickCreated_Rule) String() string {
 func (*IERCPoWTickCreated_Rule) ProtoMessage() {}
 
 func (x *IERCPoWTickCreated_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_event_proto_msgTypes[8]
+	mi := &file_indexer_event_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,7 +1774,7 @@ type StakingPoolUpdated_TickConfigDetail struct {
 func (x *StakingPoolUpdated_TickConfigDetail) Reset() {
 	*x = StakingPoolUpdated_TickConfigDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_event_proto_msgTypes[9]
+		mi := &file_indexer_event_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1310,7 +1787,7 @@ func (x *StakingPoolUpdated_TickConfigDetail) String() string {
 func (*StakingPoolUpdated_TickConfigDetail) ProtoMessage() {}
 
 func (x *StakingPoolUpdated_TickConfigDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_event_proto_msgTypes[9]
+	mi := &file_indexer_event_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,7 +1800,7 @@ func (x *StakingPoolUpdated_TickConfigDetail) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use StakingPoolUpdated_TickConfigDetail.ProtoReflect.Descriptor instead.
 func (*StakingPoolUpdated_TickConfigDetail) Descriptor() ([]byte, []int) {
-	return file_indexer_event_proto_rawDescGZIP(), []int{5, 0}
+	return file_indexer_event_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *StakingPoolUpdated_TickConfigDetail) GetTick() string {
@@ -1481,7 +1958,59 @@ var file_indexer_event_proto_rawDesc = []byte{
 	0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x6f,
 	0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65,
 	0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0xbb, 0x03, 0x0a, 0x12, 0x53,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x49,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x07,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x0e, 0x49, 0x45, 0x52, 0x43,
+	0x32, 0x30, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x07, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x91, 0x02, 0x0a, 0x14, 0x49, 0x45, 0x52, 0x43, 0x32, 0x30,
+	0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6c, 0x69, 0x66, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9e, 0x01, 0x0a, 0x14, 0x49, 0x45,
+	0x52, 0x43, 0x32, 0x30, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2e,
+	0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x03, 0x0a, 0x12, 0x53,
 	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2e, 0x0a,
@@ -1509,7 +2038,7 @@ var file_indexer_event_proto_rawDesc = []byte{
 	0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
 	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
-	0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x97, 0x05, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb5, 0x07, 0x0a, 0x05, 0x45, 0x76, 0x65,
 	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
 	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
 	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
@@ -1550,26 +2079,48 @@ var file_indexer_event_proto_rawDesc = []byte{
 	0x74, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50,
 	0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6f,
-	0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x2a, 0xd4, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x17,
-	0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x0c, 0x0a,
-	0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x46,
-	0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x10, 0x05, 0x12, 0x11, 0x0a,
-	0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x06,
-	0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10,
-	0x07, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x55, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x79, 0x10, 0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x69, 0x6d,
-	0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x10, 0x0c, 0x42, 0x44, 0x0a, 0x0b, 0x61, 0x70, 0x69,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x49, 0x45, 0x72, 0x63, 0x4f, 0x72, 0x67, 0x2f, 0x49,
-	0x45, 0x52, 0x43, 0x5f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x69, 0x63,
+	0x6b, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52,
+	0x43, 0x32, 0x30, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69, 0x63,
+	0x6b, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x5f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x45, 0x52,
+	0x43, 0x32, 0x30, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x74,
+	0x69, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x49, 0x45, 0x52, 0x43, 0x32, 0x30, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x49, 0x45, 0x52, 0x43, 0x32, 0x30, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x2a, 0x98, 0x02, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x06, 0x12, 0x0f,
+	0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x07, 0x12,
+	0x09, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x55, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x10, 0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x69,
+	0x72, 0x64, 0x72, 0x6f, 0x70, 0x10, 0x0c, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x75, 0x72, 0x6e, 0x10,
+	0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x10, 0x0e, 0x12, 0x10,
+	0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x10, 0x0f,
+	0x12, 0x08, 0x0a, 0x04, 0x56, 0x65, 0x73, 0x74, 0x10, 0x10, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x56, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x11, 0x42, 0x46, 0x0a, 0x0b, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x65, 0x76, 0x69, 0x6e, 0x38, 0x38,
+	0x38, 0x38, 0x36, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x3b, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1585,7 +2136,7 @@ func file_indexer_event_proto_rawDescGZIP() []byte {
 }
 
 var file_indexer_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_indexer_event_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_indexer_event_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_indexer_event_proto_goTypes = []interface{}{
 	(Operate)(0),                                // 0: api.indexer.Operate
 	(*IERC20TickCreated)(nil),                   // 1: api.indexer.IERC20TickCreated
@@ -1593,33 +2144,45 @@ var file_indexer_event_proto_goTypes = []interface{}{
 	(*IERCPoWTickCreated)(nil),                  // 3: api.indexer.IERCPoWTickCreated
 	(*IERCPoWMinted)(nil),                       // 4: api.indexer.IERCPoWMinted
 	(*TickTransferred)(nil),                     // 5: api.indexer.TickTransferred
-	(*StakingPoolUpdated)(nil),                  // 6: api.indexer.StakingPoolUpdated
-	(*Event)(nil),                               // 7: api.indexer.Event
-	(*IERCPoWTickCreated_TokenomicsDetail)(nil), // 8: api.indexer.IERCPoWTickCreated.TokenomicsDetail
-	(*IERCPoWTickCreated_Rule)(nil),             // 9: api.indexer.IERCPoWTickCreated.Rule
-	(*StakingPoolUpdated_TickConfigDetail)(nil), // 10: api.indexer.StakingPoolUpdated.TickConfigDetail
+	(*IERC20Burned)(nil),                        // 6: api.indexer.IERC20Burned
+	(*IERC20Approved)(nil),                      // 7: api.indexer.IERC20Approved
+	(*IERC20VestingCreated)(nil),                // 8: api.indexer.IERC20VestingCreated
+	(*IERC20VestingClaimed)(nil),                // 9: api.indexer.IERC20VestingClaimed
+	(*StakingPoolUpdated)(nil),                  // 10: api.indexer.StakingPoolUpdated
+	(*Event)(nil),                               // 11: api.indexer.Event
+	(*IERCPoWTickCreated_TokenomicsDetail)(nil), // 12: api.indexer.IERCPoWTickCreated.TokenomicsDetail
+	(*IERCPoWTickCreated_Rule)(nil),             // 13: api.indexer.IERCPoWTickCreated.Rule
+	(*StakingPoolUpdated_TickConfigDetail)(nil), // 14: api.indexer.StakingPoolUpdated.TickConfigDetail
 }
 var file_indexer_event_proto_depIdxs = []int32{
 	0,  // 0: api.indexer.IERC20TickCreated.operate:type_name -> api.indexer.Operate
 	0,  // 1: api.indexer.IERC20Minted.operate:type_name -> api.indexer.Operate
 	0,  // 2: api.indexer.IERCPoWTickCreated.operate:type_name -> api.indexer.Operate
-	8,  // 3: api.indexer.IERCPoWTickCreated.tokenomics_details:type_name -> api.indexer.IERCPoWTickCreated.TokenomicsDetail
-	9,  // 4: api.indexer.IERCPoWTickCreated.rule:type_name -> api.indexer.IERCPoWTickCreated.Rule
+	12, // 3: api.indexer.IERCPoWTickCreated.tokenomics_details:type_name -> api.indexer.IERCPoWTickCreated.TokenomicsDetail
+	13, // 4: api.indexer.IERCPoWTickCreated.rule:type_name -> api.indexer.IERCPoWTickCreated.Rule
 	0,  // 5: api.indexer.IERCPoWMinted.operate:type_name -> api.indexer.Operate
 	0,  // 6: api.indexer.TickTransferred.operate:type_name -> api.indexer.Operate
-	0,  // 7: api.indexer.StakingPoolUpdated.operate:type_name -> api.indexer.Operate
-	10, // 8: api.indexer.StakingPoolUpdated.details:type_name -> api.indexer.StakingPoolUpdated.TickConfigDetail
-	1,  // 9: api.indexer.Event.tick_created:type_name -> api.indexer.IERC20TickCreated
-	2,  // 10: api.indexer.Event.minted:type_name -> api.indexer.IERC20Minted
-	3,  // 11: api.indexer.Event.pow_tick_created:type_name -> api.indexer.IERCPoWTickCreated
-	4,  // 12: api.indexer.Event.pow_minted:type_name -> api.indexer.IERCPoWMinted
-	5,  // 13: api.indexer.Event.tick_transferred:type_name -> api.indexer.TickTransferred
-	6,  // 14: api.indexer.Event.pool_updated:type_name -> api.indexer.StakingPoolUpdated
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	0,  // 7: api.indexer.IERC20Burned.operate:type_name -> api.indexer.Operate
+	0,  // 8: api.indexer.IERC20Approved.operate:type_name -> api.indexer.Operate
+	0,  // 9: api.indexer.IERC20VestingCreated.operate:type_name -> api.indexer.Operate
+	0,  // 10: api.indexer.IERC20VestingClaimed.operate:type_name -> api.indexer.Operate
+	0,  // 11: api.indexer.StakingPoolUpdated.operate:type_name -> api.indexer.Operate
+	14, // 12: api.indexer.StakingPoolUpdated.details:type_name -> api.indexer.StakingPoolUpdated.TickConfigDetail
+	1,  // 13: api.indexer.Event.tick_created:type_name -> api.indexer.IERC20TickCreated
+	2,  // 14: api.indexer.Event.minted:type_name -> api.indexer.IERC20Minted
+	3,  // 15: api.indexer.Event.pow_tick_created:type_name -> api.indexer.IERCPoWTickCreated
+	4,  // 16: api.indexer.Event.pow_minted:type_name -> api.indexer.IERCPoWMinted
+	5,  // 17: api.indexer.Event.tick_transferred:type_name -> api.indexer.TickTransferred
+	10, // 18: api.indexer.Event.pool_updated:type_name -> api.indexer.StakingPoolUpdated
+	6,  // 19: api.indexer.Event.tick_burned:type_name -> api.indexer.IERC20Burned
+	7,  // 20: api.indexer.Event.tick_approved:type_name -> api.indexer.IERC20Approved
+	8,  // 21: api.indexer.Event.vesting_created:type_name -> api.indexer.IERC20VestingCreated
+	9,  // 22: api.indexer.Event.vesting_claimed:type_name -> api.indexer.IERC20VestingClaimed
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_indexer_event_proto_init() }
@@ -1689,7 +2252,7 @@ func file_indexer_event_proto_init() {
 			}
 		}
 		file_indexer_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StakingPoolUpdated); i {
+			switch v := v.(*IERC20Burned); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1701,7 +2264,7 @@ func file_indexer_event_proto_init() {
 			}
 		}
 		file_indexer_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+			switch v := v.(*IERC20Approved); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1713,7 +2276,7 @@ func file_indexer_event_proto_init() {
 			}
 		}
 		file_indexer_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IERCPoWTickCreated_TokenomicsDetail); i {
+			switch v := v.(*IERC20VestingCreated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1725,7 +2288,7 @@ func file_indexer_event_proto_init() {
 			}
 		}
 		file_indexer_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IERCPoWTickCreated_Rule); i {
+			switch v := v.(*IERC20VestingClaimed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1737,6 +2300,54 @@ func file_indexer_event_proto_init() {
 			}
 		}
 		file_indexer_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingPoolUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCPoWTickCreated_TokenomicsDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IERCPoWTickCreated_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_event_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StakingPoolUpdated_TickConfigDetail); i {
 			case 0:
 				return &v.state
@@ -1749,13 +2360,17 @@ func file_indexer_event_proto_init() {
 			}
 		}
 	}
-	file_indexer_event_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_indexer_event_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*Event_TickCreated)(nil),
 		(*Event_Minted)(nil),
 		(*Event_PowTickCreated)(nil),
 		(*Event_PowMinted)(nil),
 		(*Event_TickTransferred)(nil),
 		(*Event_PoolUpdated)(nil),
+		(*Event_TickBurned)(nil),
+		(*Event_TickApproved)(nil),
+		(*Event_VestingCreated)(nil),
+		(*Event_VestingClaimed)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1763,7 +2378,7 @@ func file_indexer_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_indexer_event_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
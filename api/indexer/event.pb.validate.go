@@ -709,6 +709,463 @@ var _ interface {
 	ErrorName() string
 } = TickTransferredValidationError{}
 
+// Validate checks the field values on IERC20Burned with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IERC20Burned) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERC20Burned with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IERC20BurnedMultiError, or
+// nil if none found.
+func (m *IERC20Burned) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERC20Burned) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Operate
+
+	// no validation rules for Tick
+
+	// no validation rules for From
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return IERC20BurnedMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERC20BurnedMultiError is an error wrapping multiple validation errors
+// returned by IERC20Burned.ValidateAll() if the designated constraints aren't met.
+type IERC20BurnedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERC20BurnedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERC20BurnedMultiError) AllErrors() []error { return m }
+
+// IERC20BurnedValidationError is the validation error returned by
+// IERC20Burned.Validate if the designated constraints aren't met.
+type IERC20BurnedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERC20BurnedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERC20BurnedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERC20BurnedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERC20BurnedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERC20BurnedValidationError) ErrorName() string { return "IERC20BurnedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IERC20BurnedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERC20Burned.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERC20BurnedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERC20BurnedValidationError{}
+
+// Validate checks the field values on IERC20Approved with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IERC20Approved) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERC20Approved with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IERC20ApprovedMultiError,
+// or nil if none found.
+func (m *IERC20Approved) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERC20Approved) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Operate
+
+	// no validation rules for Tick
+
+	// no validation rules for Owner
+
+	// no validation rules for Spender
+
+	// no validation rules for Amount
+
+	// no validation rules for SignNonce
+
+	// no validation rules for Sign
+
+	if len(errors) > 0 {
+		return IERC20ApprovedMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERC20ApprovedMultiError is an error wrapping multiple validation errors
+// returned by IERC20Approved.ValidateAll() if the designated constraints
+// aren't met.
+type IERC20ApprovedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERC20ApprovedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERC20ApprovedMultiError) AllErrors() []error { return m }
+
+// IERC20ApprovedValidationError is the validation error returned by
+// IERC20Approved.Validate if the designated constraints aren't met.
+type IERC20ApprovedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERC20ApprovedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERC20ApprovedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERC20ApprovedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERC20ApprovedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERC20ApprovedValidationError) ErrorName() string { return "IERC20ApprovedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IERC20ApprovedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERC20Approved.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERC20ApprovedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERC20ApprovedValidationError{}
+
+// Validate checks the field values on IERC20VestingCreated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERC20VestingCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERC20VestingCreated with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERC20VestingCreatedMultiError, or nil if none found.
+func (m *IERC20VestingCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERC20VestingCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Operate
+
+	// no validation rules for Tick
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Amount
+
+	// no validation rules for StartBlock
+
+	// no validation rules for CliffBlock
+
+	// no validation rules for EndBlock
+
+	if len(errors) > 0 {
+		return IERC20VestingCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERC20VestingCreatedMultiError is an error wrapping multiple validation
+// errors returned by IERC20VestingCreated.ValidateAll() if the designated
+// constraints aren't met.
+type IERC20VestingCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERC20VestingCreatedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERC20VestingCreatedMultiError) AllErrors() []error { return m }
+
+// IERC20VestingCreatedValidationError is the validation error returned by
+// IERC20VestingCreated.Validate if the designated constraints aren't met.
+type IERC20VestingCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERC20VestingCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERC20VestingCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERC20VestingCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERC20VestingCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERC20VestingCreatedValidationError) ErrorName() string {
+	return "IERC20VestingCreatedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERC20VestingCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERC20VestingCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERC20VestingCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERC20VestingCreatedValidationError{}
+
+// Validate checks the field values on IERC20VestingClaimed with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IERC20VestingClaimed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IERC20VestingClaimed with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IERC20VestingClaimedMultiError, or nil if none found.
+func (m *IERC20VestingClaimed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IERC20VestingClaimed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Operate
+
+	// no validation rules for Tick
+
+	// no validation rules for To
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return IERC20VestingClaimedMultiError(errors)
+	}
+
+	return nil
+}
+
+// IERC20VestingClaimedMultiError is an error wrapping multiple validation
+// errors returned by IERC20VestingClaimed.ValidateAll() if the designated
+// constraints aren't met.
+type IERC20VestingClaimedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IERC20VestingClaimedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IERC20VestingClaimedMultiError) AllErrors() []error { return m }
+
+// IERC20VestingClaimedValidationError is the validation error returned by
+// IERC20VestingClaimed.Validate if the designated constraints aren't met.
+type IERC20VestingClaimedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IERC20VestingClaimedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IERC20VestingClaimedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IERC20VestingClaimedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IERC20VestingClaimedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IERC20VestingClaimedValidationError) ErrorName() string {
+	return "IERC20VestingClaimedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IERC20VestingClaimedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIERC20VestingClaimed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IERC20VestingClaimedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IERC20VestingClaimedValidationError{}
+
 // Validate checks the field values on StakingPoolUpdated with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1149,6 +1606,170 @@ func (m *Event) validate(all bool) error {
 			}
 		}
 
+	case *Event_TickBurned:
+		if v == nil {
+			err := EventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTickBurned()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "TickBurned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "TickBurned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTickBurned()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "TickBurned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_TickApproved:
+		if v == nil {
+			err := EventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTickApproved()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "TickApproved",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "TickApproved",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTickApproved()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "TickApproved",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_VestingCreated:
+		if v == nil {
+			err := EventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetVestingCreated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "VestingCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "VestingCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetVestingCreated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "VestingCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_VestingClaimed:
+		if v == nil {
+			err := EventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetVestingClaimed()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "VestingClaimed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "VestingClaimed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetVestingClaimed()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "VestingClaimed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
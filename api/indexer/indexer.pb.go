@@ -21,6 +21,115 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 事件处理结果过滤
+type EventStatus int32
+
+const (
+	// 不过滤
+	EventStatus_EVENT_STATUS_ALL EventStatus = 0
+	// 处理成功. err_code = 0
+	EventStatus_EVENT_STATUS_SUCCESS EventStatus = 1
+	// 处理失败. err_code != 0
+	EventStatus_EVENT_STATUS_FAILED EventStatus = 2
+)
+
+// Enum value maps for EventStatus.
+var (
+	EventStatus_name = map[int32]string{
+		0: "EVENT_STATUS_ALL",
+		1: "EVENT_STATUS_SUCCESS",
+		2: "EVENT_STATUS_FAILED",
+	}
+	EventStatus_value = map[string]int32{
+		"EVENT_STATUS_ALL":     0,
+		"EVENT_STATUS_SUCCESS": 1,
+		"EVENT_STATUS_FAILED":  2,
+	}
+)
+
+func (x EventStatus) Enum() *EventStatus {
+	p := new(EventStatus)
+	*p = x
+	return p
+}
+
+func (x EventStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_indexer_proto_enumTypes[0].Descriptor()
+}
+
+func (EventStatus) Type() protoreflect.EnumType {
+	return &file_indexer_indexer_proto_enumTypes[0]
+}
+
+func (x EventStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventStatus.Descriptor instead.
+func (EventStatus) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{0}
+}
+
+// 交易处理状态
+type TransactionState int32
+
+const (
+	TransactionState_TRANSACTION_STATE_UNSPECIFIED TransactionState = 0
+	// 待处理
+	TransactionState_TRANSACTION_STATE_PENDING TransactionState = 1
+	// 处理成功. 每条记录的处理结果见对应事件的 err_code
+	TransactionState_TRANSACTION_STATE_PROCESSED TransactionState = 2
+	// 无效交易. 整笔交易没有执行
+	TransactionState_TRANSACTION_STATE_INVALID TransactionState = 3
+)
+
+// Enum value maps for TransactionState.
+var (
+	TransactionState_name = map[int32]string{
+		0: "TRANSACTION_STATE_UNSPECIFIED",
+		1: "TRANSACTION_STATE_PENDING",
+		2: "TRANSACTION_STATE_PROCESSED",
+		3: "TRANSACTION_STATE_INVALID",
+	}
+	TransactionState_value = map[string]int32{
+		"TRANSACTION_STATE_UNSPECIFIED": 0,
+		"TRANSACTION_STATE_PENDING":     1,
+		"TRANSACTION_STATE_PROCESSED":   2,
+		"TRANSACTION_STATE_INVALID":     3,
+	}
+)
+
+func (x TransactionState) Enum() *TransactionState {
+	p := new(TransactionState)
+	*p = x
+	return p
+}
+
+func (x TransactionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_indexer_proto_enumTypes[1].Descriptor()
+}
+
+func (TransactionState) Type() protoreflect.EnumType {
+	return &file_indexer_indexer_proto_enumTypes[1]
+}
+
+func (x TransactionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionState.Descriptor instead.
+func (TransactionState) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{1}
+}
+
 type SubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -28,6 +137,19 @@ type SubscribeRequest struct {
 
 	// 从哪个区块开始订阅
 	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// ========== 过滤条件. 为空时不过滤, 条件之间为 AND 关系, 条件内为 OR 关系 ==========
+	// tick
+	Ticks []string `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	// 地址, 匹配 ierc_from、ierc_to、eth_from 中的任意一个
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// 协议操作, 例如 transfer
+	Operates []string `protobuf:"bytes,4,rep,name=operates,proto3" json:"operates,omitempty"`
+	// 事件类型
+	EventKinds []uint32 `protobuf:"varint,5,rep,packed,name=event_kinds,json=eventKinds,proto3" json:"event_kinds,omitempty"`
+	// 只推送处理成功的事件
+	SuccessOnly bool `protobuf:"varint,6,opt,name=success_only,json=successOnly,proto3" json:"success_only,omitempty"`
+	// 续订令牌, 取自断线前收到的最后一条消息. 不为空时忽略 start_block, 过滤条件需要与之前保持一致
+	ResumeToken string `protobuf:"bytes,7,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
 func (x *SubscribeRequest) Reset() {
@@ -69,6 +191,48 @@ func (x *SubscribeRequest) GetStartBlock() uint64 {
 	return 0
 }
 
+func (x *SubscribeRequest) GetTicks() []string {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetOperates() []string {
+	if x != nil {
+		return x.Operates
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetEventKinds() []uint32 {
+	if x != nil {
+		return x.EventKinds
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetSuccessOnly() bool {
+	if x != nil {
+		return x.SuccessOnly
+	}
+	return false
+}
+
+func (x *SubscribeRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 type SubscribeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -76,10 +240,16 @@ type SubscribeReply struct {
 
 	// 区块号
 	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	// 上一个有事件发生的区块. 客户端用于检验是否有区块缺失的情况
+	// 上一个有事件发生的区块. 客户端用于检验是否有区块缺失的情况.
+	// 带过滤条件订阅时, 为上一个有命中事件的区块, 订阅后的第一个区块为 0
 	PrevBlockNumber uint64 `protobuf:"varint,2,opt,name=prev_block_number,json=prevBlockNumber,proto3" json:"prev_block_number,omitempty"`
 	// 这个区块上发生的事件
 	Events []*Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// 续订令牌. 断线重连时携带, 从这条消息之后继续推送
+	ResumeToken string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// 是否已经追平最新处理的区块. 为 false 时表示还在回放历史事件.
+	// 追平时会推送一条没有事件的消息, block_number 为当前的订阅位置
+	CaughtUp bool `protobuf:"varint,5,opt,name=caught_up,json=caughtUp,proto3" json:"caught_up,omitempty"`
 }
 
 func (x *SubscribeReply) Reset() {
@@ -135,6 +305,20 @@ func (x *SubscribeReply) GetEvents() []*Event {
 	return nil
 }
 
+func (x *SubscribeReply) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *SubscribeReply) GetCaughtUp() bool {
+	if x != nil {
+		return x.CaughtUp
+	}
+	return false
+}
+
 type SubscribeSystemStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -530,21 +714,21 @@ func (x *CheckTransferReply) GetData() *CheckTransferReply_TransferRecord {
 	return nil
 }
 
-type QueryEventsReply_EventsByBlock struct {
+type ListAllowancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 区块号
-	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	// 上一个有事件发生的区块. 客户端用于检验是否有区块缺失的情况
-	PrevBlockNumber uint64 `protobuf:"varint,2,opt,name=prev_block_number,json=prevBlockNumber,proto3" json:"prev_block_number,omitempty"`
-	// 这个区块上发生的事件
-	Events []*Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// 资产所有者. 为空时不过滤
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// 被授权者. 为空时不过滤
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// tick. 为空时不过滤
+	Tick string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
 }
 
-func (x *QueryEventsReply_EventsByBlock) Reset() {
-	*x = QueryEventsReply_EventsByBlock{}
+func (x *ListAllowancesRequest) Reset() {
+	*x = ListAllowancesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,13 +736,13 @@ func (x *QueryEventsReply_EventsByBlock) Reset() {
 	}
 }
 
-func (x *QueryEventsReply_EventsByBlock) String() string {
+func (x *ListAllowancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryEventsReply_EventsByBlock) ProtoMessage() {}
+func (*ListAllowancesRequest) ProtoMessage() {}
 
-func (x *QueryEventsReply_EventsByBlock) ProtoReflect() protoreflect.Message {
+func (x *ListAllowancesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -570,46 +754,42 @@ func (x *QueryEventsReply_EventsByBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryEventsReply_EventsByBlock.ProtoReflect.Descriptor instead.
-func (*QueryEventsReply_EventsByBlock) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use ListAllowancesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllowancesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryEventsReply_EventsByBlock) GetBlockNumber() uint64 {
+func (x *ListAllowancesRequest) GetOwner() string {
 	if x != nil {
-		return x.BlockNumber
+		return x.Owner
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryEventsReply_EventsByBlock) GetPrevBlockNumber() uint64 {
+func (x *ListAllowancesRequest) GetSpender() string {
 	if x != nil {
-		return x.PrevBlockNumber
+		return x.Spender
 	}
-	return 0
+	return ""
 }
 
-func (x *QueryEventsReply_EventsByBlock) GetEvents() []*Event {
+func (x *ListAllowancesRequest) GetTick() string {
 	if x != nil {
-		return x.Events
+		return x.Tick
 	}
-	return nil
+	return ""
 }
 
-type CheckTransferReply_TransferRecord struct {
+type ListAllowancesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Tick     string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
-	Amount   string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status   bool   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Data []*ListAllowancesReply_Allowance `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CheckTransferReply_TransferRecord) Reset() {
-	*x = CheckTransferReply_TransferRecord{}
+func (x *ListAllowancesReply) Reset() {
+	*x = ListAllowancesReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,13 +797,13 @@ func (x *CheckTransferReply_TransferRecord) Reset() {
 	}
 }
 
-func (x *CheckTransferReply_TransferRecord) String() string {
+func (x *ListAllowancesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckTransferReply_TransferRecord) ProtoMessage() {}
+func (*ListAllowancesReply) ProtoMessage() {}
 
-func (x *CheckTransferReply_TransferRecord) ProtoReflect() protoreflect.Message {
+func (x *ListAllowancesReply) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,235 +815,3361 @@ func (x *CheckTransferReply_TransferRecord) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckTransferReply_TransferRecord.ProtoReflect.Descriptor instead.
-func (*CheckTransferReply_TransferRecord) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ListAllowancesReply.ProtoReflect.Descriptor instead.
+func (*ListAllowancesReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CheckTransferReply_TransferRecord) GetSender() string {
+func (x *ListAllowancesReply) GetData() []*ListAllowancesReply_Allowance {
 	if x != nil {
-		return x.Sender
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckTransferReply_TransferRecord) GetReceiver() string {
+type ListVestingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 锁仓发起人. 为空时不过滤
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// 接收者. 为空时不过滤
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// tick. 为空时不过滤
+	Tick string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *ListVestingsRequest) Reset() {
+	*x = ListVestingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVestingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVestingsRequest) ProtoMessage() {}
+
+func (x *ListVestingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVestingsRequest.ProtoReflect.Descriptor instead.
+func (*ListVestingsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListVestingsRequest) GetFrom() string {
 	if x != nil {
-		return x.Receiver
+		return x.From
 	}
 	return ""
 }
 
-func (x *CheckTransferReply_TransferRecord) GetTick() string {
+func (x *ListVestingsRequest) GetRecipient() string {
 	if x != nil {
-		return x.Tick
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *CheckTransferReply_TransferRecord) GetAmount() string {
+func (x *ListVestingsRequest) GetTick() string {
 	if x != nil {
-		return x.Amount
+		return x.Tick
 	}
 	return ""
 }
 
-func (x *CheckTransferReply_TransferRecord) GetStatus() bool {
-	if x != nil {
-		return x.Status
+type ListVestingsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 计算可领取数量使用的区块
+	BlockNumber uint64                       `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Data        []*ListVestingsReply_Vesting `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListVestingsReply) Reset() {
+	*x = ListVestingsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-var File_indexer_indexer_proto protoreflect.FileDescriptor
+func (x *ListVestingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_indexer_indexer_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x8b, 0x01, 0x0a,
-	0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70,
-	0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a,
-	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x22, 0x49, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x10,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x53, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72,
-	0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79,
-	0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x51, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x88, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x32, 0xaf, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x15,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0b, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7d, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x79, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x42, 0x44, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x49, 0x45, 0x72, 0x63, 0x4f, 0x72, 0x67, 0x2f, 0x49, 0x45, 0x52, 0x43, 0x5f, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*ListVestingsReply) ProtoMessage() {}
+
+func (x *ListVestingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_indexer_indexer_proto_rawDescOnce sync.Once
-	file_indexer_indexer_proto_rawDescData = file_indexer_indexer_proto_rawDesc
-)
+// Deprecated: Use ListVestingsReply.ProtoReflect.Descriptor instead.
+func (*ListVestingsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{13}
+}
 
-func file_indexer_indexer_proto_rawDescGZIP() []byte {
-	file_indexer_indexer_proto_rawDescOnce.Do(func() {
-		file_indexer_indexer_proto_rawDescData = protoimpl.X.CompressGZIP(file_indexer_indexer_proto_rawDescData)
-	})
-	return file_indexer_indexer_proto_rawDescData
+func (x *ListVestingsReply) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
 }
 
-var file_indexer_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_indexer_indexer_proto_goTypes = []interface{}{
-	(*SubscribeRequest)(nil),                  // 0: api.indexer.SubscribeRequest
-	(*SubscribeReply)(nil),                    // 1: api.indexer.SubscribeReply
-	(*SubscribeSystemStatusRequest)(nil),      // 2: api.indexer.SubscribeSystemStatusRequest
-	(*SubscribeSystemStatusReply)(nil),        // 3: api.indexer.SubscribeSystemStatusReply
-	(*QueryEventsRequest)(nil),                // 4: api.indexer.QueryEventsRequest
-	(*QueryEventsReply)(nil),                  // 5: api.indexer.QueryEventsReply
-	(*QuerySystemStatusRequest)(nil),          // 6: api.indexer.QuerySystemStatusRequest
-	(*QuerySystemStatusReply)(nil),            // 7: api.indexer.QuerySystemStatusReply
-	(*CheckTransferRequest)(nil),              // 8: api.indexer.CheckTransferRequest
-	(*CheckTransferReply)(nil),                // 9: api.indexer.CheckTransferReply
-	(*QueryEventsReply_EventsByBlock)(nil),    // 10: api.indexer.QueryEventsReply.EventsByBlock
-	(*CheckTransferReply_TransferRecord)(nil), // 11: api.indexer.CheckTransferReply.TransferRecord
-	(*Event)(nil),                             // 12: api.indexer.Event
+func (x *ListVestingsReply) GetData() []*ListVestingsReply_Vesting {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
-var file_indexer_indexer_proto_depIdxs = []int32{
-	12, // 0: api.indexer.SubscribeReply.events:type_name -> api.indexer.Event
-	10, // 1: api.indexer.QueryEventsReply.event_by_blocks:type_name -> api.indexer.QueryEventsReply.EventsByBlock
-	11, // 2: api.indexer.CheckTransferReply.data:type_name -> api.indexer.CheckTransferReply.TransferRecord
-	12, // 3: api.indexer.QueryEventsReply.EventsByBlock.events:type_name -> api.indexer.Event
-	0,  // 4: api.indexer.Indexer.SubscribeEvent:input_type -> api.indexer.SubscribeRequest
-	2,  // 5: api.indexer.Indexer.SubscribeSystemStatus:input_type -> api.indexer.SubscribeSystemStatusRequest
-	4,  // 6: api.indexer.Indexer.QueryEvents:input_type -> api.indexer.QueryEventsRequest
-	6,  // 7: api.indexer.Indexer.QuerySystemStatus:input_type -> api.indexer.QuerySystemStatusRequest
-	8,  // 8: api.indexer.Indexer.CheckTransfer:input_type -> api.indexer.CheckTransferRequest
-	1,  // 9: api.indexer.Indexer.SubscribeEvent:output_type -> api.indexer.SubscribeReply
-	3,  // 10: api.indexer.Indexer.SubscribeSystemStatus:output_type -> api.indexer.SubscribeSystemStatusReply
-	5,  // 11: api.indexer.Indexer.QueryEvents:output_type -> api.indexer.QueryEventsReply
-	7,  // 12: api.indexer.Indexer.QuerySystemStatus:output_type -> api.indexer.QuerySystemStatusReply
-	9,  // 13: api.indexer.Indexer.CheckTransfer:output_type -> api.indexer.CheckTransferReply
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+type GetTickStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
 }
 
-func init() { file_indexer_indexer_proto_init() }
-func file_indexer_indexer_proto_init() {
-	if File_indexer_indexer_proto != nil {
-		return
+func (x *GetTickStatsRequest) Reset() {
+	*x = GetTickStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	file_indexer_event_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_indexer_indexer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
+}
+
+func (x *GetTickStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickStatsRequest) ProtoMessage() {}
+
+func (x *GetTickStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTickStatsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTickStatsRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+type GetTickStatsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 持仓地址数. 余额(可用 + 冻结)大于 0 的地址
+	Holders int64 `protobuf:"varint,2,opt,name=holders,proto3" json:"holders,omitempty"`
+	// 按持仓数量的分布
+	Distribution []*GetTickStatsReply_Bucket `protobuf:"bytes,3,rep,name=distribution,proto3" json:"distribution,omitempty"`
+	// 第一次余额变化的区块和时间(unix 秒)
+	FirstActivityBlock uint64 `protobuf:"varint,4,opt,name=first_activity_block,json=firstActivityBlock,proto3" json:"first_activity_block,omitempty"`
+	FirstActivityAt    int64  `protobuf:"varint,5,opt,name=first_activity_at,json=firstActivityAt,proto3" json:"first_activity_at,omitempty"`
+	// 最后一次余额变化的区块和时间(unix 秒)
+	LastActivityBlock uint64 `protobuf:"varint,6,opt,name=last_activity_block,json=lastActivityBlock,proto3" json:"last_activity_block,omitempty"`
+	LastActivityAt    int64  `protobuf:"varint,7,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+}
+
+func (x *GetTickStatsReply) Reset() {
+	*x = GetTickStatsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickStatsReply) ProtoMessage() {}
+
+func (x *GetTickStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickStatsReply.ProtoReflect.Descriptor instead.
+func (*GetTickStatsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTickStatsReply) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *GetTickStatsReply) GetHolders() int64 {
+	if x != nil {
+		return x.Holders
+	}
+	return 0
+}
+
+func (x *GetTickStatsReply) GetDistribution() []*GetTickStatsReply_Bucket {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *GetTickStatsReply) GetFirstActivityBlock() uint64 {
+	if x != nil {
+		return x.FirstActivityBlock
+	}
+	return 0
+}
+
+func (x *GetTickStatsReply) GetFirstActivityAt() int64 {
+	if x != nil {
+		return x.FirstActivityAt
+	}
+	return 0
+}
+
+func (x *GetTickStatsReply) GetLastActivityBlock() uint64 {
+	if x != nil {
+		return x.LastActivityBlock
+	}
+	return 0
+}
+
+func (x *GetTickStatsReply) GetLastActivityAt() int64 {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return 0
+}
+
+type GetTickHoldersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 返回数量, 默认 100, 最大 1000
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTickHoldersRequest) Reset() {
+	*x = GetTickHoldersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickHoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickHoldersRequest) ProtoMessage() {}
+
+func (x *GetTickHoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickHoldersRequest.ProtoReflect.Descriptor instead.
+func (*GetTickHoldersRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTickHoldersRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *GetTickHoldersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTickHoldersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 持仓地址数
+	Holders int64                         `protobuf:"varint,2,opt,name=holders,proto3" json:"holders,omitempty"`
+	Data    []*GetTickHoldersReply_Holder `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetTickHoldersReply) Reset() {
+	*x = GetTickHoldersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickHoldersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickHoldersReply) ProtoMessage() {}
+
+func (x *GetTickHoldersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickHoldersReply.ProtoReflect.Descriptor instead.
+func (*GetTickHoldersReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTickHoldersReply) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *GetTickHoldersReply) GetHolders() int64 {
+	if x != nil {
+		return x.Holders
+	}
+	return 0
+}
+
+func (x *GetTickHoldersReply) GetData() []*GetTickHoldersReply_Holder {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Balance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tick    string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 可用数量. 浮点字符串
+	Available string `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
+	// 冻结数量. 浮点字符串
+	Freeze string `protobuf:"bytes,4,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	// mint 的数量. 浮点字符串
+	Minted string `protobuf:"bytes,5,opt,name=minted,proto3" json:"minted,omitempty"`
+	// 最后更新的区块
+	LastUpdatedBlock uint64 `protobuf:"varint,6,opt,name=last_updated_block,json=lastUpdatedBlock,proto3" json:"last_updated_block,omitempty"`
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Balance) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Balance) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *Balance) GetAvailable() string {
+	if x != nil {
+		return x.Available
+	}
+	return ""
+}
+
+func (x *Balance) GetFreeze() string {
+	if x != nil {
+		return x.Freeze
+	}
+	return ""
+}
+
+func (x *Balance) GetMinted() string {
+	if x != nil {
+		return x.Minted
+	}
+	return ""
+}
+
+func (x *Balance) GetLastUpdatedBlock() uint64 {
+	if x != nil {
+		return x.LastUpdatedBlock
+	}
+	return 0
+}
+
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tick    string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetBalanceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetBalanceRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+type GetBalanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 余额对应的索引高度
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// 余额不存在时各数量为 0
+	Data *Balance `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetBalanceReply) Reset() {
+	*x = GetBalanceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceReply) ProtoMessage() {}
+
+func (x *GetBalanceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceReply.ProtoReflect.Descriptor instead.
+func (*GetBalanceReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBalanceReply) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetBalanceReply) GetData() *Balance {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListBalancesByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 上一页返回的 next_cursor. 为空时从第一页开始
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 返回数量, 默认 100, 最大 1000
+	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 是否包含余额为 0 的 tick
+	IncludeZero bool `protobuf:"varint,4,opt,name=include_zero,json=includeZero,proto3" json:"include_zero,omitempty"`
+}
+
+func (x *ListBalancesByAddressRequest) Reset() {
+	*x = ListBalancesByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalancesByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalancesByAddressRequest) ProtoMessage() {}
+
+func (x *ListBalancesByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalancesByAddressRequest.ProtoReflect.Descriptor instead.
+func (*ListBalancesByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListBalancesByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListBalancesByAddressRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBalancesByAddressRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBalancesByAddressRequest) GetIncludeZero() bool {
+	if x != nil {
+		return x.IncludeZero
+	}
+	return false
+}
+
+type ListBalancesByAddressReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 余额对应的索引高度
+	BlockNumber uint64     `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Data        []*Balance `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	// 下一页的游标. 为空时表示没有更多数据
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListBalancesByAddressReply) Reset() {
+	*x = ListBalancesByAddressReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalancesByAddressReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalancesByAddressReply) ProtoMessage() {}
+
+func (x *ListBalancesByAddressReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalancesByAddressReply.ProtoReflect.Descriptor instead.
+func (*ListBalancesByAddressReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListBalancesByAddressReply) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListBalancesByAddressReply) GetData() []*Balance {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListBalancesByAddressReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListHoldersByTickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 上一页返回的 next_cursor. 为空时从第一页开始
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 返回数量, 默认 100, 最大 1000
+	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListHoldersByTickRequest) Reset() {
+	*x = ListHoldersByTickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHoldersByTickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldersByTickRequest) ProtoMessage() {}
+
+func (x *ListHoldersByTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldersByTickRequest.ProtoReflect.Descriptor instead.
+func (*ListHoldersByTickRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListHoldersByTickRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListHoldersByTickRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListHoldersByTickRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListHoldersByTickReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 余额对应的索引高度
+	BlockNumber uint64     `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Data        []*Balance `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	// 下一页的游标. 为空时表示没有更多数据
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListHoldersByTickReply) Reset() {
+	*x = ListHoldersByTickReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHoldersByTickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldersByTickReply) ProtoMessage() {}
+
+func (x *ListHoldersByTickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldersByTickReply.ProtoReflect.Descriptor instead.
+func (*ListHoldersByTickReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListHoldersByTickReply) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListHoldersByTickReply) GetData() []*Balance {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListHoldersByTickReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type Tick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// ierc-20, terc-20, ierc-pow
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Decimals int64  `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// 最大发行量. 浮点字符串
+	MaxSupply string `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// 已发行量. pow tick 为 pow、pos 和空投之和. 浮点字符串
+	Supply string `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`
+	// mint 进度, 百分比. 浮点字符串
+	MintProgress string `protobuf:"bytes,6,opt,name=mint_progress,json=mintProgress,proto3" json:"mint_progress,omitempty"`
+	// 是否还可以 mint
+	Mintable    bool   `protobuf:"varint,7,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	Creator     string `protobuf:"bytes,8,opt,name=creator,proto3" json:"creator,omitempty"`
+	DeployBlock uint64 `protobuf:"varint,9,opt,name=deploy_block,json=deployBlock,proto3" json:"deploy_block,omitempty"`
+	// 部署时间, unix 秒
+	DeployedAt       int64  `protobuf:"varint,10,opt,name=deployed_at,json=deployedAt,proto3" json:"deployed_at,omitempty"`
+	LastUpdatedBlock uint64 `protobuf:"varint,11,opt,name=last_updated_block,json=lastUpdatedBlock,proto3" json:"last_updated_block,omitempty"`
+	// ====== ierc-20 / terc-20
+	// 单笔 mint 的最大数量. 浮点字符串
+	Limit string `protobuf:"bytes,12,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 单个地址 mint 的最大数量. 浮点字符串
+	WalletLimit string `protobuf:"bytes,13,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	Workc       string `protobuf:"bytes,14,opt,name=workc,proto3" json:"workc,omitempty"`
+	// 已销毁数量. 浮点字符串
+	BurnedAmount string `protobuf:"bytes,15,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+	// ====== ierc-pow
+	Tokenomics       []*Tick_Tokenomics     `protobuf:"bytes,16,rep,name=tokenomics,proto3" json:"tokenomics,omitempty"`
+	DistributionRule *Tick_DistributionRule `protobuf:"bytes,17,opt,name=distribution_rule,json=distributionRule,proto3" json:"distribution_rule,omitempty"`
+	// 浮点字符串
+	PowSupply     string `protobuf:"bytes,18,opt,name=pow_supply,json=powSupply,proto3" json:"pow_supply,omitempty"`
+	PowBurnAmount string `protobuf:"bytes,19,opt,name=pow_burn_amount,json=powBurnAmount,proto3" json:"pow_burn_amount,omitempty"`
+	PosSupply     string `protobuf:"bytes,20,opt,name=pos_supply,json=posSupply,proto3" json:"pos_supply,omitempty"`
+	PosBurnAmount string `protobuf:"bytes,21,opt,name=pos_burn_amount,json=posBurnAmount,proto3" json:"pos_burn_amount,omitempty"`
+	AirdropAmount string `protobuf:"bytes,22,opt,name=airdrop_amount,json=airdropAmount,proto3" json:"airdrop_amount,omitempty"`
+	// 当前区块的 pow 和 pos 产出数量. 浮点字符串
+	PowEmissionPerBlock string `protobuf:"bytes,23,opt,name=pow_emission_per_block,json=powEmissionPerBlock,proto3" json:"pow_emission_per_block,omitempty"`
+	PosEmissionPerBlock string `protobuf:"bytes,24,opt,name=pos_emission_per_block,json=posEmissionPerBlock,proto3" json:"pos_emission_per_block,omitempty"`
+}
+
+func (x *Tick) Reset() {
+	*x = Tick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tick) ProtoMessage() {}
+
+func (x *Tick) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tick.ProtoReflect.Descriptor instead.
+func (*Tick) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Tick) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *Tick) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Tick) GetDecimals() int64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Tick) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Tick) GetSupply() string {
+	if x != nil {
+		return x.Supply
+	}
+	return ""
+}
+
+func (x *Tick) GetMintProgress() string {
+	if x != nil {
+		return x.MintProgress
+	}
+	return ""
+}
+
+func (x *Tick) GetMintable() bool {
+	if x != nil {
+		return x.Mintable
+	}
+	return false
+}
+
+func (x *Tick) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Tick) GetDeployBlock() uint64 {
+	if x != nil {
+		return x.DeployBlock
+	}
+	return 0
+}
+
+func (x *Tick) GetDeployedAt() int64 {
+	if x != nil {
+		return x.DeployedAt
+	}
+	return 0
+}
+
+func (x *Tick) GetLastUpdatedBlock() uint64 {
+	if x != nil {
+		return x.LastUpdatedBlock
+	}
+	return 0
+}
+
+func (x *Tick) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *Tick) GetWalletLimit() string {
+	if x != nil {
+		return x.WalletLimit
+	}
+	return ""
+}
+
+func (x *Tick) GetWorkc() string {
+	if x != nil {
+		return x.Workc
+	}
+	return ""
+}
+
+func (x *Tick) GetBurnedAmount() string {
+	if x != nil {
+		return x.BurnedAmount
+	}
+	return ""
+}
+
+func (x *Tick) GetTokenomics() []*Tick_Tokenomics {
+	if x != nil {
+		return x.Tokenomics
+	}
+	return nil
+}
+
+func (x *Tick) GetDistributionRule() *Tick_DistributionRule {
+	if x != nil {
+		return x.DistributionRule
+	}
+	return nil
+}
+
+func (x *Tick) GetPowSupply() string {
+	if x != nil {
+		return x.PowSupply
+	}
+	return ""
+}
+
+func (x *Tick) GetPowBurnAmount() string {
+	if x != nil {
+		return x.PowBurnAmount
+	}
+	return ""
+}
+
+func (x *Tick) GetPosSupply() string {
+	if x != nil {
+		return x.PosSupply
+	}
+	return ""
+}
+
+func (x *Tick) GetPosBurnAmount() string {
+	if x != nil {
+		return x.PosBurnAmount
+	}
+	return ""
+}
+
+func (x *Tick) GetAirdropAmount() string {
+	if x != nil {
+		return x.AirdropAmount
+	}
+	return ""
+}
+
+func (x *Tick) GetPowEmissionPerBlock() string {
+	if x != nil {
+		return x.PowEmissionPerBlock
+	}
+	return ""
+}
+
+func (x *Tick) GetPosEmissionPerBlock() string {
+	if x != nil {
+		return x.PosEmissionPerBlock
+	}
+	return ""
+}
+
+type GetTickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick string `protobuf:"bytes,1,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *GetTickRequest) Reset() {
+	*x = GetTickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickRequest) ProtoMessage() {}
+
+func (x *GetTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickRequest.ProtoReflect.Descriptor instead.
+func (*GetTickRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTickRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+type GetTickReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 计算产出使用的区块(最后处理的区块)
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Data        *Tick  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetTickReply) Reset() {
+	*x = GetTickReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickReply) ProtoMessage() {}
+
+func (x *GetTickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickReply.ProtoReflect.Descriptor instead.
+func (*GetTickReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTickReply) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetTickReply) GetData() *Tick {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListTicksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 协议. 为空时不过滤
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// 创建者. 为空时不过滤
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// 只返回还可以 mint 的 tick
+	Mintable bool `protobuf:"varint,3,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// 上一页返回的 next_cursor. 为 0 时从第一页开始
+	Cursor int64 `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 返回数量, 默认 100, 最大 1000
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTicksRequest) Reset() {
+	*x = ListTicksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicksRequest) ProtoMessage() {}
+
+func (x *ListTicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicksRequest.ProtoReflect.Descriptor instead.
+func (*ListTicksRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTicksRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ListTicksRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *ListTicksRequest) GetMintable() bool {
+	if x != nil {
+		return x.Mintable
+	}
+	return false
+}
+
+func (x *ListTicksRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListTicksRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTicksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 计算产出使用的区块(最后处理的区块)
+	BlockNumber uint64  `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Data        []*Tick `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	// 下一页的游标. 为 0 时表示没有更多数据
+	NextCursor int64 `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListTicksReply) Reset() {
+	*x = ListTicksReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTicksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicksReply) ProtoMessage() {}
+
+func (x *ListTicksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicksReply.ProtoReflect.Descriptor instead.
+func (*ListTicksReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTicksReply) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListTicksReply) GetData() []*Tick {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListTicksReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type ListEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 地址, 匹配 ierc_from、ierc_to、eth_from 中的任意一个. 为空时不过滤
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// IERC 协议交易发起者. 为空时不过滤
+	IercFrom string `protobuf:"bytes,2,opt,name=ierc_from,json=iercFrom,proto3" json:"ierc_from,omitempty"`
+	// IERC 协议交易接收者. 为空时不过滤
+	IercTo string `protobuf:"bytes,3,opt,name=ierc_to,json=iercTo,proto3" json:"ierc_to,omitempty"`
+	// ETH 交易发起者. 为空时不过滤
+	EthFrom string `protobuf:"bytes,4,opt,name=eth_from,json=ethFrom,proto3" json:"eth_from,omitempty"`
+	// tick. 为空时不过滤
+	Tick string `protobuf:"bytes,5,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 协议操作, 例如 transfer. 为空时不过滤
+	Operate string `protobuf:"bytes,6,opt,name=operate,proto3" json:"operate,omitempty"`
+	// 事件类型. 为空时不过滤
+	EventKinds []uint32 `protobuf:"varint,7,rep,packed,name=event_kinds,json=eventKinds,proto3" json:"event_kinds,omitempty"`
+	// 交易hash. 为空时不过滤
+	TxHash string      `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Status EventStatus `protobuf:"varint,9,opt,name=status,proto3,enum=api.indexer.EventStatus" json:"status,omitempty"`
+	// 上一次返回的 next_cursor, 查询下一页. 与 before 互斥
+	After string `protobuf:"bytes,10,opt,name=after,proto3" json:"after,omitempty"`
+	// 上一次返回的 prev_cursor, 查询上一页. 与 after 互斥
+	Before string `protobuf:"bytes,11,opt,name=before,proto3" json:"before,omitempty"`
+	// 是否按 (区块号, 事件ID) 倒序返回. 翻页时需要与上一次查询保持一致
+	Desc bool `protobuf:"varint,12,opt,name=desc,proto3" json:"desc,omitempty"`
+	// 返回数量, 默认 100, 最大 1000
+	Limit int64 `protobuf:"varint,13,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListEventsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetIercFrom() string {
+	if x != nil {
+		return x.IercFrom
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetIercTo() string {
+	if x != nil {
+		return x.IercTo
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetEthFrom() string {
+	if x != nil {
+		return x.EthFrom
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetOperate() string {
+	if x != nil {
+		return x.Operate
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetEventKinds() []uint32 {
+	if x != nil {
+		return x.EventKinds
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_EVENT_STATUS_ALL
+}
+
+func (x *ListEventsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListEventsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListEventsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Event `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// 下一页的游标. 为空时表示没有更多数据
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// 上一页的游标. 为空时表示没有更多数据
+	PrevCursor string `protobuf:"bytes,3,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+}
+
+func (x *ListEventsReply) Reset() {
+	*x = ListEventsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsReply) ProtoMessage() {}
+
+func (x *ListEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsReply.ProtoReflect.Descriptor instead.
+func (*ListEventsReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListEventsReply) GetData() []*Event {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListEventsReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListEventsReply) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// 交易在区块交易列表中的位置
+	PositionInTxs int64  `protobuf:"varint,3,opt,name=position_in_txs,json=positionInTxs,proto3" json:"position_in_txs,omitempty"`
+	From          string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// ETH value
+	Value string           `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	State TransactionState `protobuf:"varint,7,opt,name=state,proto3,enum=api.indexer.TransactionState" json:"state,omitempty"`
+	// 处理结果状态码. 0 表示成功
+	Code   int32  `protobuf:"varint,8,opt,name=code,proto3" json:"code,omitempty"`
+	Remark string `protobuf:"bytes,9,opt,name=remark,proto3" json:"remark,omitempty"`
+	// 状态码对应的错误名称, 例如 InsufficientAvailableFunds
+	ErrorName string `protobuf:"bytes,10,opt,name=error_name,json=errorName,proto3" json:"error_name,omitempty"`
+	// 解析出的协议及操作. 交易数据无法解析时为空
+	Protocol string `protobuf:"bytes,11,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Operate  string `protobuf:"bytes,12,opt,name=operate,proto3" json:"operate,omitempty"`
+	// 解析出的协议命令, JSON 格式
+	Command string `protobuf:"bytes,13,opt,name=command,proto3" json:"command,omitempty"`
+	// 按位置升序
+	Records []*Transaction_Record `protobuf:"bytes,14,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Transaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Transaction) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Transaction) GetPositionInTxs() int64 {
+	if x != nil {
+		return x.PositionInTxs
+	}
+	return 0
+}
+
+func (x *Transaction) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Transaction) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Transaction) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Transaction) GetState() TransactionState {
+	if x != nil {
+		return x.State
+	}
+	return TransactionState_TRANSACTION_STATE_UNSPECIFIED
+}
+
+func (x *Transaction) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Transaction) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *Transaction) GetErrorName() string {
+	if x != nil {
+		return x.ErrorName
+	}
+	return ""
+}
+
+func (x *Transaction) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Transaction) GetOperate() string {
+	if x != nil {
+		return x.Operate
+	}
+	return ""
+}
+
+func (x *Transaction) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *Transaction) GetRecords() []*Transaction_Record {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetTransactionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *Transaction `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetTransactionReply) Reset() {
+	*x = GetTransactionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionReply) ProtoMessage() {}
+
+func (x *GetTransactionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionReply.ProtoReflect.Descriptor instead.
+func (*GetTransactionReply) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTransactionReply) GetData() *Transaction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type QueryEventsReply_EventsByBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 区块号
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// 上一个有事件发生的区块. 客户端用于检验是否有区块缺失的情况
+	PrevBlockNumber uint64 `protobuf:"varint,2,opt,name=prev_block_number,json=prevBlockNumber,proto3" json:"prev_block_number,omitempty"`
+	// 这个区块上发生的事件
+	Events []*Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *QueryEventsReply_EventsByBlock) Reset() {
+	*x = QueryEventsReply_EventsByBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEventsReply_EventsByBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsReply_EventsByBlock) ProtoMessage() {}
+
+func (x *QueryEventsReply_EventsByBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsReply_EventsByBlock.ProtoReflect.Descriptor instead.
+func (*QueryEventsReply_EventsByBlock) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *QueryEventsReply_EventsByBlock) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *QueryEventsReply_EventsByBlock) GetPrevBlockNumber() uint64 {
+	if x != nil {
+		return x.PrevBlockNumber
+	}
+	return 0
+}
+
+func (x *QueryEventsReply_EventsByBlock) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CheckTransferReply_TransferRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Tick     string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	Amount   string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status   bool   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CheckTransferReply_TransferRecord) Reset() {
+	*x = CheckTransferReply_TransferRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckTransferReply_TransferRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTransferReply_TransferRecord) ProtoMessage() {}
+
+func (x *CheckTransferReply_TransferRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTransferReply_TransferRecord.ProtoReflect.Descriptor instead.
+func (*CheckTransferReply_TransferRecord) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *CheckTransferReply_TransferRecord) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CheckTransferReply_TransferRecord) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+type ListAllowancesReply_Allowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 资产所有者
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// 被授权者
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Tick    string `protobuf:"bytes,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 剩余授权额度. 浮点字符串
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 最后一次签名授权使用的 nonce
+	SignNonce string `protobuf:"bytes,5,opt,name=sign_nonce,json=signNonce,proto3" json:"sign_nonce,omitempty"`
+	// 最后更新的区块
+	LastUpdatedBlock uint64 `protobuf:"varint,6,opt,name=last_updated_block,json=lastUpdatedBlock,proto3" json:"last_updated_block,omitempty"`
+}
+
+func (x *ListAllowancesReply_Allowance) Reset() {
+	*x = ListAllowancesReply_Allowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllowancesReply_Allowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllowancesReply_Allowance) ProtoMessage() {}
+
+func (x *ListAllowancesReply_Allowance) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllowancesReply_Allowance.ProtoReflect.Descriptor instead.
+func (*ListAllowancesReply_Allowance) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *ListAllowancesReply_Allowance) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListAllowancesReply_Allowance) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *ListAllowancesReply_Allowance) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListAllowancesReply_Allowance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ListAllowancesReply_Allowance) GetSignNonce() string {
+	if x != nil {
+		return x.SignNonce
+	}
+	return ""
+}
+
+func (x *ListAllowancesReply_Allowance) GetLastUpdatedBlock() uint64 {
+	if x != nil {
+		return x.LastUpdatedBlock
+	}
+	return 0
+}
+
+type ListVestingsReply_Vesting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 锁仓ID. 交易hash-交易中的位置
+	VestId string `protobuf:"bytes,1,opt,name=vest_id,json=vestId,proto3" json:"vest_id,omitempty"`
+	Tick   string `protobuf:"bytes,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// 锁仓发起人
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// 接收者
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// 锁仓总量. 浮点字符串
+	Total string `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	// 已领取数量. 浮点字符串
+	Released string `protobuf:"bytes,6,opt,name=released,proto3" json:"released,omitempty"`
+	// 当前可领取数量. 按最后处理的区块计算
+	Releasable string `protobuf:"bytes,7,opt,name=releasable,proto3" json:"releasable,omitempty"`
+	StartBlock uint64 `protobuf:"varint,8,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	CliffBlock uint64 `protobuf:"varint,9,opt,name=cliff_block,json=cliffBlock,proto3" json:"cliff_block,omitempty"`
+	EndBlock   uint64 `protobuf:"varint,10,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (x *ListVestingsReply_Vesting) Reset() {
+	*x = ListVestingsReply_Vesting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVestingsReply_Vesting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVestingsReply_Vesting) ProtoMessage() {}
+
+func (x *ListVestingsReply_Vesting) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVestingsReply_Vesting.ProtoReflect.Descriptor instead.
+func (*ListVestingsReply_Vesting) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *ListVestingsReply_Vesting) GetVestId() string {
+	if x != nil {
+		return x.VestId
+	}
+	return ""
+}
+
+func (x *ListVestingsReply_Vesting) GetTick() string {
+	if x != nil {
+		return x.Tick
+	}
+	return ""
+}
+
+func (x *ListVestingsReply_Vesting) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListVestingsReply_Vesting) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *ListVestingsReply_Vesting) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *ListVestingsReply_Vesting) GetReleased() string {
+	if x != nil {
+		return x.Released
+	}
+	return ""
+}
+
+func (x *ListVestingsReply_Vesting) GetReleasable() string {
+	if x != nil {
+		return x.Releasable
+	}
+	return ""
+}
+
+func (x *ListVestingsReply_Vesting) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *ListVestingsReply_Vesting) GetCliffBlock() uint64 {
+	if x != nil {
+		return x.CliffBlock
+	}
+	return 0
+}
+
+func (x *ListVestingsReply_Vesting) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+type GetTickStatsReply_Bucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 区间描述. 例如: 0-1, 1-10, 100000000+
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// 区间下限, 包含. 浮点字符串
+	Min string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	// 区间上限, 不包含. 浮点字符串, 最后一个区间为空
+	Max string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	// 区间内的持仓地址数
+	Holders int64 `protobuf:"varint,4,opt,name=holders,proto3" json:"holders,omitempty"`
+}
+
+func (x *GetTickStatsReply_Bucket) Reset() {
+	*x = GetTickStatsReply_Bucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickStatsReply_Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickStatsReply_Bucket) ProtoMessage() {}
+
+func (x *GetTickStatsReply_Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickStatsReply_Bucket.ProtoReflect.Descriptor instead.
+func (*GetTickStatsReply_Bucket) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetTickStatsReply_Bucket) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GetTickStatsReply_Bucket) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *GetTickStatsReply_Bucket) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *GetTickStatsReply_Bucket) GetHolders() int64 {
+	if x != nil {
+		return x.Holders
+	}
+	return 0
+}
+
+type GetTickHoldersReply_Holder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 排名, 从 1 开始
+	Rank    int64  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// 持仓数量(可用 + 冻结). 浮点字符串
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *GetTickHoldersReply_Holder) Reset() {
+	*x = GetTickHoldersReply_Holder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTickHoldersReply_Holder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickHoldersReply_Holder) ProtoMessage() {}
+
+func (x *GetTickHoldersReply_Holder) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickHoldersReply_Holder.ProtoReflect.Descriptor instead.
+func (*GetTickHoldersReply_Holder) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetTickHoldersReply_Holder) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetTickHoldersReply_Holder) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetTickHoldersReply_Holder) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Tick_Tokenomics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 从该区块开始生效
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// 每个区块的产出数量. 浮点字符串
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Tick_Tokenomics) Reset() {
+	*x = Tick_Tokenomics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tick_Tokenomics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tick_Tokenomics) ProtoMessage() {}
+
+func (x *Tick_Tokenomics) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tick_Tokenomics.ProtoReflect.Descriptor instead.
+func (*Tick_Tokenomics) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *Tick_Tokenomics) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Tick_Tokenomics) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Tick_DistributionRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pow 奖励占比. 浮点字符串
+	PowRatio string `protobuf:"bytes,1,opt,name=pow_ratio,json=powRatio,proto3" json:"pow_ratio,omitempty"`
+	// pow 最小难度
+	MinWorkc string `protobuf:"bytes,2,opt,name=min_workc,json=minWorkc,proto3" json:"min_workc,omitempty"`
+	// pow 难度系数. 浮点字符串
+	DifficultyRatio string `protobuf:"bytes,3,opt,name=difficulty_ratio,json=difficultyRatio,proto3" json:"difficulty_ratio,omitempty"`
+	// pos 奖励占比. 浮点字符串
+	PosRatio string `protobuf:"bytes,4,opt,name=pos_ratio,json=posRatio,proto3" json:"pos_ratio,omitempty"`
+	// pos 奖励池
+	PosPool string `protobuf:"bytes,5,opt,name=pos_pool,json=posPool,proto3" json:"pos_pool,omitempty"`
+	// 最多累积的奖励区块数
+	MaxRewardBlock uint64 `protobuf:"varint,6,opt,name=max_reward_block,json=maxRewardBlock,proto3" json:"max_reward_block,omitempty"`
+}
+
+func (x *Tick_DistributionRule) Reset() {
+	*x = Tick_DistributionRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tick_DistributionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tick_DistributionRule) ProtoMessage() {}
+
+func (x *Tick_DistributionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tick_DistributionRule.ProtoReflect.Descriptor instead.
+func (*Tick_DistributionRule) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *Tick_DistributionRule) GetPowRatio() string {
+	if x != nil {
+		return x.PowRatio
+	}
+	return ""
+}
+
+func (x *Tick_DistributionRule) GetMinWorkc() string {
+	if x != nil {
+		return x.MinWorkc
+	}
+	return ""
+}
+
+func (x *Tick_DistributionRule) GetDifficultyRatio() string {
+	if x != nil {
+		return x.DifficultyRatio
+	}
+	return ""
+}
+
+func (x *Tick_DistributionRule) GetPosRatio() string {
+	if x != nil {
+		return x.PosRatio
+	}
+	return ""
+}
+
+func (x *Tick_DistributionRule) GetPosPool() string {
+	if x != nil {
+		return x.PosPool
+	}
+	return ""
+}
+
+func (x *Tick_DistributionRule) GetMaxRewardBlock() uint64 {
+	if x != nil {
+		return x.MaxRewardBlock
+	}
+	return 0
+}
+
+type Transaction_Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 记录在 IERC 交易中的位置, 对应事件的 pos_in_ierc_txs
+	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	// 记录产生的事件
+	Events []*Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *Transaction_Record) Reset() {
+	*x = Transaction_Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction_Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction_Record) ProtoMessage() {}
+
+func (x *Transaction_Record) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction_Record.ProtoReflect.Descriptor instead.
+func (*Transaction_Record) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *Transaction_Record) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Transaction_Record) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_indexer_indexer_proto protoreflect.FileDescriptor
+
+var file_indexer_indexer_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x75, 0x67, 0x68, 0x74, 0x5f,
+	0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x75, 0x67, 0x68, 0x74,
+	0x55, 0x70, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x49, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x62, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0d,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x8a, 0x01,
+	0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70,
+	0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x51, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x88, 0x01,
+	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5b, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x8c, 0x02, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xb4, 0x01,
+	0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x5b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63,
+	0x6b, 0x22, 0x8e, 0x03, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x99, 0x02, 0x0a, 0x07, 0x56, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x66, 0x66,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0xa2, 0x03,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2a,
+	0x0a, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x41, 0x74, 0x1a, 0x5c, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x48,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x48, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4e, 0x0a, 0x06, 0x48, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x41,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63,
+	0x6b, 0x22, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x89, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x22, 0x8a, 0x01,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x5c, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x9d, 0x09, 0x0a, 0x04, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x6b, 0x63, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x6b, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c,
+	0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x4f, 0x0a, 0x11,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x6f, 0x77, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0f,
+	0x70, 0x6f, 0x77, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x77, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f,
+	0x73, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x70, 0x6f, 0x77, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x70, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x16, 0x70, 0x6f, 0x73, 0x5f, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x47, 0x0a, 0x0a,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xd9, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f,
+	0x77, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6f, 0x77, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x77,
+	0x6f, 0x72, 0x6b, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x63, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x6f, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x58, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0xf0, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x65, 0x72, 0x63, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x65, 0x72, 0x63, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x65, 0x72, 0x63, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x65, 0x72, 0x63, 0x54, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x74, 0x68,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x74, 0x68,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69,
+	0x6e, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x65, 0x73, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7b, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x83, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x54,
+	0x78, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x50, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x56, 0x0a, 0x0b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x94, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x32, 0xba, 0x0e, 0x0a, 0x07, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x7d, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x79, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x78, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x22,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x70, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x72, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x7a, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x22,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x74, 0x69, 0x63, 0x6b,
+	0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x84, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x2f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x6d, 0x0a,
+	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x46, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x65, 0x76, 0x69, 0x6e, 0x38, 0x38, 0x38, 0x38, 0x36, 0x2f,
+	0x65, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x3b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_indexer_indexer_proto_rawDescOnce sync.Once
+	file_indexer_indexer_proto_rawDescData = file_indexer_indexer_proto_rawDesc
+)
+
+func file_indexer_indexer_proto_rawDescGZIP() []byte {
+	file_indexer_indexer_proto_rawDescOnce.Do(func() {
+		file_indexer_indexer_proto_rawDescData = protoimpl.X.CompressGZIP(file_indexer_indexer_proto_rawDescData)
+	})
+	return file_indexer_indexer_proto_rawDescData
+}
+
+var file_indexer_indexer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_indexer_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_indexer_indexer_proto_goTypes = []interface{}{
+	(EventStatus)(0),                          // 0: api.indexer.EventStatus
+	(TransactionState)(0),                     // 1: api.indexer.TransactionState
+	(*SubscribeRequest)(nil),                  // 2: api.indexer.SubscribeRequest
+	(*SubscribeReply)(nil),                    // 3: api.indexer.SubscribeReply
+	(*SubscribeSystemStatusRequest)(nil),      // 4: api.indexer.SubscribeSystemStatusRequest
+	(*SubscribeSystemStatusReply)(nil),        // 5: api.indexer.SubscribeSystemStatusReply
+	(*QueryEventsRequest)(nil),                // 6: api.indexer.QueryEventsRequest
+	(*QueryEventsReply)(nil),                  // 7: api.indexer.QueryEventsReply
+	(*QuerySystemStatusRequest)(nil),          // 8: api.indexer.QuerySystemStatusRequest
+	(*QuerySystemStatusReply)(nil),            // 9: api.indexer.QuerySystemStatusReply
+	(*CheckTransferRequest)(nil),              // 10: api.indexer.CheckTransferRequest
+	(*CheckTransferReply)(nil),                // 11: api.indexer.CheckTransferReply
+	(*ListAllowancesRequest)(nil),             // 12: api.indexer.ListAllowancesRequest
+	(*ListAllowancesReply)(nil),               // 13: api.indexer.ListAllowancesReply
+	(*ListVestingsRequest)(nil),               // 14: api.indexer.ListVestingsRequest
+	(*ListVestingsReply)(nil),                 // 15: api.indexer.ListVestingsReply
+	(*GetTickStatsRequest)(nil),               // 16: api.indexer.GetTickStatsRequest
+	(*GetTickStatsReply)(nil),                 // 17: api.indexer.GetTickStatsReply
+	(*GetTickHoldersRequest)(nil),             // 18: api.indexer.GetTickHoldersRequest
+	(*GetTickHoldersReply)(nil),               // 19: api.indexer.GetTickHoldersReply
+	(*Balance)(nil),                           // 20: api.indexer.Balance
+	(*GetBalanceRequest)(nil),                 // 21: api.indexer.GetBalanceRequest
+	(*GetBalanceReply)(nil),                   // 22: api.indexer.GetBalanceReply
+	(*ListBalancesByAddressRequest)(nil),      // 23: api.indexer.ListBalancesByAddressRequest
+	(*ListBalancesByAddressReply)(nil),        // 24: api.indexer.ListBalancesByAddressReply
+	(*ListHoldersByTickRequest)(nil),          // 25: api.indexer.ListHoldersByTickRequest
+	(*ListHoldersByTickReply)(nil),            // 26: api.indexer.ListHoldersByTickReply
+	(*Tick)(nil),                              // 27: api.indexer.Tick
+	(*GetTickRequest)(nil),                    // 28: api.indexer.GetTickRequest
+	(*GetTickReply)(nil),                      // 29: api.indexer.GetTickReply
+	(*ListTicksRequest)(nil),                  // 30: api.indexer.ListTicksRequest
+	(*ListTicksReply)(nil),                    // 31: api.indexer.ListTicksReply
+	(*ListEventsRequest)(nil),                 // 32: api.indexer.ListEventsRequest
+	(*ListEventsReply)(nil),                   // 33: api.indexer.ListEventsReply
+	(*Transaction)(nil),                       // 34: api.indexer.Transaction
+	(*GetTransactionRequest)(nil),             // 35: api.indexer.GetTransactionRequest
+	(*GetTransactionReply)(nil),               // 36: api.indexer.GetTransactionReply
+	(*QueryEventsReply_EventsByBlock)(nil),    // 37: api.indexer.QueryEventsReply.EventsByBlock
+	(*CheckTransferReply_TransferRecord)(nil), // 38: api.indexer.CheckTransferReply.TransferRecord
+	(*ListAllowancesReply_Allowance)(nil),     // 39: api.indexer.ListAllowancesReply.Allowance
+	(*ListVestingsReply_Vesting)(nil),         // 40: api.indexer.ListVestingsReply.Vesting
+	(*GetTickStatsReply_Bucket)(nil),          // 41: api.indexer.GetTickStatsReply.Bucket
+	(*GetTickHoldersReply_Holder)(nil),        // 42: api.indexer.GetTickHoldersReply.Holder
+	(*Tick_Tokenomics)(nil),                   // 43: api.indexer.Tick.Tokenomics
+	(*Tick_DistributionRule)(nil),             // 44: api.indexer.Tick.DistributionRule
+	(*Transaction_Record)(nil),                // 45: api.indexer.Transaction.Record
+	(*Event)(nil),                             // 46: api.indexer.Event
+}
+var file_indexer_indexer_proto_depIdxs = []int32{
+	46, // 0: api.indexer.SubscribeReply.events:type_name -> api.indexer.Event
+	37, // 1: api.indexer.QueryEventsReply.event_by_blocks:type_name -> api.indexer.QueryEventsReply.EventsByBlock
+	38, // 2: api.indexer.CheckTransferReply.data:type_name -> api.indexer.CheckTransferReply.TransferRecord
+	39, // 3: api.indexer.ListAllowancesReply.data:type_name -> api.indexer.ListAllowancesReply.Allowance
+	40, // 4: api.indexer.ListVestingsReply.data:type_name -> api.indexer.ListVestingsReply.Vesting
+	41, // 5: api.indexer.GetTickStatsReply.distribution:type_name -> api.indexer.GetTickStatsReply.Bucket
+	42, // 6: api.indexer.GetTickHoldersReply.data:type_name -> api.indexer.GetTickHoldersReply.Holder
+	20, // 7: api.indexer.GetBalanceReply.data:type_name -> api.indexer.Balance
+	20, // 8: api.indexer.ListBalancesByAddressReply.data:type_name -> api.indexer.Balance
+	20, // 9: api.indexer.ListHoldersByTickReply.data:type_name -> api.indexer.Balance
+	43, // 10: api.indexer.Tick.tokenomics:type_name -> api.indexer.Tick.Tokenomics
+	44, // 11: api.indexer.Tick.distribution_rule:type_name -> api.indexer.Tick.DistributionRule
+	27, // 12: api.indexer.GetTickReply.data:type_name -> api.indexer.Tick
+	27, // 13: api.indexer.ListTicksReply.data:type_name -> api.indexer.Tick
+	0,  // 14: api.indexer.ListEventsRequest.status:type_name -> api.indexer.EventStatus
+	46, // 15: api.indexer.ListEventsReply.data:type_name -> api.indexer.Event
+	1,  // 16: api.indexer.Transaction.state:type_name -> api.indexer.TransactionState
+	45, // 17: api.indexer.Transaction.records:type_name -> api.indexer.Transaction.Record
+	34, // 18: api.indexer.GetTransactionReply.data:type_name -> api.indexer.Transaction
+	46, // 19: api.indexer.QueryEventsReply.EventsByBlock.events:type_name -> api.indexer.Event
+	46, // 20: api.indexer.Transaction.Record.events:type_name -> api.indexer.Event
+	2,  // 21: api.indexer.Indexer.SubscribeEvent:input_type -> api.indexer.SubscribeRequest
+	4,  // 22: api.indexer.Indexer.SubscribeSystemStatus:input_type -> api.indexer.SubscribeSystemStatusRequest
+	6,  // 23: api.indexer.Indexer.QueryEvents:input_type -> api.indexer.QueryEventsRequest
+	8,  // 24: api.indexer.Indexer.QuerySystemStatus:input_type -> api.indexer.QuerySystemStatusRequest
+	10, // 25: api.indexer.Indexer.CheckTransfer:input_type -> api.indexer.CheckTransferRequest
+	12, // 26: api.indexer.Indexer.ListAllowances:input_type -> api.indexer.ListAllowancesRequest
+	14, // 27: api.indexer.Indexer.ListVestings:input_type -> api.indexer.ListVestingsRequest
+	16, // 28: api.indexer.Indexer.GetTickStats:input_type -> api.indexer.GetTickStatsRequest
+	18, // 29: api.indexer.Indexer.GetTickHolders:input_type -> api.indexer.GetTickHoldersRequest
+	21, // 30: api.indexer.Indexer.GetBalance:input_type -> api.indexer.GetBalanceRequest
+	23, // 31: api.indexer.Indexer.ListBalancesByAddress:input_type -> api.indexer.ListBalancesByAddressRequest
+	25, // 32: api.indexer.Indexer.ListHoldersByTick:input_type -> api.indexer.ListHoldersByTickRequest
+	28, // 33: api.indexer.Indexer.GetTick:input_type -> api.indexer.GetTickRequest
+	30, // 34: api.indexer.Indexer.ListTicks:input_type -> api.indexer.ListTicksRequest
+	32, // 35: api.indexer.Indexer.ListEvents:input_type -> api.indexer.ListEventsRequest
+	35, // 36: api.indexer.Indexer.GetTransaction:input_type -> api.indexer.GetTransactionRequest
+	3,  // 37: api.indexer.Indexer.SubscribeEvent:output_type -> api.indexer.SubscribeReply
+	5,  // 38: api.indexer.Indexer.SubscribeSystemStatus:output_type -> api.indexer.SubscribeSystemStatusReply
+	7,  // 39: api.indexer.Indexer.QueryEvents:output_type -> api.indexer.QueryEventsReply
+	9,  // 40: api.indexer.Indexer.QuerySystemStatus:output_type -> api.indexer.QuerySystemStatusReply
+	11, // 41: api.indexer.Indexer.CheckTransfer:output_type -> api.indexer.CheckTransferReply
+	13, // 42: api.indexer.Indexer.ListAllowances:output_type -> api.indexer.ListAllowancesReply
+	15, // 43: api.indexer.Indexer.ListVestings:output_type -> api.indexer.ListVestingsReply
+	17, // 44: api.indexer.Indexer.GetTickStats:output_type -> api.indexer.GetTickStatsReply
+	19, // 45: api.indexer.Indexer.GetTickHolders:output_type -> api.indexer.GetTickHoldersReply
+	22, // 46: api.indexer.Indexer.GetBalance:output_type -> api.indexer.GetBalanceReply
+	24, // 47: api.indexer.Indexer.ListBalancesByAddress:output_type -> api.indexer.ListBalancesByAddressReply
+	26, // 48: api.indexer.Indexer.ListHoldersByTick:output_type -> api.indexer.ListHoldersByTickReply
+	29, // 49: api.indexer.Indexer.GetTick:output_type -> api.indexer.GetTickReply
+	31, // 50: api.indexer.Indexer.ListTicks:output_type -> api.indexer.ListTicksReply
+	33, // 51: api.indexer.Indexer.ListEvents:output_type -> api.indexer.ListEventsReply
+	36, // 52: api.indexer.Indexer.GetTransaction:output_type -> api.indexer.GetTransactionReply
+	37, // [37:53] is the sub-list for method output_type
+	21, // [21:37] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_indexer_indexer_proto_init() }
+func file_indexer_indexer_proto_init() {
+	if File_indexer_indexer_proto != nil {
+		return
+	}
+	file_indexer_event_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_indexer_indexer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSystemStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSystemStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySystemStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySystemStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTransferReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeReply); i {
+		file_indexer_indexer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -874,8 +4180,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSystemStatusRequest); i {
+		file_indexer_indexer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowancesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -886,8 +4192,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSystemStatusReply); i {
+		file_indexer_indexer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVestingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -898,8 +4204,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEventsRequest); i {
+		file_indexer_indexer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVestingsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -910,8 +4216,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEventsReply); i {
+		file_indexer_indexer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -922,8 +4228,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySystemStatusRequest); i {
+		file_indexer_indexer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickStatsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -934,8 +4240,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySystemStatusReply); i {
+		file_indexer_indexer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickHoldersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -946,8 +4252,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckTransferRequest); i {
+		file_indexer_indexer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickHoldersReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -958,8 +4264,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckTransferReply); i {
+		file_indexer_indexer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Balance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -970,7 +4276,199 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesByAddressReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHoldersByTickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHoldersByTickReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTicksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTicksReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryEventsReply_EventsByBlock); i {
 			case 0:
 				return &v.state
@@ -982,7 +4480,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckTransferReply_TransferRecord); i {
 			case 0:
 				return &v.state
@@ -994,19 +4492,104 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
+		file_indexer_indexer_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowancesReply_Allowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVestingsReply_Vesting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickStatsReply_Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickHoldersReply_Holder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tick_Tokenomics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tick_DistributionRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction_Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_indexer_indexer_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_indexer_indexer_proto_goTypes,
 		DependencyIndexes: file_indexer_indexer_proto_depIdxs,
+		EnumInfos:         file_indexer_indexer_proto_enumTypes,
 		MessageInfos:      file_indexer_indexer_proto_msgTypes,
 	}.Build()
 	File_indexer_indexer_proto = out.File
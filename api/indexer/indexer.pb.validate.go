@@ -59,6 +59,10 @@ func (m *SubscribeRequest) validate(all bool) error {
 
 	// no validation rules for StartBlock
 
+	// no validation rules for SuccessOnly
+
+	// no validation rules for ResumeToken
+
 	if len(errors) > 0 {
 		return SubscribeRequestMultiError(errors)
 	}
@@ -197,6 +201,10 @@ func (m *SubscribeReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for ResumeToken
+
+	// no validation rules for CaughtUp
+
 	if len(errors) > 0 {
 		return SubscribeReplyMultiError(errors)
 	}
@@ -1169,49 +1177,153 @@ var _ interface {
 	ErrorName() string
 } = CheckTransferReplyValidationError{}
 
-// Validate checks the field values on QueryEventsReply_EventsByBlock with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListAllowancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryEventsReply_EventsByBlock) Validate() error {
+func (m *ListAllowancesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryEventsReply_EventsByBlock with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// QueryEventsReply_EventsByBlockMultiError, or nil if none found.
-func (m *QueryEventsReply_EventsByBlock) ValidateAll() error {
+// ValidateAll checks the field values on ListAllowancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAllowancesRequestMultiError, or nil if none found.
+func (m *ListAllowancesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryEventsReply_EventsByBlock) validate(all bool) error {
+func (m *ListAllowancesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BlockNumber
+	// no validation rules for Owner
 
-	// no validation rules for PrevBlockNumber
+	// no validation rules for Spender
 
-	for idx, item := range m.GetEvents() {
+	// no validation rules for Tick
+
+	if len(errors) > 0 {
+		return ListAllowancesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAllowancesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAllowancesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAllowancesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAllowancesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAllowancesRequestMultiError) AllErrors() []error { return m }
+
+// ListAllowancesRequestValidationError is the validation error returned by
+// ListAllowancesRequest.Validate if the designated constraints aren't met.
+type ListAllowancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAllowancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAllowancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAllowancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAllowancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAllowancesRequestValidationError) ErrorName() string {
+	return "ListAllowancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAllowancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAllowancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAllowancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAllowancesRequestValidationError{}
+
+// Validate checks the field values on ListAllowancesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAllowancesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAllowancesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAllowancesReplyMultiError, or nil if none found.
+func (m *ListAllowancesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAllowancesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, ListAllowancesReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, ListAllowancesReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1219,8 +1331,8 @@ func (m *QueryEventsReply_EventsByBlock) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return QueryEventsReply_EventsByBlockValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
+				return ListAllowancesReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1230,19 +1342,19 @@ func (m *QueryEventsReply_EventsByBlock) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return QueryEventsReply_EventsByBlockMultiError(errors)
+		return ListAllowancesReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryEventsReply_EventsByBlockMultiError is an error wrapping multiple
-// validation errors returned by QueryEventsReply_EventsByBlock.ValidateAll()
-// if the designated constraints aren't met.
-type QueryEventsReply_EventsByBlockMultiError []error
+// ListAllowancesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListAllowancesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListAllowancesReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryEventsReply_EventsByBlockMultiError) Error() string {
+func (m ListAllowancesReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1251,12 +1363,11 @@ func (m QueryEventsReply_EventsByBlockMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryEventsReply_EventsByBlockMultiError) AllErrors() []error { return m }
+func (m ListAllowancesReplyMultiError) AllErrors() []error { return m }
 
-// QueryEventsReply_EventsByBlockValidationError is the validation error
-// returned by QueryEventsReply_EventsByBlock.Validate if the designated
-// constraints aren't met.
-type QueryEventsReply_EventsByBlockValidationError struct {
+// ListAllowancesReplyValidationError is the validation error returned by
+// ListAllowancesReply.Validate if the designated constraints aren't met.
+type ListAllowancesReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1264,24 +1375,24 @@ type QueryEventsReply_EventsByBlockValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryEventsReply_EventsByBlockValidationError) Field() string { return e.field }
+func (e ListAllowancesReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryEventsReply_EventsByBlockValidationError) Reason() string { return e.reason }
+func (e ListAllowancesReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryEventsReply_EventsByBlockValidationError) Cause() error { return e.cause }
+func (e ListAllowancesReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryEventsReply_EventsByBlockValidationError) Key() bool { return e.key }
+func (e ListAllowancesReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryEventsReply_EventsByBlockValidationError) ErrorName() string {
-	return "QueryEventsReply_EventsByBlockValidationError"
+func (e ListAllowancesReplyValidationError) ErrorName() string {
+	return "ListAllowancesReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryEventsReply_EventsByBlockValidationError) Error() string {
+func (e ListAllowancesReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1293,14 +1404,14 @@ func (e QueryEventsReply_EventsByBlockValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryEventsReply_EventsByBlock.%s: %s%s",
+		"invalid %sListAllowancesReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryEventsReply_EventsByBlockValidationError{}
+var _ error = ListAllowancesReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1308,56 +1419,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryEventsReply_EventsByBlockValidationError{}
+} = ListAllowancesReplyValidationError{}
 
-// Validate checks the field values on CheckTransferReply_TransferRecord with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckTransferReply_TransferRecord) Validate() error {
+// Validate checks the field values on ListVestingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVestingsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckTransferReply_TransferRecord
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckTransferReply_TransferRecordMultiError, or nil if none found.
-func (m *CheckTransferReply_TransferRecord) ValidateAll() error {
+// ValidateAll checks the field values on ListVestingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVestingsRequestMultiError, or nil if none found.
+func (m *ListVestingsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckTransferReply_TransferRecord) validate(all bool) error {
+func (m *ListVestingsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Sender
+	// no validation rules for From
 
-	// no validation rules for Receiver
+	// no validation rules for Recipient
 
 	// no validation rules for Tick
 
-	// no validation rules for Amount
-
-	// no validation rules for Status
-
 	if len(errors) > 0 {
-		return CheckTransferReply_TransferRecordMultiError(errors)
+		return ListVestingsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckTransferReply_TransferRecordMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckTransferReply_TransferRecord.ValidateAll() if the designated
+// ListVestingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListVestingsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CheckTransferReply_TransferRecordMultiError []error
+type ListVestingsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckTransferReply_TransferRecordMultiError) Error() string {
+func (m ListVestingsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1366,12 +1471,11 @@ func (m CheckTransferReply_TransferRecordMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckTransferReply_TransferRecordMultiError) AllErrors() []error { return m }
+func (m ListVestingsRequestMultiError) AllErrors() []error { return m }
 
-// CheckTransferReply_TransferRecordValidationError is the validation error
-// returned by CheckTransferReply_TransferRecord.Validate if the designated
-// constraints aren't met.
-type CheckTransferReply_TransferRecordValidationError struct {
+// ListVestingsRequestValidationError is the validation error returned by
+// ListVestingsRequest.Validate if the designated constraints aren't met.
+type ListVestingsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1379,24 +1483,24 @@ type CheckTransferReply_TransferRecordValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckTransferReply_TransferRecordValidationError) Field() string { return e.field }
+func (e ListVestingsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckTransferReply_TransferRecordValidationError) Reason() string { return e.reason }
+func (e ListVestingsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckTransferReply_TransferRecordValidationError) Cause() error { return e.cause }
+func (e ListVestingsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckTransferReply_TransferRecordValidationError) Key() bool { return e.key }
+func (e ListVestingsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckTransferReply_TransferRecordValidationError) ErrorName() string {
-	return "CheckTransferReply_TransferRecordValidationError"
+func (e ListVestingsRequestValidationError) ErrorName() string {
+	return "ListVestingsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckTransferReply_TransferRecordValidationError) Error() string {
+func (e ListVestingsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1408,14 +1512,14 @@ func (e CheckTransferReply_TransferRecordValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckTransferReply_TransferRecord.%s: %s%s",
+		"invalid %sListVestingsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckTransferReply_TransferRecordValidationError{}
+var _ error = ListVestingsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1423,4 +1527,3896 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckTransferReply_TransferRecordValidationError{}
+} = ListVestingsRequestValidationError{}
+
+// Validate checks the field values on ListVestingsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListVestingsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVestingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVestingsReplyMultiError, or nil if none found.
+func (m *ListVestingsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVestingsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListVestingsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListVestingsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListVestingsReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListVestingsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVestingsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListVestingsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListVestingsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVestingsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVestingsReplyMultiError) AllErrors() []error { return m }
+
+// ListVestingsReplyValidationError is the validation error returned by
+// ListVestingsReply.Validate if the designated constraints aren't met.
+type ListVestingsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVestingsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVestingsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVestingsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVestingsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVestingsReplyValidationError) ErrorName() string {
+	return "ListVestingsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVestingsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVestingsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVestingsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVestingsReplyValidationError{}
+
+// Validate checks the field values on GetTickStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTickStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickStatsRequestMultiError, or nil if none found.
+func (m *GetTickStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	if len(errors) > 0 {
+		return GetTickStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTickStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTickStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetTickStatsRequestValidationError is the validation error returned by
+// GetTickStatsRequest.Validate if the designated constraints aren't met.
+type GetTickStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickStatsRequestValidationError) ErrorName() string {
+	return "GetTickStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickStatsRequestValidationError{}
+
+// Validate checks the field values on GetTickStatsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTickStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickStatsReplyMultiError, or nil if none found.
+func (m *GetTickStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Holders
+
+	for idx, item := range m.GetDistribution() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTickStatsReplyValidationError{
+						field:  fmt.Sprintf("Distribution[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTickStatsReplyValidationError{
+						field:  fmt.Sprintf("Distribution[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTickStatsReplyValidationError{
+					field:  fmt.Sprintf("Distribution[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FirstActivityBlock
+
+	// no validation rules for FirstActivityAt
+
+	// no validation rules for LastActivityBlock
+
+	// no validation rules for LastActivityAt
+
+	if len(errors) > 0 {
+		return GetTickStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickStatsReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTickStatsReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetTickStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetTickStatsReplyValidationError is the validation error returned by
+// GetTickStatsReply.Validate if the designated constraints aren't met.
+type GetTickStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickStatsReplyValidationError) ErrorName() string {
+	return "GetTickStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickStatsReplyValidationError{}
+
+// Validate checks the field values on GetTickHoldersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTickHoldersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickHoldersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickHoldersRequestMultiError, or nil if none found.
+func (m *GetTickHoldersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickHoldersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetTickHoldersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickHoldersRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTickHoldersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTickHoldersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickHoldersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickHoldersRequestMultiError) AllErrors() []error { return m }
+
+// GetTickHoldersRequestValidationError is the validation error returned by
+// GetTickHoldersRequest.Validate if the designated constraints aren't met.
+type GetTickHoldersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickHoldersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickHoldersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickHoldersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickHoldersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickHoldersRequestValidationError) ErrorName() string {
+	return "GetTickHoldersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickHoldersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickHoldersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickHoldersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickHoldersRequestValidationError{}
+
+// Validate checks the field values on GetTickHoldersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTickHoldersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickHoldersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickHoldersReplyMultiError, or nil if none found.
+func (m *GetTickHoldersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickHoldersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Holders
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTickHoldersReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTickHoldersReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTickHoldersReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTickHoldersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickHoldersReplyMultiError is an error wrapping multiple validation
+// errors returned by GetTickHoldersReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetTickHoldersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickHoldersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickHoldersReplyMultiError) AllErrors() []error { return m }
+
+// GetTickHoldersReplyValidationError is the validation error returned by
+// GetTickHoldersReply.Validate if the designated constraints aren't met.
+type GetTickHoldersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickHoldersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickHoldersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickHoldersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickHoldersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickHoldersReplyValidationError) ErrorName() string {
+	return "GetTickHoldersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickHoldersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickHoldersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickHoldersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickHoldersReplyValidationError{}
+
+// Validate checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Balance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BalanceMultiError, or nil if none found.
+func (m *Balance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Balance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Tick
+
+	// no validation rules for Available
+
+	// no validation rules for Freeze
+
+	// no validation rules for Minted
+
+	// no validation rules for LastUpdatedBlock
+
+	if len(errors) > 0 {
+		return BalanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceMultiError is an error wrapping multiple validation errors returned
+// by Balance.ValidateAll() if the designated constraints aren't met.
+type BalanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceMultiError) AllErrors() []error { return m }
+
+// BalanceValidationError is the validation error returned by Balance.Validate
+// if the designated constraints aren't met.
+type BalanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceValidationError) ErrorName() string { return "BalanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BalanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceValidationError{}
+
+// Validate checks the field values on GetBalanceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceRequestMultiError, or nil if none found.
+func (m *GetBalanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Tick
+
+	if len(errors) > 0 {
+		return GetBalanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBalanceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBalanceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceRequestMultiError) AllErrors() []error { return m }
+
+// GetBalanceRequestValidationError is the validation error returned by
+// GetBalanceRequest.Validate if the designated constraints aren't met.
+type GetBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceRequestValidationError) ErrorName() string {
+	return "GetBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceRequestValidationError{}
+
+// Validate checks the field values on GetBalanceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceReplyMultiError, or nil if none found.
+func (m *GetBalanceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBalanceReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBalanceReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBalanceReplyValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBalanceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBalanceReplyMultiError is an error wrapping multiple validation errors
+// returned by GetBalanceReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetBalanceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceReplyMultiError) AllErrors() []error { return m }
+
+// GetBalanceReplyValidationError is the validation error returned by
+// GetBalanceReply.Validate if the designated constraints aren't met.
+type GetBalanceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceReplyValidationError) ErrorName() string { return "GetBalanceReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBalanceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceReplyValidationError{}
+
+// Validate checks the field values on ListBalancesByAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalancesByAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalancesByAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalancesByAddressRequestMultiError, or nil if none found.
+func (m *ListBalancesByAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalancesByAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	// no validation rules for IncludeZero
+
+	if len(errors) > 0 {
+		return ListBalancesByAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalancesByAddressRequestMultiError is an error wrapping multiple
+// validation errors returned by ListBalancesByAddressRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListBalancesByAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalancesByAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalancesByAddressRequestMultiError) AllErrors() []error { return m }
+
+// ListBalancesByAddressRequestValidationError is the validation error returned
+// by ListBalancesByAddressRequest.Validate if the designated constraints
+// aren't met.
+type ListBalancesByAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalancesByAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalancesByAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalancesByAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalancesByAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalancesByAddressRequestValidationError) ErrorName() string {
+	return "ListBalancesByAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalancesByAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalancesByAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalancesByAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalancesByAddressRequestValidationError{}
+
+// Validate checks the field values on ListBalancesByAddressReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalancesByAddressReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalancesByAddressReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalancesByAddressReplyMultiError, or nil if none found.
+func (m *ListBalancesByAddressReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalancesByAddressReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBalancesByAddressReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBalancesByAddressReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBalancesByAddressReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListBalancesByAddressReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalancesByAddressReplyMultiError is an error wrapping multiple
+// validation errors returned by ListBalancesByAddressReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListBalancesByAddressReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalancesByAddressReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalancesByAddressReplyMultiError) AllErrors() []error { return m }
+
+// ListBalancesByAddressReplyValidationError is the validation error returned
+// by ListBalancesByAddressReply.Validate if the designated constraints aren't met.
+type ListBalancesByAddressReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalancesByAddressReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalancesByAddressReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalancesByAddressReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalancesByAddressReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalancesByAddressReplyValidationError) ErrorName() string {
+	return "ListBalancesByAddressReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalancesByAddressReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalancesByAddressReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalancesByAddressReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalancesByAddressReplyValidationError{}
+
+// Validate checks the field values on ListHoldersByTickRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHoldersByTickRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHoldersByTickRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHoldersByTickRequestMultiError, or nil if none found.
+func (m *ListHoldersByTickRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHoldersByTickRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListHoldersByTickRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHoldersByTickRequestMultiError is an error wrapping multiple validation
+// errors returned by ListHoldersByTickRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListHoldersByTickRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHoldersByTickRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHoldersByTickRequestMultiError) AllErrors() []error { return m }
+
+// ListHoldersByTickRequestValidationError is the validation error returned by
+// ListHoldersByTickRequest.Validate if the designated constraints aren't met.
+type ListHoldersByTickRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHoldersByTickRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHoldersByTickRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHoldersByTickRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHoldersByTickRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHoldersByTickRequestValidationError) ErrorName() string {
+	return "ListHoldersByTickRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHoldersByTickRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHoldersByTickRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHoldersByTickRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHoldersByTickRequestValidationError{}
+
+// Validate checks the field values on ListHoldersByTickReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHoldersByTickReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHoldersByTickReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHoldersByTickReplyMultiError, or nil if none found.
+func (m *ListHoldersByTickReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHoldersByTickReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListHoldersByTickReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListHoldersByTickReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHoldersByTickReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListHoldersByTickReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHoldersByTickReplyMultiError is an error wrapping multiple validation
+// errors returned by ListHoldersByTickReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListHoldersByTickReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHoldersByTickReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHoldersByTickReplyMultiError) AllErrors() []error { return m }
+
+// ListHoldersByTickReplyValidationError is the validation error returned by
+// ListHoldersByTickReply.Validate if the designated constraints aren't met.
+type ListHoldersByTickReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHoldersByTickReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHoldersByTickReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHoldersByTickReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHoldersByTickReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHoldersByTickReplyValidationError) ErrorName() string {
+	return "ListHoldersByTickReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHoldersByTickReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHoldersByTickReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHoldersByTickReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHoldersByTickReplyValidationError{}
+
+// Validate checks the field values on Tick with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tick) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tick with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TickMultiError, or nil if none found.
+func (m *Tick) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tick) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	// no validation rules for Protocol
+
+	// no validation rules for Decimals
+
+	// no validation rules for MaxSupply
+
+	// no validation rules for Supply
+
+	// no validation rules for MintProgress
+
+	// no validation rules for Mintable
+
+	// no validation rules for Creator
+
+	// no validation rules for DeployBlock
+
+	// no validation rules for DeployedAt
+
+	// no validation rules for LastUpdatedBlock
+
+	// no validation rules for Limit
+
+	// no validation rules for WalletLimit
+
+	// no validation rules for Workc
+
+	// no validation rules for BurnedAmount
+
+	for idx, item := range m.GetTokenomics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TickValidationError{
+						field:  fmt.Sprintf("Tokenomics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TickValidationError{
+						field:  fmt.Sprintf("Tokenomics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TickValidationError{
+					field:  fmt.Sprintf("Tokenomics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDistributionRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TickValidationError{
+					field:  "DistributionRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TickValidationError{
+					field:  "DistributionRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDistributionRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TickValidationError{
+				field:  "DistributionRule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PowSupply
+
+	// no validation rules for PowBurnAmount
+
+	// no validation rules for PosSupply
+
+	// no validation rules for PosBurnAmount
+
+	// no validation rules for AirdropAmount
+
+	// no validation rules for PowEmissionPerBlock
+
+	// no validation rules for PosEmissionPerBlock
+
+	if len(errors) > 0 {
+		return TickMultiError(errors)
+	}
+
+	return nil
+}
+
+// TickMultiError is an error wrapping multiple validation errors returned by
+// Tick.ValidateAll() if the designated constraints aren't met.
+type TickMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TickMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TickMultiError) AllErrors() []error { return m }
+
+// TickValidationError is the validation error returned by Tick.Validate if the
+// designated constraints aren't met.
+type TickValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TickValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TickValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TickValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TickValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TickValidationError) ErrorName() string { return "TickValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TickValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTick.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TickValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TickValidationError{}
+
+// Validate checks the field values on GetTickRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTickRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTickRequestMultiError,
+// or nil if none found.
+func (m *GetTickRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tick
+
+	if len(errors) > 0 {
+		return GetTickRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTickRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTickRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickRequestMultiError) AllErrors() []error { return m }
+
+// GetTickRequestValidationError is the validation error returned by
+// GetTickRequest.Validate if the designated constraints aren't met.
+type GetTickRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickRequestValidationError) ErrorName() string { return "GetTickRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTickRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickRequestValidationError{}
+
+// Validate checks the field values on GetTickReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTickReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTickReplyMultiError, or
+// nil if none found.
+func (m *GetTickReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTickReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTickReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTickReplyValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTickReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTickReply.ValidateAll() if the designated constraints aren't met.
+type GetTickReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickReplyMultiError) AllErrors() []error { return m }
+
+// GetTickReplyValidationError is the validation error returned by
+// GetTickReply.Validate if the designated constraints aren't met.
+type GetTickReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickReplyValidationError) ErrorName() string { return "GetTickReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTickReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickReplyValidationError{}
+
+// Validate checks the field values on ListTicksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTicksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTicksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTicksRequestMultiError, or nil if none found.
+func (m *ListTicksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTicksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for Creator
+
+	// no validation rules for Mintable
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListTicksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTicksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTicksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTicksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTicksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTicksRequestMultiError) AllErrors() []error { return m }
+
+// ListTicksRequestValidationError is the validation error returned by
+// ListTicksRequest.Validate if the designated constraints aren't met.
+type ListTicksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTicksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTicksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTicksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTicksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTicksRequestValidationError) ErrorName() string { return "ListTicksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTicksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTicksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTicksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTicksRequestValidationError{}
+
+// Validate checks the field values on ListTicksReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTicksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTicksReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTicksReplyMultiError,
+// or nil if none found.
+func (m *ListTicksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTicksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTicksReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTicksReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTicksReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListTicksReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTicksReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTicksReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTicksReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTicksReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTicksReplyMultiError) AllErrors() []error { return m }
+
+// ListTicksReplyValidationError is the validation error returned by
+// ListTicksReply.Validate if the designated constraints aren't met.
+type ListTicksReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTicksReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTicksReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTicksReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTicksReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTicksReplyValidationError) ErrorName() string { return "ListTicksReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTicksReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTicksReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTicksReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTicksReplyValidationError{}
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsRequestMultiError, or nil if none found.
+func (m *ListEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for IercFrom
+
+	// no validation rules for IercTo
+
+	// no validation rules for EthFrom
+
+	// no validation rules for Tick
+
+	// no validation rules for Operate
+
+	// no validation rules for TxHash
+
+	// no validation rules for Status
+
+	// no validation rules for After
+
+	// no validation rules for Before
+
+	// no validation rules for Desc
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on ListEventsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsReplyMultiError, or nil if none found.
+func (m *ListEventsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	// no validation rules for PrevCursor
+
+	if len(errors) > 0 {
+		return ListEventsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListEventsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsReplyMultiError) AllErrors() []error { return m }
+
+// ListEventsReplyValidationError is the validation error returned by
+// ListEventsReply.Validate if the designated constraints aren't met.
+type ListEventsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsReplyValidationError) ErrorName() string { return "ListEventsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListEventsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsReplyValidationError{}
+
+// Validate checks the field values on Transaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Transaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransactionMultiError, or
+// nil if none found.
+func (m *Transaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hash
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for PositionInTxs
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Value
+
+	// no validation rules for State
+
+	// no validation rules for Code
+
+	// no validation rules for Remark
+
+	// no validation rules for ErrorName
+
+	// no validation rules for Protocol
+
+	// no validation rules for Operate
+
+	// no validation rules for Command
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransactionValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransactionValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransactionValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TransactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionMultiError is an error wrapping multiple validation errors
+// returned by Transaction.ValidateAll() if the designated constraints aren't met.
+type TransactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionMultiError) AllErrors() []error { return m }
+
+// TransactionValidationError is the validation error returned by
+// Transaction.Validate if the designated constraints aren't met.
+type TransactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionValidationError) ErrorName() string { return "TransactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionValidationError{}
+
+// Validate checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionRequestMultiError, or nil if none found.
+func (m *GetTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hash
+
+	if len(errors) > 0 {
+		return GetTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionRequestMultiError) AllErrors() []error { return m }
+
+// GetTransactionRequestValidationError is the validation error returned by
+// GetTransactionRequest.Validate if the designated constraints aren't met.
+type GetTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionRequestValidationError) ErrorName() string {
+	return "GetTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionRequestValidationError{}
+
+// Validate checks the field values on GetTransactionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionReplyMultiError, or nil if none found.
+func (m *GetTransactionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransactionReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTransactionReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransactionReplyValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTransactionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionReplyMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionReplyMultiError) AllErrors() []error { return m }
+
+// GetTransactionReplyValidationError is the validation error returned by
+// GetTransactionReply.Validate if the designated constraints aren't met.
+type GetTransactionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionReplyValidationError) ErrorName() string {
+	return "GetTransactionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionReplyValidationError{}
+
+// Validate checks the field values on QueryEventsReply_EventsByBlock with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryEventsReply_EventsByBlock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryEventsReply_EventsByBlock with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryEventsReply_EventsByBlockMultiError, or nil if none found.
+func (m *QueryEventsReply_EventsByBlock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryEventsReply_EventsByBlock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for PrevBlockNumber
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryEventsReply_EventsByBlockValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryEventsReply_EventsByBlockValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryEventsReply_EventsByBlockMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryEventsReply_EventsByBlockMultiError is an error wrapping multiple
+// validation errors returned by QueryEventsReply_EventsByBlock.ValidateAll()
+// if the designated constraints aren't met.
+type QueryEventsReply_EventsByBlockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryEventsReply_EventsByBlockMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryEventsReply_EventsByBlockMultiError) AllErrors() []error { return m }
+
+// QueryEventsReply_EventsByBlockValidationError is the validation error
+// returned by QueryEventsReply_EventsByBlock.Validate if the designated
+// constraints aren't met.
+type QueryEventsReply_EventsByBlockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryEventsReply_EventsByBlockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryEventsReply_EventsByBlockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryEventsReply_EventsByBlockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryEventsReply_EventsByBlockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryEventsReply_EventsByBlockValidationError) ErrorName() string {
+	return "QueryEventsReply_EventsByBlockValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryEventsReply_EventsByBlockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryEventsReply_EventsByBlock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryEventsReply_EventsByBlockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryEventsReply_EventsByBlockValidationError{}
+
+// Validate checks the field values on CheckTransferReply_TransferRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckTransferReply_TransferRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckTransferReply_TransferRecord
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckTransferReply_TransferRecordMultiError, or nil if none found.
+func (m *CheckTransferReply_TransferRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckTransferReply_TransferRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sender
+
+	// no validation rules for Receiver
+
+	// no validation rules for Tick
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return CheckTransferReply_TransferRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckTransferReply_TransferRecordMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckTransferReply_TransferRecord.ValidateAll() if the designated
+// constraints aren't met.
+type CheckTransferReply_TransferRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckTransferReply_TransferRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckTransferReply_TransferRecordMultiError) AllErrors() []error { return m }
+
+// CheckTransferReply_TransferRecordValidationError is the validation error
+// returned by CheckTransferReply_TransferRecord.Validate if the designated
+// constraints aren't met.
+type CheckTransferReply_TransferRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckTransferReply_TransferRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckTransferReply_TransferRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckTransferReply_TransferRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckTransferReply_TransferRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckTransferReply_TransferRecordValidationError) ErrorName() string {
+	return "CheckTransferReply_TransferRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckTransferReply_TransferRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckTransferReply_TransferRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckTransferReply_TransferRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckTransferReply_TransferRecordValidationError{}
+
+// Validate checks the field values on ListAllowancesReply_Allowance with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAllowancesReply_Allowance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAllowancesReply_Allowance with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAllowancesReply_AllowanceMultiError, or nil if none found.
+func (m *ListAllowancesReply_Allowance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAllowancesReply_Allowance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Owner
+
+	// no validation rules for Spender
+
+	// no validation rules for Tick
+
+	// no validation rules for Amount
+
+	// no validation rules for SignNonce
+
+	// no validation rules for LastUpdatedBlock
+
+	if len(errors) > 0 {
+		return ListAllowancesReply_AllowanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAllowancesReply_AllowanceMultiError is an error wrapping multiple
+// validation errors returned by ListAllowancesReply_Allowance.ValidateAll()
+// if the designated constraints aren't met.
+type ListAllowancesReply_AllowanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAllowancesReply_AllowanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAllowancesReply_AllowanceMultiError) AllErrors() []error { return m }
+
+// ListAllowancesReply_AllowanceValidationError is the validation error
+// returned by ListAllowancesReply_Allowance.Validate if the designated
+// constraints aren't met.
+type ListAllowancesReply_AllowanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAllowancesReply_AllowanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAllowancesReply_AllowanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAllowancesReply_AllowanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAllowancesReply_AllowanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAllowancesReply_AllowanceValidationError) ErrorName() string {
+	return "ListAllowancesReply_AllowanceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAllowancesReply_AllowanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAllowancesReply_Allowance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAllowancesReply_AllowanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAllowancesReply_AllowanceValidationError{}
+
+// Validate checks the field values on ListVestingsReply_Vesting with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVestingsReply_Vesting) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVestingsReply_Vesting with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVestingsReply_VestingMultiError, or nil if none found.
+func (m *ListVestingsReply_Vesting) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVestingsReply_Vesting) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VestId
+
+	// no validation rules for Tick
+
+	// no validation rules for From
+
+	// no validation rules for Recipient
+
+	// no validation rules for Total
+
+	// no validation rules for Released
+
+	// no validation rules for Releasable
+
+	// no validation rules for StartBlock
+
+	// no validation rules for CliffBlock
+
+	// no validation rules for EndBlock
+
+	if len(errors) > 0 {
+		return ListVestingsReply_VestingMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVestingsReply_VestingMultiError is an error wrapping multiple validation
+// errors returned by ListVestingsReply_Vesting.ValidateAll() if the
+// designated constraints aren't met.
+type ListVestingsReply_VestingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVestingsReply_VestingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVestingsReply_VestingMultiError) AllErrors() []error { return m }
+
+// ListVestingsReply_VestingValidationError is the validation error returned by
+// ListVestingsReply_Vesting.Validate if the designated constraints aren't met.
+type ListVestingsReply_VestingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVestingsReply_VestingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVestingsReply_VestingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVestingsReply_VestingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVestingsReply_VestingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVestingsReply_VestingValidationError) ErrorName() string {
+	return "ListVestingsReply_VestingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVestingsReply_VestingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVestingsReply_Vesting.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVestingsReply_VestingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVestingsReply_VestingValidationError{}
+
+// Validate checks the field values on GetTickStatsReply_Bucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTickStatsReply_Bucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickStatsReply_Bucket with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickStatsReply_BucketMultiError, or nil if none found.
+func (m *GetTickStatsReply_Bucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickStatsReply_Bucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Label
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Holders
+
+	if len(errors) > 0 {
+		return GetTickStatsReply_BucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickStatsReply_BucketMultiError is an error wrapping multiple validation
+// errors returned by GetTickStatsReply_Bucket.ValidateAll() if the designated
+// constraints aren't met.
+type GetTickStatsReply_BucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickStatsReply_BucketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickStatsReply_BucketMultiError) AllErrors() []error { return m }
+
+// GetTickStatsReply_BucketValidationError is the validation error returned by
+// GetTickStatsReply_Bucket.Validate if the designated constraints aren't met.
+type GetTickStatsReply_BucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickStatsReply_BucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickStatsReply_BucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickStatsReply_BucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickStatsReply_BucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickStatsReply_BucketValidationError) ErrorName() string {
+	return "GetTickStatsReply_BucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickStatsReply_BucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickStatsReply_Bucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickStatsReply_BucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickStatsReply_BucketValidationError{}
+
+// Validate checks the field values on GetTickHoldersReply_Holder with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTickHoldersReply_Holder) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTickHoldersReply_Holder with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTickHoldersReply_HolderMultiError, or nil if none found.
+func (m *GetTickHoldersReply_Holder) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTickHoldersReply_Holder) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rank
+
+	// no validation rules for Address
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return GetTickHoldersReply_HolderMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTickHoldersReply_HolderMultiError is an error wrapping multiple
+// validation errors returned by GetTickHoldersReply_Holder.ValidateAll() if
+// the designated constraints aren't met.
+type GetTickHoldersReply_HolderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTickHoldersReply_HolderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTickHoldersReply_HolderMultiError) AllErrors() []error { return m }
+
+// GetTickHoldersReply_HolderValidationError is the validation error returned
+// by GetTickHoldersReply_Holder.Validate if the designated constraints aren't met.
+type GetTickHoldersReply_HolderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTickHoldersReply_HolderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTickHoldersReply_HolderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTickHoldersReply_HolderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTickHoldersReply_HolderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTickHoldersReply_HolderValidationError) ErrorName() string {
+	return "GetTickHoldersReply_HolderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTickHoldersReply_HolderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTickHoldersReply_Holder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTickHoldersReply_HolderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTickHoldersReply_HolderValidationError{}
+
+// Validate checks the field values on Tick_Tokenomics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Tick_Tokenomics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tick_Tokenomics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Tick_TokenomicsMultiError, or nil if none found.
+func (m *Tick_Tokenomics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tick_Tokenomics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return Tick_TokenomicsMultiError(errors)
+	}
+
+	return nil
+}
+
+// Tick_TokenomicsMultiError is an error wrapping multiple validation errors
+// returned by Tick_Tokenomics.ValidateAll() if the designated constraints
+// aren't met.
+type Tick_TokenomicsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Tick_TokenomicsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Tick_TokenomicsMultiError) AllErrors() []error { return m }
+
+// Tick_TokenomicsValidationError is the validation error returned by
+// Tick_Tokenomics.Validate if the designated constraints aren't met.
+type Tick_TokenomicsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Tick_TokenomicsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Tick_TokenomicsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Tick_TokenomicsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Tick_TokenomicsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Tick_TokenomicsValidationError) ErrorName() string { return "Tick_TokenomicsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Tick_TokenomicsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTick_Tokenomics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Tick_TokenomicsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Tick_TokenomicsValidationError{}
+
+// Validate checks the field values on Tick_DistributionRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Tick_DistributionRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tick_DistributionRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Tick_DistributionRuleMultiError, or nil if none found.
+func (m *Tick_DistributionRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tick_DistributionRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PowRatio
+
+	// no validation rules for MinWorkc
+
+	// no validation rules for DifficultyRatio
+
+	// no validation rules for PosRatio
+
+	// no validation rules for PosPool
+
+	// no validation rules for MaxRewardBlock
+
+	if len(errors) > 0 {
+		return Tick_DistributionRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// Tick_DistributionRuleMultiError is an error wrapping multiple validation
+// errors returned by Tick_DistributionRule.ValidateAll() if the designated
+// constraints aren't met.
+type Tick_DistributionRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Tick_DistributionRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Tick_DistributionRuleMultiError) AllErrors() []error { return m }
+
+// Tick_DistributionRuleValidationError is the validation error returned by
+// Tick_DistributionRule.Validate if the designated constraints aren't met.
+type Tick_DistributionRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Tick_DistributionRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Tick_DistributionRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Tick_DistributionRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Tick_DistributionRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Tick_DistributionRuleValidationError) ErrorName() string {
+	return "Tick_DistributionRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Tick_DistributionRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTick_DistributionRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Tick_DistributionRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Tick_DistributionRuleValidationError{}
+
+// Validate checks the field values on Transaction_Record with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Transaction_Record) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transaction_Record with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Transaction_RecordMultiError, or nil if none found.
+func (m *Transaction_Record) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transaction_Record) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Position
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Transaction_RecordValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Transaction_RecordValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Transaction_RecordValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return Transaction_RecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// Transaction_RecordMultiError is an error wrapping multiple validation errors
+// returned by Transaction_Record.ValidateAll() if the designated constraints
+// aren't met.
+type Transaction_RecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Transaction_RecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Transaction_RecordMultiError) AllErrors() []error { return m }
+
+// Transaction_RecordValidationError is the validation error returned by
+// Transaction_Record.Validate if the designated constraints aren't met.
+type Transaction_RecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Transaction_RecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Transaction_RecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Transaction_RecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Transaction_RecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Transaction_RecordValidationError) ErrorName() string {
+	return "Transaction_RecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Transaction_RecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransaction_Record.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Transaction_RecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Transaction_RecordValidationError{}
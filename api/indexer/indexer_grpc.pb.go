@@ -24,21 +24,56 @@ const (
 	Indexer_QueryEvents_FullMethodName           = "/api.indexer.Indexer/QueryEvents"
 	Indexer_QuerySystemStatus_FullMethodName     = "/api.indexer.Indexer/QuerySystemStatus"
 	Indexer_CheckTransfer_FullMethodName         = "/api.indexer.Indexer/CheckTransfer"
+	Indexer_ListAllowances_FullMethodName        = "/api.indexer.Indexer/ListAllowances"
+	Indexer_ListVestings_FullMethodName          = "/api.indexer.Indexer/ListVestings"
+	Indexer_GetTickStats_FullMethodName          = "/api.indexer.Indexer/GetTickStats"
+	Indexer_GetTickHolders_FullMethodName        = "/api.indexer.Indexer/GetTickHolders"
+	Indexer_GetBalance_FullMethodName            = "/api.indexer.Indexer/GetBalance"
+	Indexer_ListBalancesByAddress_FullMethodName = "/api.indexer.Indexer/ListBalancesByAddress"
+	Indexer_ListHoldersByTick_FullMethodName     = "/api.indexer.Indexer/ListHoldersByTick"
+	Indexer_GetTick_FullMethodName               = "/api.indexer.Indexer/GetTick"
+	Indexer_ListTicks_FullMethodName             = "/api.indexer.Indexer/ListTicks"
+	Indexer_ListEvents_FullMethodName            = "/api.indexer.Indexer/ListEvents"
+	Indexer_GetTransaction_FullMethodName        = "/api.indexer.Indexer/GetTransaction"
 )
 
 // IndexerClient is the client API for Indexer service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type IndexerClient interface {
-	// 订阅事件
+	// 订阅事件. 浏览器可以通过 GET /api/v2/stream/events 以 WebSocket 或 SSE 订阅, 参数以 query 传递,
+	// SSE 断线重连时 Last-Event-ID 作为续订令牌
 	SubscribeEvent(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Indexer_SubscribeEventClient, error)
-	// 查询 索引状态
+	// 查询 索引状态. 浏览器可以通过 GET /api/v2/stream/status 以 WebSocket 或 SSE 订阅
 	SubscribeSystemStatus(ctx context.Context, in *SubscribeSystemStatusRequest, opts ...grpc.CallOption) (Indexer_SubscribeSystemStatusClient, error)
 	// 订阅事件
 	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsReply, error)
 	// 查询 索引状态
 	QuerySystemStatus(ctx context.Context, in *QuerySystemStatusRequest, opts ...grpc.CallOption) (*QuerySystemStatusReply, error)
+	// 已废弃, 使用 GetTransaction
 	CheckTransfer(ctx context.Context, in *CheckTransferRequest, opts ...grpc.CallOption) (*CheckTransferReply, error)
+	// 查询 授权额度
+	ListAllowances(ctx context.Context, in *ListAllowancesRequest, opts ...grpc.CallOption) (*ListAllowancesReply, error)
+	// 查询 锁仓
+	ListVestings(ctx context.Context, in *ListVestingsRequest, opts ...grpc.CallOption) (*ListVestingsReply, error)
+	// 查询 tick 的持仓统计
+	GetTickStats(ctx context.Context, in *GetTickStatsRequest, opts ...grpc.CallOption) (*GetTickStatsReply, error)
+	// 查询 tick 的持仓排行
+	GetTickHolders(ctx context.Context, in *GetTickHoldersRequest, opts ...grpc.CallOption) (*GetTickHoldersReply, error)
+	// 查询 地址在某个 tick 的余额
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceReply, error)
+	// 查询 地址持有的所有 tick 的余额
+	ListBalancesByAddress(ctx context.Context, in *ListBalancesByAddressRequest, opts ...grpc.CallOption) (*ListBalancesByAddressReply, error)
+	// 查询 tick 的持仓地址及余额, 按持仓数量倒序
+	ListHoldersByTick(ctx context.Context, in *ListHoldersByTickRequest, opts ...grpc.CallOption) (*ListHoldersByTickReply, error)
+	// 查询 tick 详情
+	GetTick(ctx context.Context, in *GetTickRequest, opts ...grpc.CallOption) (*GetTickReply, error)
+	// 查询 tick 列表, 按部署顺序
+	ListTicks(ctx context.Context, in *ListTicksRequest, opts ...grpc.CallOption) (*ListTicksReply, error)
+	// 按条件分页查询事件
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsReply, error)
+	// 查询交易的处理状态及产生的事件, 支持所有协议操作
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionReply, error)
 }
 
 type indexerClient struct {
@@ -140,19 +175,142 @@ func (c *indexerClient) CheckTransfer(ctx context.Context, in *CheckTransferRequ
 	return out, nil
 }
 
+func (c *indexerClient) ListAllowances(ctx context.Context, in *ListAllowancesRequest, opts ...grpc.CallOption) (*ListAllowancesReply, error) {
+	out := new(ListAllowancesReply)
+	err := c.cc.Invoke(ctx, Indexer_ListAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListVestings(ctx context.Context, in *ListVestingsRequest, opts ...grpc.CallOption) (*ListVestingsReply, error) {
+	out := new(ListVestingsReply)
+	err := c.cc.Invoke(ctx, Indexer_ListVestings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTickStats(ctx context.Context, in *GetTickStatsRequest, opts ...grpc.CallOption) (*GetTickStatsReply, error) {
+	out := new(GetTickStatsReply)
+	err := c.cc.Invoke(ctx, Indexer_GetTickStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTickHolders(ctx context.Context, in *GetTickHoldersRequest, opts ...grpc.CallOption) (*GetTickHoldersReply, error) {
+	out := new(GetTickHoldersReply)
+	err := c.cc.Invoke(ctx, Indexer_GetTickHolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceReply, error) {
+	out := new(GetBalanceReply)
+	err := c.cc.Invoke(ctx, Indexer_GetBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListBalancesByAddress(ctx context.Context, in *ListBalancesByAddressRequest, opts ...grpc.CallOption) (*ListBalancesByAddressReply, error) {
+	out := new(ListBalancesByAddressReply)
+	err := c.cc.Invoke(ctx, Indexer_ListBalancesByAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListHoldersByTick(ctx context.Context, in *ListHoldersByTickRequest, opts ...grpc.CallOption) (*ListHoldersByTickReply, error) {
+	out := new(ListHoldersByTickReply)
+	err := c.cc.Invoke(ctx, Indexer_ListHoldersByTick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTick(ctx context.Context, in *GetTickRequest, opts ...grpc.CallOption) (*GetTickReply, error) {
+	out := new(GetTickReply)
+	err := c.cc.Invoke(ctx, Indexer_GetTick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListTicks(ctx context.Context, in *ListTicksRequest, opts ...grpc.CallOption) (*ListTicksReply, error) {
+	out := new(ListTicksReply)
+	err := c.cc.Invoke(ctx, Indexer_ListTicks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsReply, error) {
+	out := new(ListEventsReply)
+	err := c.cc.Invoke(ctx, Indexer_ListEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionReply, error) {
+	out := new(GetTransactionReply)
+	err := c.cc.Invoke(ctx, Indexer_GetTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexerServer is the server API for Indexer service.
 // All implementations must embed UnimplementedIndexerServer
 // for forward compatibility
 type IndexerServer interface {
-	// 订阅事件
+	// 订阅事件. 浏览器可以通过 GET /api/v2/stream/events 以 WebSocket 或 SSE 订阅, 参数以 query 传递,
+	// SSE 断线重连时 Last-Event-ID 作为续订令牌
 	SubscribeEvent(*SubscribeRequest, Indexer_SubscribeEventServer) error
-	// 查询 索引状态
+	// 查询 索引状态. 浏览器可以通过 GET /api/v2/stream/status 以 WebSocket 或 SSE 订阅
 	SubscribeSystemStatus(*SubscribeSystemStatusRequest, Indexer_SubscribeSystemStatusServer) error
 	// 订阅事件
 	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsReply, error)
 	// 查询 索引状态
 	QuerySystemStatus(context.Context, *QuerySystemStatusRequest) (*QuerySystemStatusReply, error)
+	// 已废弃, 使用 GetTransaction
 	CheckTransfer(context.Context, *CheckTransferRequest) (*CheckTransferReply, error)
+	// 查询 授权额度
+	ListAllowances(context.Context, *ListAllowancesRequest) (*ListAllowancesReply, error)
+	// 查询 锁仓
+	ListVestings(context.Context, *ListVestingsRequest) (*ListVestingsReply, error)
+	// 查询 tick 的持仓统计
+	GetTickStats(context.Context, *GetTickStatsRequest) (*GetTickStatsReply, error)
+	// 查询 tick 的持仓排行
+	GetTickHolders(context.Context, *GetTickHoldersRequest) (*GetTickHoldersReply, error)
+	// 查询 地址在某个 tick 的余额
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceReply, error)
+	// 查询 地址持有的所有 tick 的余额
+	ListBalancesByAddress(context.Context, *ListBalancesByAddressRequest) (*ListBalancesByAddressReply, error)
+	// 查询 tick 的持仓地址及余额, 按持仓数量倒序
+	ListHoldersByTick(context.Context, *ListHoldersByTickRequest) (*ListHoldersByTickReply, error)
+	// 查询 tick 详情
+	GetTick(context.Context, *GetTickRequest) (*GetTickReply, error)
+	// 查询 tick 列表, 按部署顺序
+	ListTicks(context.Context, *ListTicksRequest) (*ListTicksReply, error)
+	// 按条件分页查询事件
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsReply, error)
+	// 查询交易的处理状态及产生的事件, 支持所有协议操作
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionReply, error)
 	mustEmbedUnimplementedIndexerServer()
 }
 
@@ -175,6 +333,39 @@ func (UnimplementedIndexerServer) QuerySystemStatus(context.Context, *QuerySyste
 func (UnimplementedIndexerServer) CheckTransfer(context.Context, *CheckTransferRequest) (*CheckTransferReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckTransfer not implemented")
 }
+func (UnimplementedIndexerServer) ListAllowances(context.Context, *ListAllowancesRequest) (*ListAllowancesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowances not implemented")
+}
+func (UnimplementedIndexerServer) ListVestings(context.Context, *ListVestingsRequest) (*ListVestingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVestings not implemented")
+}
+func (UnimplementedIndexerServer) GetTickStats(context.Context, *GetTickStatsRequest) (*GetTickStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickStats not implemented")
+}
+func (UnimplementedIndexerServer) GetTickHolders(context.Context, *GetTickHoldersRequest) (*GetTickHoldersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickHolders not implemented")
+}
+func (UnimplementedIndexerServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedIndexerServer) ListBalancesByAddress(context.Context, *ListBalancesByAddressRequest) (*ListBalancesByAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalancesByAddress not implemented")
+}
+func (UnimplementedIndexerServer) ListHoldersByTick(context.Context, *ListHoldersByTickRequest) (*ListHoldersByTickReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHoldersByTick not implemented")
+}
+func (UnimplementedIndexerServer) GetTick(context.Context, *GetTickRequest) (*GetTickReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTick not implemented")
+}
+func (UnimplementedIndexerServer) ListTicks(context.Context, *ListTicksRequest) (*ListTicksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicks not implemented")
+}
+func (UnimplementedIndexerServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedIndexerServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
 func (UnimplementedIndexerServer) mustEmbedUnimplementedIndexerServer() {}
 
 // UnsafeIndexerServer may be embedded to opt out of forward compatibility for this service.
@@ -284,6 +475,204 @@ func _Indexer_CheckTransfer_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Indexer_ListAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListAllowances(ctx, req.(*ListAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListVestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListVestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListVestings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListVestings(ctx, req.(*ListVestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTickStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTickStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetTickStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTickStats(ctx, req.(*GetTickStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTickHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTickHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetTickHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTickHolders(ctx, req.(*GetTickHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListBalancesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalancesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListBalancesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListBalancesByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListBalancesByAddress(ctx, req.(*ListBalancesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListHoldersByTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHoldersByTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListHoldersByTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListHoldersByTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListHoldersByTick(ctx, req.(*ListHoldersByTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTick(ctx, req.(*GetTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListTicks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListTicks(ctx, req.(*ListTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Indexer_ServiceDesc is the grpc.ServiceDesc for Indexer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -303,6 +692,50 @@ var Indexer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckTransfer",
 			Handler:    _Indexer_CheckTransfer_Handler,
 		},
+		{
+			MethodName: "ListAllowances",
+			Handler:    _Indexer_ListAllowances_Handler,
+		},
+		{
+			MethodName: "ListVestings",
+			Handler:    _Indexer_ListVestings_Handler,
+		},
+		{
+			MethodName: "GetTickStats",
+			Handler:    _Indexer_GetTickStats_Handler,
+		},
+		{
+			MethodName: "GetTickHolders",
+			Handler:    _Indexer_GetTickHolders_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _Indexer_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListBalancesByAddress",
+			Handler:    _Indexer_ListBalancesByAddress_Handler,
+		},
+		{
+			MethodName: "ListHoldersByTick",
+			Handler:    _Indexer_ListHoldersByTick_Handler,
+		},
+		{
+			MethodName: "GetTick",
+			Handler:    _Indexer_GetTick_Handler,
+		},
+		{
+			MethodName: "ListTicks",
+			Handler:    _Indexer_ListTicks_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _Indexer_ListEvents_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Indexer_GetTransaction_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -20,11 +20,45 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationIndexerCheckTransfer = "/api.indexer.Indexer/CheckTransfer"
+const OperationIndexerGetBalance = "/api.indexer.Indexer/GetBalance"
+const OperationIndexerGetTick = "/api.indexer.Indexer/GetTick"
+const OperationIndexerGetTickHolders = "/api.indexer.Indexer/GetTickHolders"
+const OperationIndexerGetTickStats = "/api.indexer.Indexer/GetTickStats"
+const OperationIndexerGetTransaction = "/api.indexer.Indexer/GetTransaction"
+const OperationIndexerListAllowances = "/api.indexer.Indexer/ListAllowances"
+const OperationIndexerListBalancesByAddress = "/api.indexer.Indexer/ListBalancesByAddress"
+const OperationIndexerListEvents = "/api.indexer.Indexer/ListEvents"
+const OperationIndexerListHoldersByTick = "/api.indexer.Indexer/ListHoldersByTick"
+const OperationIndexerListTicks = "/api.indexer.Indexer/ListTicks"
+const OperationIndexerListVestings = "/api.indexer.Indexer/ListVestings"
 const OperationIndexerQueryEvents = "/api.indexer.Indexer/QueryEvents"
 const OperationIndexerQuerySystemStatus = "/api.indexer.Indexer/QuerySystemStatus"
 
 type IndexerHTTPServer interface {
+	// CheckTransfer 已废弃, 使用 GetTransaction
 	CheckTransfer(context.Context, *CheckTransferRequest) (*CheckTransferReply, error)
+	// GetBalance 查询 地址在某个 tick 的余额
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceReply, error)
+	// GetTick 查询 tick 详情
+	GetTick(context.Context, *GetTickRequest) (*GetTickReply, error)
+	// GetTickHolders 查询 tick 的持仓排行
+	GetTickHolders(context.Context, *GetTickHoldersRequest) (*GetTickHoldersReply, error)
+	// GetTickStats 查询 tick 的持仓统计
+	GetTickStats(context.Context, *GetTickStatsRequest) (*GetTickStatsReply, error)
+	// GetTransaction 查询交易的处理状态及产生的事件, 支持所有协议操作
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionReply, error)
+	// ListAllowances 查询 授权额度
+	ListAllowances(context.Context, *ListAllowancesRequest) (*ListAllowancesReply, error)
+	// ListBalancesByAddress 查询 地址持有的所有 tick 的余额
+	ListBalancesByAddress(context.Context, *ListBalancesByAddressRequest) (*ListBalancesByAddressReply, error)
+	// ListEvents 按条件分页查询事件
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsReply, error)
+	// ListHoldersByTick 查询 tick 的持仓地址及余额, 按持仓数量倒序
+	ListHoldersByTick(context.Context, *ListHoldersByTickRequest) (*ListHoldersByTickReply, error)
+	// ListTicks 查询 tick 列表, 按部署顺序
+	ListTicks(context.Context, *ListTicksRequest) (*ListTicksReply, error)
+	// ListVestings 查询 锁仓
+	ListVestings(context.Context, *ListVestingsRequest) (*ListVestingsReply, error)
 	// QueryEvents 订阅事件
 	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsReply, error)
 	// QuerySystemStatus 查询 索引状态
@@ -36,6 +70,17 @@ func RegisterIndexerHTTPServer(s *http.Server, srv IndexerHTTPServer) {
 	r.GET("/api/v2/index/events", _Indexer_QueryEvents0_HTTP_Handler(srv))
 	r.GET("/api/v2/index/status", _Indexer_QuerySystemStatus0_HTTP_Handler(srv))
 	r.GET("/api/v2/index/check_transfer", _Indexer_CheckTransfer0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/allowances", _Indexer_ListAllowances0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/vestings", _Indexer_ListVestings0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/tick/stats", _Indexer_GetTickStats0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/tick/holders", _Indexer_GetTickHolders0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/balance", _Indexer_GetBalance0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/balances", _Indexer_ListBalancesByAddress0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/tick/balances", _Indexer_ListHoldersByTick0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/tick", _Indexer_GetTick0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/ticks", _Indexer_ListTicks0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/events/list", _Indexer_ListEvents0_HTTP_Handler(srv))
+	r.GET("/api/v2/index/transaction", _Indexer_GetTransaction0_HTTP_Handler(srv))
 }
 
 func _Indexer_QueryEvents0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
@@ -95,8 +140,228 @@ func _Indexer_CheckTransfer0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.C
 	}
 }
 
+func _Indexer_ListAllowances0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAllowancesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListAllowances)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAllowances(ctx, req.(*ListAllowancesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAllowancesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListVestings0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListVestingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListVestings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVestings(ctx, req.(*ListVestingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListVestingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetTickStats0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTickStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetTickStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTickStats(ctx, req.(*GetTickStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTickStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetTickHolders0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTickHoldersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetTickHolders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTickHolders(ctx, req.(*GetTickHoldersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTickHoldersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetBalance0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBalanceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetBalance)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBalance(ctx, req.(*GetBalanceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBalanceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListBalancesByAddress0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBalancesByAddressRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListBalancesByAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBalancesByAddress(ctx, req.(*ListBalancesByAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBalancesByAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListHoldersByTick0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListHoldersByTickRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListHoldersByTick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListHoldersByTick(ctx, req.(*ListHoldersByTickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListHoldersByTickReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetTick0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTickRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetTick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTick(ctx, req.(*GetTickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTickReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListTicks0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTicksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListTicks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTicks(ctx, req.(*ListTicksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTicksReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_ListEvents0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerListEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEvents(ctx, req.(*ListEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEventsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Indexer_GetTransaction0_HTTP_Handler(srv IndexerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTransactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationIndexerGetTransaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTransaction(ctx, req.(*GetTransactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTransactionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type IndexerHTTPClient interface {
 	CheckTransfer(ctx context.Context, req *CheckTransferRequest, opts ...http.CallOption) (rsp *CheckTransferReply, err error)
+	GetBalance(ctx context.Context, req *GetBalanceRequest, opts ...http.CallOption) (rsp *GetBalanceReply, err error)
+	GetTick(ctx context.Context, req *GetTickRequest, opts ...http.CallOption) (rsp *GetTickReply, err error)
+	GetTickHolders(ctx context.Context, req *GetTickHoldersRequest, opts ...http.CallOption) (rsp *GetTickHoldersReply, err error)
+	GetTickStats(ctx context.Context, req *GetTickStatsRequest, opts ...http.CallOption) (rsp *GetTickStatsReply, err error)
+	GetTransaction(ctx context.Context, req *GetTransactionRequest, opts ...http.CallOption) (rsp *GetTransactionReply, err error)
+	ListAllowances(ctx context.Context, req *ListAllowancesRequest, opts ...http.CallOption) (rsp *ListAllowancesReply, err error)
+	ListBalancesByAddress(ctx context.Context, req *ListBalancesByAddressRequest, opts ...http.CallOption) (rsp *ListBalancesByAddressReply, err error)
+	ListEvents(ctx context.Context, req *ListEventsRequest, opts ...http.CallOption) (rsp *ListEventsReply, err error)
+	ListHoldersByTick(ctx context.Context, req *ListHoldersByTickRequest, opts ...http.CallOption) (rsp *ListHoldersByTickReply, err error)
+	ListTicks(ctx context.Context, req *ListTicksRequest, opts ...http.CallOption) (rsp *ListTicksReply, err error)
+	ListVestings(ctx context.Context, req *ListVestingsRequest, opts ...http.CallOption) (rsp *ListVestingsReply, err error)
 	QueryEvents(ctx context.Context, req *QueryEventsRequest, opts ...http.CallOption) (rsp *QueryEventsReply, err error)
 	QuerySystemStatus(ctx context.Context, req *QuerySystemStatusRequest, opts ...http.CallOption) (rsp *QuerySystemStatusReply, err error)
 }
@@ -122,6 +387,149 @@ func (c *IndexerHTTPClientImpl) CheckTransfer(ctx context.Context, in *CheckTran
 	return &out, err
 }
 
+func (c *IndexerHTTPClientImpl) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...http.CallOption) (*GetBalanceReply, error) {
+	var out GetBalanceReply
+	pattern := "/api/v2/index/balance"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetBalance))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetTick(ctx context.Context, in *GetTickRequest, opts ...http.CallOption) (*GetTickReply, error) {
+	var out GetTickReply
+	pattern := "/api/v2/index/tick"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetTick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetTickHolders(ctx context.Context, in *GetTickHoldersRequest, opts ...http.CallOption) (*GetTickHoldersReply, error) {
+	var out GetTickHoldersReply
+	pattern := "/api/v2/index/tick/holders"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetTickHolders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetTickStats(ctx context.Context, in *GetTickStatsRequest, opts ...http.CallOption) (*GetTickStatsReply, error) {
+	var out GetTickStatsReply
+	pattern := "/api/v2/index/tick/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetTickStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...http.CallOption) (*GetTransactionReply, error) {
+	var out GetTransactionReply
+	pattern := "/api/v2/index/transaction"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerGetTransaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListAllowances(ctx context.Context, in *ListAllowancesRequest, opts ...http.CallOption) (*ListAllowancesReply, error) {
+	var out ListAllowancesReply
+	pattern := "/api/v2/index/allowances"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListAllowances))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListBalancesByAddress(ctx context.Context, in *ListBalancesByAddressRequest, opts ...http.CallOption) (*ListBalancesByAddressReply, error) {
+	var out ListBalancesByAddressReply
+	pattern := "/api/v2/index/balances"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListBalancesByAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...http.CallOption) (*ListEventsReply, error) {
+	var out ListEventsReply
+	pattern := "/api/v2/index/events/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListHoldersByTick(ctx context.Context, in *ListHoldersByTickRequest, opts ...http.CallOption) (*ListHoldersByTickReply, error) {
+	var out ListHoldersByTickReply
+	pattern := "/api/v2/index/tick/balances"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListHoldersByTick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListTicks(ctx context.Context, in *ListTicksRequest, opts ...http.CallOption) (*ListTicksReply, error) {
+	var out ListTicksReply
+	pattern := "/api/v2/index/ticks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListTicks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *IndexerHTTPClientImpl) ListVestings(ctx context.Context, in *ListVestingsRequest, opts ...http.CallOption) (*ListVestingsReply, error) {
+	var out ListVestingsReply
+	pattern := "/api/v2/index/vestings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationIndexerListVestings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *IndexerHTTPClientImpl) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...http.CallOption) (*QueryEventsReply, error) {
 	var out QueryEventsReply
 	pattern := "/api/v2/index/events"
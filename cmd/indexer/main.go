@@ -9,6 +9,7 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/kevin88886/eth_indexer/internal/domain/service"
+	"github.com/kevin88886/eth_indexer/internal/facade"
 	"github.com/kevin88886/eth_indexer/internal/facade/handler"
 	_ "go.uber.org/automaxprocs"
 )
@@ -29,14 +30,14 @@ func init() {
 	flag.StringVar(&flagconf, "c", "../../configs", "config path, eg: -c config.yaml")
 }
 
-func newApp(logger log.Logger, ss *service.IndexDomainService, rh *handler.IndexHandler, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, ss *service.IndexDomainService, ws *service.WebhookService, or *service.OutboxRelay, rh *handler.IndexHandler, gs *grpc.Server, hs *http.Server, as *facade.AdminServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
-		kratos.Server(ss, rh, gs, hs),
+		kratos.Server(ss, ws, or, rh, gs, hs, as),
 	)
 }
 
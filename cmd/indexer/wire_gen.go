@@ -8,13 +8,15 @@ package main
 
 import (
 	"github.com/kevin88886/eth_indexer/internal/conf"
-	"github.com/kevin88886/eth_indexer/internal/domain/protocol/parser"
+	"github.com/kevin88886/eth_indexer/internal/domain/module"
 	"github.com/kevin88886/eth_indexer/internal/domain/service"
 	"github.com/kevin88886/eth_indexer/internal/facade"
+	"github.com/kevin88886/eth_indexer/internal/facade/graphql"
 	"github.com/kevin88886/eth_indexer/internal/facade/handler"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/network/ethereum"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/network/webhook"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -31,7 +33,7 @@ func wireApp(string2 string, logger log.Logger) (*kratos.App, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	parserParser := parser.NewParser()
+	parserParser := module.NewParser()
 	blockFetcher, err := ethereum.NewEthereumFetcher(config, parserParser, logger)
 	if err != nil {
 		cleanup()
@@ -61,19 +63,64 @@ func wireApp(string2 string, logger log.Logger) (*kratos.App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	blockService, err := service.NewBlockService(config, logger, blockRepository, eventRepository, transactionRepository, tickRepository, balanceRepository, stakingRepository)
+	allowanceRepository := mysqlimpl.NewAllowanceRepo(db)
+	vestingRepository := mysqlimpl.NewVestingRepo(db)
+	rewardsRecordRepository := mysqlimpl.NewRewardsRecordRepo(db)
+	miningStatsRepository := mysqlimpl.NewMiningStatsRepo(db)
+	orderRepository := mysqlimpl.NewOrderRepo(db)
+	tradeRepository := mysqlimpl.NewTradeRepo(db)
+	candleRepository := mysqlimpl.NewCandleRepo(db)
+	holderRepository := repository.NewHolderRepository(db)
+	outboxRepository := mysqlimpl.NewOutboxRepo(db)
+	v, cleanup4, err := repository.NewOutboxPublishers(config, logger)
 	if err != nil {
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
+	invalidTxRepository := mysqlimpl.NewInvalidTxRepo(db)
+	invalidTxService, err := service.NewInvalidTxService(config, logger, invalidTxRepository, blockRepository)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	v2 := module.NewStateFactories(allowanceRepository, vestingRepository)
+	blockService, err := service.NewBlockService(config, logger, blockRepository, eventRepository, transactionRepository, tickRepository, balanceRepository, stakingRepository, rewardsRecordRepository, miningStatsRepository, orderRepository, tradeRepository, candleRepository, holderRepository, outboxRepository, v, v2, invalidTxService)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	indexDomainService := service.NewIndexApplication(config, logger, blockFetcher, blockRepository, blockService)
-	indexHandler := handler.NewIndexHandler(indexDomainService, eventRepository, blockFetcher, blockRepository, logger)
-	server := facade.NewGRPCServer(config, indexHandler, logger)
-	httpServer := facade.NewHTTPServer(config, indexHandler, logger)
-	app := newApp(logger, indexDomainService, indexHandler, server, httpServer)
+	indexHandler := handler.NewIndexHandler(indexDomainService, eventRepository, blockFetcher, blockRepository, allowanceRepository, vestingRepository, holderRepository, balanceRepository, tickRepository, logger)
+	webhookRepository := mysqlimpl.NewWebhookRepo(db)
+	sender := webhook.NewHTTPSender()
+	webhookService := service.NewWebhookService(config, logger, webhookRepository, eventRepository, blockRepository, sender)
+	adminHandler := handler.NewAdminHandler(invalidTxService, webhookService, logger)
+	stakingHandler := handler.NewStakingHandler(stakingRepository, rewardsRecordRepository, blockRepository, logger)
+	miningHandler := handler.NewMiningHandler(tickRepository, eventRepository, blockRepository, miningStatsRepository, logger)
+	marketHandler := handler.NewMarketHandler(orderRepository, tradeRepository, candleRepository, logger)
+	server := facade.NewGRPCServer(config, indexHandler, stakingHandler, miningHandler, marketHandler, logger)
+	graphqlHandler := graphql.NewHandler(eventRepository, blockRepository, tickRepository, balanceRepository, holderRepository, stakingRepository, logger)
+	httpServer := facade.NewHTTPServer(config, indexHandler, stakingHandler, miningHandler, marketHandler, graphqlHandler, logger)
+	adminServer, err := facade.NewAdminServer(config, adminHandler, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxRelay := service.NewOutboxRelay(config, logger, outboxRepository, v)
+	app := newApp(logger, indexDomainService, webhookService, outboxRelay, indexHandler, server, httpServer, adminServer)
 	return app, func() {
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/migration"
+)
+
+var (
+	// flagconf is the config flag.
+	flagconf string
+	// 只检查, 不修改数据
+	dryRun bool
+	// 审计报告的输出路径
+	reportPath string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "c", "../../configs", "config path, eg: -c config.yaml")
+	flag.BoolVar(&dryRun, "dry-run", true, "only detect case-variant duplicates, do not modify data")
+	flag.StringVar(&reportPath, "report", "./address_migration_report.json", "audit report path")
+}
+
+// 规范化数据库中的地址, 合并 ierc_balances 和 staking_* 中大小写不同的重复记录.
+// staking_pools 中大小写不同的同一个池子不会合并, 也不会规范化, 只在报告的 unresolved_pools 中列出, 需要人工处理.
+// 执行前需要停止 indexer, 执行后重启 indexer 以重建缓存和持仓统计
+func main() {
+	flag.Parse()
+	logger := log.With(log.NewStdLogger(os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+	)
+	helper := log.NewHelper(logger)
+
+	config, cleanup, err := conf.NewConfigFromPath(flagconf, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	db, cleanupDB, err := repository.NewDB(config, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanupDB()
+
+	helper.Infof("normalize addresses. dry-run: %v", dryRun)
+	report, err := migration.NormalizeAddresses(context.Background(), db, dryRun)
+	if err != nil {
+		panic(err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := os.WriteFile(reportPath, data, 0644); err != nil {
+		panic(err)
+	}
+
+	for _, t := range report.Tables {
+		helper.Infof("table: %s, normalized: %d, merged groups: %d, deleted: %d", t.Table, t.Normalized, t.MergedGroups, t.Deleted)
+	}
+
+	// 重复的池子没有合并, 逐个列出
+	for _, group := range report.UnresolvedPools {
+		helper.Warnf("staking pool %s has case-variant duplicates %v. NOT merged or normalized, needs manual review", group.Key, group.Variants)
+	}
+	if len(report.UnresolvedPools) != 0 {
+		helper.Warnf("%d staking pools were left unmerged, see unresolved_pools in the audit report", len(report.UnresolvedPools))
+	}
+
+	helper.Infof("audit report: %s", reportPath)
+}
@@ -17,7 +17,7 @@ type Config struct {
 
 	*Bootstrap
 
-	InvalidTxHash map[string]struct{}
+	InvalidTxHash map[string][]string // 无效交易Hash, 按分类(操作)分组. 启动时导入数据库
 }
 
 func NewConfigFromPath(path string, logger log.Logger) (*Config, func(), error) {
@@ -45,7 +45,7 @@ func NewConfigFromPath(path string, logger log.Logger) (*Config, func(), error)
 	}
 
 	helper.Infof("load invalid tx hash. path: %s", bc.Runtime.InvalidTxHashPath)
-	invalidHash, err := LoadInvalidHashMap(bc.Runtime.InvalidTxHashPath)
+	invalidHash, err := LoadInvalidHashFile(bc.Runtime.InvalidTxHashPath)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
@@ -58,7 +58,12 @@ func NewConfigFromPath(path string, logger log.Logger) (*Config, func(), error)
 	}, cleanup, nil
 }
 
-func LoadInvalidHashMap(path string) (map[string]struct{}, error) {
+// 读取无效交易Hash文件. 文件格式为 {"分类": ["hash", ...]}, 未配置路径时返回空
+func LoadInvalidHashFile(path string) (map[string][]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
 	// 读取 JSON 文件
 	bytes, err := os.ReadFile(path)
 	if err != nil {
@@ -72,20 +77,9 @@ func LoadInvalidHashMap(path string) (map[string]struct{}, error) {
 		return nil, err
 	}
 
-	var invalidHashMap = make(map[string]struct{})
-	for _, record := range records {
-		for _, r := range record {
-			if _, existed := invalidHashMap[r]; existed {
-				log.Infof("repeat hash: %s", r)
-			}
-			invalidHashMap[r] = struct{}{}
-		}
-	}
-
-	if len(invalidHashMap) == 0 {
+	if len(records) == 0 {
 		log.Info("invalid hash list is empty")
-		return invalidHashMap, nil
 	}
 
-	return invalidHashMap, nil
+	return records, nil
 }
@@ -0,0 +1,58 @@
+package address
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Address 规范化后的地址: 去除首尾空白, 全部小写.
+// 链上地址、协议常量、用户在协议数据中填写的地址都需要先转换为 Address 再参与比较或落库,
+// 避免同一个地址因为大小写(checksum)不同而产生多条记录.
+type Address string
+
+var ErrInvalidAddress = errors.New("invalid address")
+
+// Normalize 将任意格式的地址转换为规范格式. 不校验地址是否合法
+func Normalize(s string) Address {
+	return Address(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// Parse 转换并校验地址. 地址必须带 0x 前缀, 且为 20 字节的十六进制字符串
+func Parse(s string) (Address, error) {
+	addr := Normalize(s)
+	if !addr.IsValid() {
+		return "", ErrInvalidAddress
+	}
+
+	return addr, nil
+}
+
+// FromCommon 将 go-ethereum 的地址转换为规范格式
+func FromCommon(addr common.Address) Address {
+	return Address(strings.ToLower(addr.Hex()))
+}
+
+// Canonical 返回规范格式的地址字符串, 用于 string 类型的字段
+func Canonical(s string) string {
+	return string(Normalize(s))
+}
+
+// Equal 判断两个地址在规范化后是否相同
+func Equal(a, b string) bool {
+	return Normalize(a) == Normalize(b)
+}
+
+func (a Address) String() string {
+	return string(a)
+}
+
+func (a Address) IsValid() bool {
+	s := string(a)
+	return len(s) == 2+2*common.AddressLength && strings.HasPrefix(s, "0x") && common.IsHexAddress(s)
+}
+
+func (a Address) IsCanonical() bool {
+	return Normalize(string(a)) == a
+}
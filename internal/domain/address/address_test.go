@@ -0,0 +1,38 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestAddress(t *testing.T) {
+	suite.Run(t, new(TestAddressSuite))
+}
+
+type TestAddressSuite struct {
+	suite.Suite
+}
+
+func (s *TestAddressSuite) TestNormalize() {
+	const canonical = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+	s.Equal(Address(canonical), Normalize(" 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"))
+	s.Equal(Address(canonical), FromCommon(common.HexToAddress(canonical)))
+	s.Equal(canonical, Canonical("0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED"))
+	s.True(Equal(canonical, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	s.True(Address(canonical).IsCanonical())
+	s.False(Address("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed").IsCanonical())
+}
+
+func (s *TestAddressSuite) TestParse() {
+	addr, err := Parse("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	s.NoError(err)
+	s.Equal("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", addr.String())
+
+	for _, invalid := range []string{"", "0x", "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beae", "0xzzaeb6053f3e94c9b9a09f33669435e7ef1beaed"} {
+		_, err := Parse(invalid)
+		s.ErrorIs(err, ErrInvalidAddress, invalid)
+	}
+}
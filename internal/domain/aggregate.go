@@ -3,14 +3,20 @@ package domain
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/domain/mining"
+	"github.com/kevin88886/eth_indexer/internal/domain/order"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/kevin88886/eth_indexer/internal/domain/staking"
 	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/kevin88886/eth_indexer/internal/domain/trade"
 	"github.com/shopspring/decimal"
 )
 
@@ -22,44 +28,59 @@ type AggregateRoot struct {
 	BalancesMap   map[balance.BalanceKey]*balance.Balance // 聚合根相关的balances
 	Signatures    map[string]*IERC20TransferredEvent      // 聚合根相关的签名事件
 	StakingPools  map[string]*staking.PoolAggregate       // 聚合根相关的质押池
+	States        ModuleStates                            // 协议模块自有的状态
+	MiningStats   map[string]*mining.BlockStats           // 当前区块的挖矿统计. tick => stats
+	Orders        map[string]*order.Order                 // 当前区块变更的订单. orderID => order
+	Trades        []*trade.Trade                          // 当前区块的成交记录, 按成交顺序
 
 	// config
-	invalidTxHashMap map[string]struct{} // 无效交易Hash列表
-	feeStartBlock    uint64              // 开始收手续费的区块
+	invalidTxs    InvalidTxSet      // 无效交易列表
+	feeStartBlock uint64            // 开始收手续费的区块
+	handlers      []ProtocolHandler // 协议处理器
 
 	// runtime
-	mintFlag map[string]struct{}
-	Events   []Event
+	mintFlag      map[string]struct{}
+	powMintShares map[string]*totalShare                 // pow mint 的总份额, 在 PreparePoWMint 中统计
+	prevBalances  map[balance.BalanceKey]decimal.Decimal // 处理前的余额, 用于统计余额变化
+	Events        []Event
 }
 
-func NewBlockAggregate(previous uint64, block *Block, invalidTxHashMap map[string]struct{}, feeStartBlock uint64) *AggregateRoot {
-	if invalidTxHashMap == nil {
-		invalidTxHashMap = make(map[string]struct{})
+func NewBlockAggregate(previous uint64, block *Block, invalidTxs InvalidTxSet, feeStartBlock uint64, handlers ...ProtocolHandler) *AggregateRoot {
+	if invalidTxs == nil {
+		invalidTxs = make(InvalidTxSet)
 	}
 
 	return &AggregateRoot{
-		PreviousBlock:    previous,
-		Block:            block,
-		TicksMap:         make(map[string]tick.Tick),
-		BalancesMap:      make(map[balance.BalanceKey]*balance.Balance),
-		Signatures:       make(map[string]*IERC20TransferredEvent),
-		StakingPools:     make(map[string]*staking.PoolAggregate),
-		invalidTxHashMap: invalidTxHashMap,
-		feeStartBlock:    feeStartBlock,
-		mintFlag:         make(map[string]struct{}),
-		Events:           nil,
+		PreviousBlock: previous,
+		Block:         block,
+		TicksMap:      make(map[string]tick.Tick),
+		BalancesMap:   make(map[balance.BalanceKey]*balance.Balance),
+		Signatures:    make(map[string]*IERC20TransferredEvent),
+		StakingPools:  make(map[string]*staking.PoolAggregate),
+		MiningStats:   make(map[string]*mining.BlockStats),
+		Orders:        make(map[string]*order.Order),
+		Trades:        make([]*trade.Trade, 0),
+		invalidTxs:    invalidTxs,
+		feeStartBlock: feeStartBlock,
+		handlers:      handlers,
+		mintFlag:      make(map[string]struct{}),
+		powMintShares: make(map[string]*totalShare),
+		prevBalances:  make(map[balance.BalanceKey]decimal.Decimal),
+		Events:        nil,
 	}
 }
 
-func (root *AggregateRoot) checkTxHash(txHash string) error {
-	if _, existed := root.invalidTxHashMap[txHash]; existed {
+// 检查交易是否在无效交易列表中
+func (root *AggregateRoot) CheckTxHash(txHash string) error {
+	if root.invalidTxs.Contains(txHash, root.Block.Number) {
 		return protocol.NewProtocolError(protocol.InvalidTxHash, "invalid tx hash")
 	}
 
 	return nil
 }
 
-func (root *AggregateRoot) getOrCreateBalance(address, tick string) *balance.Balance {
+// 获取余额, 不存在时创建一个空余额
+func (root *AggregateRoot) GetOrCreateBalance(address, tick string) *balance.Balance {
 	key := balance.NewBalanceKey(address, tick)
 	entity, existed := root.BalancesMap[key]
 	if existed {
@@ -68,7 +89,7 @@ func (root *AggregateRoot) getOrCreateBalance(address, tick string) *balance.Bal
 
 	entity = &balance.Balance{
 		ID:               0,
-		Address:          address,
+		Address:          key.Address.String(),
 		Tick:             tick,
 		Available:        decimal.Zero,
 		Freeze:           decimal.Zero,
@@ -83,6 +104,26 @@ func (root *AggregateRoot) getOrCreateBalance(address, tick string) *balance.Bal
 	return entity
 }
 
+func (root *AggregateRoot) getOrCreateMiningStats(tickName string) *mining.BlockStats {
+	stats, existed := root.MiningStats[tickName]
+	if existed {
+		return stats
+	}
+
+	stats = mining.NewBlockStats(tickName, root.Block.Number)
+	root.MiningStats[tickName] = stats
+	return stats
+}
+
+// 记录失败的 pow mint. 只统计存在的 pow tick
+func (root *AggregateRoot) recordPoWMintRejected(tickName string, code int32, reason string) {
+	if _, ok := root.TicksMap[tickName].(*tick.IERCPoWTick); !ok {
+		return
+	}
+
+	root.getOrCreateMiningStats(tickName).AddRejected(code, reason)
+}
+
 func (root *AggregateRoot) isMinted(address, tick string) bool {
 	key := fmt.Sprintf("%s-%s", address, tick)
 	_, existed := root.mintFlag[key]
@@ -161,6 +202,7 @@ func (root *AggregateRoot) calculatePoWMintShare() map[string]*totalShare {
 			transaction.Remark = "has been minted"
 			transaction.IsProcessed = true
 			transaction.UpdatedAt = time.Now()
+			root.recordPoWMintRejected(tickName, transaction.Code, transaction.Remark)
 			continue
 		}
 
@@ -230,8 +272,7 @@ func (root *AggregateRoot) calculatePoWMintShare() map[string]*totalShare {
 
 func (root *AggregateRoot) calcPoWShare(tx *protocol.MintPoWCommand, tickEntity *tick.IERCPoWTick) decimal.Decimal {
 
-	var diff = max(tx.Block(), tx.BlockNumber) - min(tx.Block(), tx.BlockNumber)
-	if diff > 5 {
+	if !tick.IsInPoWMintWindow(tx.Block(), tx.BlockNumber) {
 		return decimal.Zero
 	}
 
@@ -239,14 +280,26 @@ func (root *AggregateRoot) calcPoWShare(tx *protocol.MintPoWCommand, tickEntity
 	return tickEntity.CalculateMintShareBasedOnHash(tx.BlockNumber, tx.TxHash)
 }
 
+// 统计当前区块 pow mint 的总份额, 必须在处理交易之前调用
+func (root *AggregateRoot) PreparePoWMint() {
+	root.powMintShares = root.calculatePoWMintShare()
+
+	for tickName, ts := range root.powMintShares {
+		root.getOrCreateMiningStats(tickName).SetTotalShare(ts.PoWTotalShare, ts.PoSTotalShare)
+	}
+}
+
 func (root *AggregateRoot) Handle() {
-	fmt.Println("处理区快交易:")
-	shares := root.calculatePoWMintShare()
+	// 记录处理前的余额
+	for key, entity := range root.BalancesMap {
+		root.prevBalances[key] = entity.Total()
+	}
+
+	for _, handler := range root.handlers {
+		handler.Prepare(root)
+	}
 
 	for _, transaction := range root.Block.Transactions {
-		fmt.Println("transaction123:")
-		fmt.Println(&transaction)
-		fmt.Println(*transaction)
 		if transaction.IsProcessed {
 			continue
 		}
@@ -258,65 +311,21 @@ func (root *AggregateRoot) Handle() {
 			continue
 		}
 
-		// 协议处理
-		var err error
-		switch tx := transaction.IERCTransaction.(type) {
-		case *protocol.DeployCommand:
-			err = root.HandleDeploy(tx)
-
-		case *protocol.MintCommand:
-			err = root.HandleMint(tx)
-
-		case *protocol.DeployPoWCommand:
-			err = root.handleDeployPow(tx)
-
-		case *protocol.MintPoWCommand:
-			powMintTotalShare, posMintTotalShare := decimal.Zero, decimal.Zero
-			if ts, existed := shares[tx.Tick()]; existed {
-				powMintTotalShare = ts.PoWTotalShare
-				posMintTotalShare = ts.PoSTotalShare
+		// 协议处理, 交给支持该交易的协议处理器
+		var (
+			handled bool
+			err     error
+		)
+		for _, handler := range root.handlers {
+			if handled, err = handler.Handle(root, transaction.IERCTransaction); handled {
+				break
 			}
-			err = root.handleMintPoW(tx, powMintTotalShare, posMintTotalShare)
-
-		case *protocol.ModifyCommand:
-			err = root.handleModify(tx)
-
-		case *protocol.ClaimAirdropCommand:
-			err = root.handleClaimAirdrop(tx)
-
-		case *protocol.TransferCommand:
-			err = root.HandleTransfer(tx)
-
-		case *protocol.UnfreezeSellCommand:
-			err = root.HandleUnfreezeSell(tx)
-
-		case *protocol.FreezeSellCommandV4:
-			err = root.HandleFreezeSellV4(tx)
-
-		case *protocol.FreezeSellCommand:
-			err = root.HandleFreezeSell(tx)
-
-		case *protocol.ProxyTransferCommandV4:
-			err = root.HandleProxyTransferV4(tx)
-
-		case *protocol.ProxyTransferCommand:
-			err = root.HandleProxyTransfer(tx)
-
-		case *protocol.ConfigStakeCommand:
-			err = root.handleConfigStaking(tx)
+		}
 
-		case *protocol.StakingCommand:
-			switch tx.Operate {
-			case protocol.OpStaking:
-				err = root.handleStaking(tx)
-			case protocol.OpUnStaking:
-				err = root.handleUnStaking(tx)
-			case protocol.OpProxyUnStaking:
-				err = root.handleProxyUnStaking(tx)
-			}
-		default:
-			fmt.Println("无法处理的区块交易数据:")
-			fmt.Println(tx.String())
+		if !handled {
+			transaction.Code = int32(protocol.InvalidProtocolParams)
+			transaction.Remark = "invalid operate"
+			continue
 		}
 
 		if err != nil {
@@ -333,6 +342,37 @@ func (root *AggregateRoot) Handle() {
 	}
 }
 
+// 当前区块的余额变化, 按 tick、地址排序. 必须在 Handle 之后调用
+func (root *AggregateRoot) BalanceChanges() []*holder.BalanceChange {
+	var changes = make([]*holder.BalanceChange, 0)
+	for key, entity := range root.BalancesMap {
+		if entity.LastUpdatedBlock < root.Block.Number {
+			continue
+		}
+
+		before, existed := root.prevBalances[key]
+		if !existed {
+			before = decimal.Zero
+		}
+
+		changes = append(changes, &holder.BalanceChange{
+			Tick:    entity.Tick,
+			Address: entity.Address,
+			Before:  before,
+			After:   entity.Total(),
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Tick != changes[j].Tick {
+			return changes[i].Tick < changes[j].Tick
+		}
+		return changes[i].Address < changes[j].Address
+	})
+
+	return changes
+}
+
 // ==================== about tick: deploy & mint ====================
 
 func (root *AggregateRoot) HandleDeploy(command *protocol.DeployCommand) (err error) {
@@ -378,7 +418,7 @@ func (root *AggregateRoot) HandleDeploy(command *protocol.DeployCommand) (err er
 func (root *AggregateRoot) HandleMint(command *protocol.MintCommand) (err error) {
 
 	// 排除无效mint的hash
-	if err = root.checkTxHash(command.TxHash); err != nil {
+	if err = root.CheckTxHash(command.TxHash); err != nil {
 		return
 	}
 
@@ -433,7 +473,7 @@ func (root *AggregateRoot) HandleMint(command *protocol.MintCommand) (err error)
 	}
 
 	// 验证mint数量是否符合要求
-	minerBalance := root.getOrCreateBalance(command.From, command.Tick)
+	minerBalance := root.GetOrCreateBalance(command.From, command.Tick)
 	if err = ierc20TickEntity.CanMint(command.Amount, minerBalance.MintedAmount); err != nil {
 		return err
 	}
@@ -446,7 +486,7 @@ func (root *AggregateRoot) HandleMint(command *protocol.MintCommand) (err error)
 	return
 }
 
-func (root *AggregateRoot) handleDeployPow(command *protocol.DeployPoWCommand) (err error) {
+func (root *AggregateRoot) HandleDeployPoW(command *protocol.DeployPoWCommand) (err error) {
 
 	event := &IERCPoWTickCreatedEvent{
 		BlockNumber:       command.BlockNumber,
@@ -490,7 +530,13 @@ func (root *AggregateRoot) handleDeployPow(command *protocol.DeployPoWCommand) (
 	return
 }
 
-func (root *AggregateRoot) handleMintPoW(command *protocol.MintPoWCommand, powTotalShare decimal.Decimal, posTotalShare decimal.Decimal) (err error) {
+func (root *AggregateRoot) HandleMintPoW(command *protocol.MintPoWCommand) (err error) {
+
+	powTotalShare, posTotalShare := decimal.Zero, decimal.Zero
+	if ts, existed := root.powMintShares[command.Tick()]; existed {
+		powTotalShare = ts.PoWTotalShare
+		posTotalShare = ts.PoSTotalShare
+	}
 
 	ee := &IERCPoWMintedEvent{
 		BlockNumber:       command.BlockNumber,
@@ -525,11 +571,14 @@ func (root *AggregateRoot) handleMintPoW(command *protocol.MintPoWCommand, powTo
 	}
 	root.Events = append(root.Events, ee) // 先加进事件列表, 后面可能存在burn事件
 
+	tickName := command.Tick()
 	defer func() {
 		ee.SetError(err)
+		if err != nil {
+			root.recordPoWMintRejected(tickName, ee.ErrCode, ee.ErrReason)
+		}
 	}()
 
-	tickName := command.Tick()
 	// 判断是否已经mint过了
 	if root.isMinted(command.From, tickName) {
 		return protocol.NewProtocolError(protocol.MintErrTickMinted, "has been minted")
@@ -630,7 +679,7 @@ func (root *AggregateRoot) handleMintPoW(command *protocol.MintPoWCommand, powTo
 	powMintedAmount, posMintedAmount := tickEntity.Mint(params)
 
 	// 更新 mint 余额
-	minerBalance := root.getOrCreateBalance(command.From, tickName)
+	minerBalance := root.GetOrCreateBalance(command.From, tickName)
 	minerBalance.AddMint(command.BlockNumber, powMintedAmount.Add(posMintedAmount))
 
 	// 更新事件数据
@@ -643,6 +692,11 @@ func (root *AggregateRoot) handleMintPoW(command *protocol.MintPoWCommand, powTo
 	// 尝试销毁多余的数量
 	powBurnAmount, posBurnAmount := tickEntity.Burn()
 	burnAmount := powBurnAmount.Add(posBurnAmount)
+
+	// 更新挖矿统计
+	stats := root.getOrCreateMiningStats(tickName)
+	stats.AddMinted(params.MinerPoWShare, params.MinerPoSShare, powMintedAmount, posMintedAmount)
+	stats.AddBurned(burnAmount)
 	// 判断是否有销毁的数量
 	if burnAmount.GreaterThan(decimal.Zero) {
 		burnEvent := &IERC20TransferredEvent{
@@ -667,7 +721,7 @@ func (root *AggregateRoot) handleMintPoW(command *protocol.MintPoWCommand, powTo
 		}
 
 		// 黑洞地址
-		blackHoleBalance := root.getOrCreateBalance(protocol.ZeroAddress, tickEntity.Tick)
+		blackHoleBalance := root.GetOrCreateBalance(protocol.ZeroAddress, tickEntity.Tick)
 		blackHoleBalance.AddAvailable(root.Block.Number, burnAmount)
 
 		root.Events = append(root.Events, burnEvent)
@@ -678,7 +732,7 @@ func (root *AggregateRoot) handleMintPoW(command *protocol.MintPoWCommand, powTo
 	return
 }
 
-func (root *AggregateRoot) handleModify(command *protocol.ModifyCommand) (err error) {
+func (root *AggregateRoot) HandleModify(command *protocol.ModifyCommand) (err error) {
 
 	event := &IERCPoWTickCreatedEvent{
 		BlockNumber:       command.BlockNumber,
@@ -731,7 +785,7 @@ func (root *AggregateRoot) handleModify(command *protocol.ModifyCommand) (err er
 	return nil
 }
 
-func (root *AggregateRoot) handleClaimAirdrop(command *protocol.ClaimAirdropCommand) (err error) {
+func (root *AggregateRoot) HandleClaimAirdrop(command *protocol.ClaimAirdropCommand) (err error) {
 
 	ee := &IERCPoWMintedEvent{
 		BlockNumber:       command.BlockNumber,
@@ -789,7 +843,7 @@ func (root *AggregateRoot) handleClaimAirdrop(command *protocol.ClaimAirdropComm
 	}
 
 	// 可用余额 < 划转数量, 划转失败
-	fromBalance := root.getOrCreateBalance(command.From, command.Tick)
+	fromBalance := root.GetOrCreateBalance(command.From, command.Tick)
 	fromBalance.AddAvailable(command.BlockNumber, command.ClaimAmount)
 
 	return nil
@@ -824,7 +878,7 @@ func (root *AggregateRoot) HandleTransfer(command *protocol.TransferCommand) err
 
 		root.Events = append(root.Events, ee)
 
-		if err := root.checkTxHash(command.TxHash); err != nil {
+		if err := root.CheckTxHash(command.TxHash); err != nil {
 			ee.SetError(err)
 			continue
 		}
@@ -838,7 +892,7 @@ func (root *AggregateRoot) HandleTransfer(command *protocol.TransferCommand) err
 		}
 
 		// 处理转账
-		err := root.handleTransferRecord(record)
+		err := root.TransferAvailable(record)
 		if err != nil {
 			ee.SetError(err)
 			continue
@@ -848,9 +902,10 @@ func (root *AggregateRoot) HandleTransfer(command *protocol.TransferCommand) err
 	return nil
 }
 
-func (root *AggregateRoot) handleTransferRecord(record *protocol.TransferRecord) error {
+// 划转可用余额, 不记录事件. 由划转、授权划转等操作复用
+func (root *AggregateRoot) TransferAvailable(record *protocol.TransferRecord) error {
 	// 可用余额 < 划转数量, 划转失败
-	fromBalance := root.getOrCreateBalance(record.From, record.Tick)
+	fromBalance := root.GetOrCreateBalance(record.From, record.Tick)
 
 	if fromBalance.Available.LessThan(record.Amount) {
 		return protocol.NewProtocolError(
@@ -859,19 +914,80 @@ func (root *AggregateRoot) handleTransferRecord(record *protocol.TransferRecord)
 		)
 	}
 
-	toBalance := root.getOrCreateBalance(record.Recv, record.Tick)
+	toBalance := root.GetOrCreateBalance(record.Recv, record.Tick)
 	// 资金操作
 	fromBalance.SubAvailable(root.Block.Number, record.Amount)
 	toBalance.AddAvailable(root.Block.Number, record.Amount)
 	return nil
 }
 
+// ==================== about tick: burn ====================
+
+func (root *AggregateRoot) HandleBurn(command *protocol.BurnCommand) (err error) {
+
+	ee := &IERC20BurnedEvent{
+		BlockNumber:       command.BlockNumber,
+		PrevBlockNumber:   root.PreviousBlock,
+		TxHash:            command.TxHash,
+		PositionInIERCTxs: 0,
+		From:              command.From,
+		To:                command.To,
+		Value:             command.TxValue.String(),
+		Data: &IERC20Burned{
+			Protocol: command.Protocol,
+			Operate:  command.Operate,
+			Tick:     command.Tick,
+			From:     command.From,
+			Amount:   command.Amount,
+		},
+		ErrCode:   0,
+		ErrReason: "",
+		EventAt:   command.EventAt,
+	}
+
+	defer func() {
+		ee.SetError(err)
+		root.Events = append(root.Events, ee)
+	}()
+
+	if err = root.CheckTxHash(command.TxHash); err != nil {
+		return
+	}
+
+	// 加载tick
+	tickEntity, existed := root.TicksMap[command.Tick]
+	if !existed {
+		return protocol.NewProtocolError(protocol.TickNotExist, "tick not existed")
+	}
+
+	// 只有 ierc-20 的 tick 支持销毁, ierc-pow 的销毁在 mint 时处理
+	ierc20TickEntity, ok := tickEntity.(*tick.IERC20Tick)
+	if !ok {
+		return protocol.NewProtocolError(protocol.ErrTickProtocolNoMatch, "tick protocol no match")
+	}
+
+	// 可用余额 < 销毁数量, 销毁失败
+	burnerBalance := root.GetOrCreateBalance(command.From, command.Tick)
+	if burnerBalance.Available.LessThan(command.Amount) {
+		return protocol.NewProtocolError(
+			protocol.InsufficientAvailableFunds,
+			fmt.Sprintf("insufficient balance. available(%s) < burn(%s)", burnerBalance.Available, command.Amount),
+		)
+	}
+
+	// 更新状态
+	burnerBalance.SubAvailable(command.BlockNumber, command.Amount)
+	ierc20TickEntity.Burn(command.BlockNumber, command.Amount)
+
+	return
+}
+
 // ==================== about trade: freeze & unfreeze & proxy_transfer ====================
 
 func (root *AggregateRoot) HandleFreezeSell(command *protocol.FreezeSellCommand) error {
 	fmt.Println("V3签名")
 	fmt.Println(command)
-	if err := root.checkTxHash(command.TxHash); err != nil {
+	if err := root.CheckTxHash(command.TxHash); err != nil {
 		return err
 	}
 
@@ -917,6 +1033,7 @@ func (root *AggregateRoot) HandleFreezeSell(command *protocol.FreezeSellCommand)
 			buyerRemainEthValue = buyerRemainEthValue.Sub(value)
 			// 如果冻结成功了, 更新签名使用情况
 			root.Signatures[record.SellerSign] = ee
+			root.createOrder(ee) // 挂单
 		}
 
 		// 记录变更信息
@@ -929,7 +1046,7 @@ func (root *AggregateRoot) HandleFreezeSell(command *protocol.FreezeSellCommand)
 func (root *AggregateRoot) HandleFreezeSellV4(command *protocol.FreezeSellCommandV4) error {
 	fmt.Println("V4签名:FreezeSell")
 	fmt.Println(command)
-	if err := root.checkTxHash(command.TxHash); err != nil {
+	if err := root.CheckTxHash(command.TxHash); err != nil {
 		return err
 	}
 
@@ -974,6 +1091,7 @@ func (root *AggregateRoot) HandleFreezeSellV4(command *protocol.FreezeSellComman
 			buyerRemainEthValue = buyerRemainEthValue.Sub(value)
 			// 如果冻结成功了, 更新签名使用情况
 			root.Signatures[record.SellerSign] = ee
+			root.createOrder(ee) // 挂单
 		}
 
 		// 记录变更信息
@@ -983,6 +1101,71 @@ func (root *AggregateRoot) HandleFreezeSellV4(command *protocol.FreezeSellComman
 	return nil
 }
 
+// 根据 freeze_sell 事件创建订单
+func (root *AggregateRoot) createOrder(ee *IERC20TransferredEvent) *order.Order {
+	entity := order.NewOrder(
+		order.NewOrderID(ee.TxHash, ee.PositionInIERCTxs),
+		ee.Data.Sign,
+		ee.Data.SignerNonce,
+		ee.Data.Tick,
+		ee.Data.From,
+		ee.Data.Amount,
+		ee.Data.EthValue,
+		ee.TxHash,
+		ee.BlockNumber,
+	)
+	root.Orders[entity.OrderID] = entity
+	return entity
+}
+
+// 获取签名当前的挂单. 签名最后一个成功的事件不是 freeze_sell 时返回 nil
+func (root *AggregateRoot) getListedOrder(sign string) *order.Order {
+	ee, existed := root.Signatures[sign]
+	if !existed || ee.Data.Operate != protocol.OpFreezeSell {
+		return nil
+	}
+
+	if entity, existed := root.Orders[order.NewOrderID(ee.TxHash, ee.PositionInIERCTxs)]; existed {
+		return entity
+	}
+
+	// 订单不在当前区块中创建, 根据挂单事件重建. 保存时按订单ID更新
+	return root.createOrder(ee)
+}
+
+func (root *AggregateRoot) cancelOrder(sign, txHash string) {
+	if entity := root.getListedOrder(sign); entity != nil {
+		_ = entity.Cancel(root.Block.Number, txHash)
+	}
+}
+
+func (root *AggregateRoot) fillOrder(sign, txHash, buyer string, amount, value decimal.Decimal) string {
+	entity := root.getListedOrder(sign)
+	if entity == nil {
+		return ""
+	}
+
+	_ = entity.Fill(root.Block.Number, txHash, buyer, amount, value)
+	return entity.OrderID
+}
+
+// 记录成交. 必须在更新签名之前调用
+func (root *AggregateRoot) recordTrade(ee *IERC20TransferredEvent) {
+	orderID := root.fillOrder(ee.Data.Sign, ee.TxHash, ee.Data.To, ee.Data.Amount, ee.Data.EthValue)
+	root.Trades = append(root.Trades, trade.NewTrade(
+		ee.TxHash,
+		ee.PositionInIERCTxs,
+		orderID,
+		ee.Data.Tick,
+		ee.Data.From,
+		ee.Data.To,
+		ee.Data.Amount,
+		ee.Data.EthValue,
+		ee.BlockNumber,
+		ee.EventAt,
+	))
+}
+
 func (root *AggregateRoot) HandleFreezeSellBundle(command *protocol.FreezeSellCommand) error {
 	return nil
 }
@@ -1039,7 +1222,7 @@ func (root *AggregateRoot) handleFreezeRecord(record *protocol.FreezeRecord, buy
 
 	// 验证卖方的Tick可用余额
 	// 可用余额 必须大于 要冻结的数量
-	sellerBalance := root.getOrCreateBalance(record.Seller, record.Tick)
+	sellerBalance := root.GetOrCreateBalance(record.Seller, record.Tick)
 	if sellerBalance.Available.LessThan(record.Amount) {
 		return protocol.NewProtocolError(
 			protocol.InsufficientAvailableFunds,
@@ -1067,7 +1250,7 @@ func (root *AggregateRoot) handleFreezeRecordV4(record *protocol.FreezeRecordV4,
 	}
 
 	// 签名验证
-	if err := record.ValidateSignatureV4(); err != nil {
+	if err := record.ValidateSignatureV4(root.Block.Number); err != nil {
 		return err
 	}
 
@@ -1105,7 +1288,7 @@ func (root *AggregateRoot) handleFreezeRecordV4(record *protocol.FreezeRecordV4,
 
 	// 验证卖方的Tick可用余额
 	// 可用余额 必须大于 要冻结的数量
-	sellerBalance := root.getOrCreateBalance(record.Seller, record.Tick)
+	sellerBalance := root.GetOrCreateBalance(record.Seller, record.Tick)
 	if sellerBalance.Available.LessThan(record.Amount) {
 		return protocol.NewProtocolError(
 			protocol.InsufficientAvailableFunds,
@@ -1141,6 +1324,7 @@ func (root *AggregateRoot) HandleUnfreezeSell(command *protocol.UnfreezeSellComm
 		if err != nil {
 			ee.SetError(err)
 		} else {
+			root.cancelOrder(record.Sign, command.TxHash) // 撤单, 必须在更新签名之前
 			root.Signatures[record.Sign] = ee
 		}
 
@@ -1209,7 +1393,7 @@ func (root *AggregateRoot) handleUnfreezeRecord(unfreezeAddress string, record *
 
 	var unfreezeAmount = ee.Data.Amount
 
-	sellerBalance := root.getOrCreateBalance(ee.Data.From, ee.Data.Tick)
+	sellerBalance := root.GetOrCreateBalance(ee.Data.From, ee.Data.Tick)
 	if sellerBalance.Freeze.LessThan(unfreezeAmount) {
 		return event, protocol.NewProtocolError(
 			protocol.InsufficientFreezeFunds,
@@ -1226,7 +1410,7 @@ func (root *AggregateRoot) handleUnfreezeRecord(unfreezeAddress string, record *
 func (root *AggregateRoot) HandleProxyTransfer(command *protocol.ProxyTransferCommand) error {
 	fmt.Println("V3签名:ProxyTransfer")
 	fmt.Println(command)
-	if err := root.checkTxHash(command.TxHash); err != nil {
+	if err := root.CheckTxHash(command.TxHash); err != nil {
 		return err
 	}
 
@@ -1253,6 +1437,7 @@ func (root *AggregateRoot) HandleProxyTransfer(command *protocol.ProxyTransferCo
 			ee.SetError(err)
 		} else {
 			buyerRemainEthValue = buyerRemainEthValue.Sub(record.Value)
+			root.recordTrade(ee) // 成交, 必须在更新签名之前
 			root.Signatures[record.Sign] = ee
 		}
 
@@ -1265,7 +1450,7 @@ func (root *AggregateRoot) HandleProxyTransfer(command *protocol.ProxyTransferCo
 func (root *AggregateRoot) HandleProxyTransferV4(command *protocol.ProxyTransferCommandV4) error {
 	fmt.Println("V4签名:ProxyTransfer")
 	fmt.Println(command)
-	if err := root.checkTxHash(command.TxHash); err != nil {
+	if err := root.CheckTxHash(command.TxHash); err != nil {
 		return err
 	}
 
@@ -1292,6 +1477,7 @@ func (root *AggregateRoot) HandleProxyTransferV4(command *protocol.ProxyTransfer
 			ee.SetError(err)
 		} else {
 			buyerRemainEthValue = buyerRemainEthValue.Sub(record.Value)
+			root.recordTrade(ee) // 成交, 必须在更新签名之前
 			root.Signatures[record.Sign] = ee
 		}
 
@@ -1362,7 +1548,7 @@ func (root *AggregateRoot) handleProxyTransferRecord(record *protocol.ProxyTrans
 	}
 
 	// 检查冻结余额
-	fromBalance := root.getOrCreateBalance(record.From, record.Tick)
+	fromBalance := root.GetOrCreateBalance(record.From, record.Tick)
 	if fromBalance.Freeze.LessThan(record.Amount) {
 		return event, protocol.NewProtocolError(
 			protocol.InsufficientFreezeFunds,
@@ -1370,7 +1556,7 @@ func (root *AggregateRoot) handleProxyTransferRecord(record *protocol.ProxyTrans
 		)
 	}
 
-	toBalance := root.getOrCreateBalance(record.To, record.Tick)
+	toBalance := root.GetOrCreateBalance(record.To, record.Tick)
 
 	// 资金划转
 	fromBalance.SubFreeze(root.Block.Number, record.Amount)
@@ -1409,7 +1595,7 @@ func (root *AggregateRoot) handleProxyTransferRecordV4(record *protocol.ProxyTra
 	}
 
 	// 签名校验
-	if err := record.ValidateSignature(); err != nil {
+	if err := record.ValidateSignature(root.Block.Number); err != nil {
 		return event, err.(*protocol.ProtocolError)
 	}
 
@@ -1440,7 +1626,7 @@ func (root *AggregateRoot) handleProxyTransferRecordV4(record *protocol.ProxyTra
 	}
 
 	// 检查冻结余额
-	fromBalance := root.getOrCreateBalance(record.From, record.Tick)
+	fromBalance := root.GetOrCreateBalance(record.From, record.Tick)
 	if fromBalance.Freeze.LessThan(record.Amount) {
 		return event, protocol.NewProtocolError(
 			protocol.InsufficientFreezeFunds,
@@ -1448,7 +1634,7 @@ func (root *AggregateRoot) handleProxyTransferRecordV4(record *protocol.ProxyTra
 		)
 	}
 
-	toBalance := root.getOrCreateBalance(record.To, record.Tick)
+	toBalance := root.GetOrCreateBalance(record.To, record.Tick)
 
 	// 资金划转
 	fromBalance.SubFreeze(root.Block.Number, record.Amount)
@@ -1460,7 +1646,7 @@ func (root *AggregateRoot) handleProxyTransferRecordV4(record *protocol.ProxyTra
 // ==================== about staking: config pool & stake & unstake & proxy_unstake ====================
 
 func (root *AggregateRoot) getPoolAggregate(pool string) (*staking.PoolAggregate, error) {
-	poolRoot, existed := root.StakingPools[pool]
+	poolRoot, existed := root.StakingPools[address.Canonical(pool)]
 	if !existed {
 		// 在预处理的时候会尝试创建池子聚合, 所以这里不可能查不到
 		return nil, protocol.NewProtocolError(protocol.StakingPoolNotFound, "pool not found")
@@ -1469,7 +1655,7 @@ func (root *AggregateRoot) getPoolAggregate(pool string) (*staking.PoolAggregate
 	return poolRoot, nil
 }
 
-func (root *AggregateRoot) handleConfigStaking(command *protocol.ConfigStakeCommand) (err error) {
+func (root *AggregateRoot) HandleConfigStaking(command *protocol.ConfigStakeCommand) (err error) {
 
 	ee := &StakingPoolUpdatedEvent{
 		BlockNumber:       command.BlockNumber,
@@ -1519,7 +1705,7 @@ func (root *AggregateRoot) handleConfigStaking(command *protocol.ConfigStakeComm
 	return poolRoot.UpdatePool(command)
 }
 
-func (root *AggregateRoot) handleStaking(command *protocol.StakingCommand) error {
+func (root *AggregateRoot) HandleStaking(command *protocol.StakingCommand) error {
 
 	poolRoot, err := root.getPoolAggregate(command.Pool)
 	if err != nil {
@@ -1569,7 +1755,7 @@ func (root *AggregateRoot) handleStakingRecord(pool *staking.PoolAggregate, reco
 		return protocol.NewProtocolError(protocol.TickNotExist, "tick not exist")
 	}
 
-	stakerBalance := root.getOrCreateBalance(record.Staker, record.Tick)
+	stakerBalance := root.GetOrCreateBalance(record.Staker, record.Tick)
 
 	// 判断 staker 可用资金是否充足
 	if record.Amount.GreaterThan(stakerBalance.Available) {
@@ -1588,13 +1774,13 @@ func (root *AggregateRoot) handleStakingRecord(pool *staking.PoolAggregate, reco
 	// 减去可用
 	stakerBalance.SubAvailable(root.Block.Number, record.Amount)
 	// 划转至目标地址的冻结上
-	poolBalance := root.getOrCreateBalance(pool.PoolAddress, record.Tick)
+	poolBalance := root.GetOrCreateBalance(pool.PoolAddress, record.Tick)
 	poolBalance.AddFreeze(root.Block.Number, record.Amount)
 
 	return nil
 }
 
-func (root *AggregateRoot) handleUnStaking(command *protocol.StakingCommand) error {
+func (root *AggregateRoot) HandleUnStaking(command *protocol.StakingCommand) error {
 
 	poolRoot, err := root.getPoolAggregate(command.Pool)
 	if err != nil {
@@ -1649,7 +1835,7 @@ func (root *AggregateRoot) handleUnStakingRecord(pool *staking.PoolAggregate, re
 	}
 
 	// 检查 pool 的冻结资产, 理论上来说能取消质押说明资金是足够的
-	poolBalance := root.getOrCreateBalance(pool.PoolAddress, record.Tick)
+	poolBalance := root.GetOrCreateBalance(pool.PoolAddress, record.Tick)
 	if poolBalance.Freeze.LessThan(record.Amount) {
 		// 进这里说明数据出错了
 		panic("pool freeze funds error, data error")
@@ -1658,13 +1844,13 @@ func (root *AggregateRoot) handleUnStakingRecord(pool *staking.PoolAggregate, re
 	poolBalance.SubFreeze(root.Block.Number, record.Amount)
 
 	// 添加可用
-	stakerBalance := root.getOrCreateBalance(record.Staker, record.Tick)
+	stakerBalance := root.GetOrCreateBalance(record.Staker, record.Tick)
 	stakerBalance.AddAvailable(root.Block.Number, record.Amount)
 
 	return nil
 }
 
-func (root *AggregateRoot) handleProxyUnStaking(command *protocol.StakingCommand) error {
+func (root *AggregateRoot) HandleProxyUnStaking(command *protocol.StakingCommand) error {
 
 	// 判断池子是否存在
 	poolRoot, err := root.getPoolAggregate(command.Pool)
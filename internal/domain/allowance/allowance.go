@@ -0,0 +1,75 @@
+package allowance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type AllowanceKey struct {
+	Owner   string
+	Spender string
+	Tick    string
+}
+
+func NewAllowanceKey(owner, spender, tick string) AllowanceKey {
+	return AllowanceKey{
+		Owner:   owner,
+		Spender: spender,
+		Tick:    tick,
+	}
+}
+
+func (key *AllowanceKey) String() string {
+	return fmt.Sprintf("%s-%s-%s", key.Owner, key.Spender, key.Tick)
+}
+
+// 授权额度. owner 授权 spender 可以划转自己指定 tick 的资产
+type Allowance struct {
+	ID               int64
+	Owner            string          // 资产所有者
+	Spender          string          // 被授权者
+	Tick             string          // tick
+	Amount           decimal.Decimal // 剩余授权额度
+	SignNonce        decimal.Decimal // 最后一次签名授权使用的 nonce. 签名授权的 nonce 必须递增, 防止签名重放
+	LastUpdatedBlock uint64          //
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func NewAllowance(owner, spender, tick string) *Allowance {
+	return &Allowance{
+		ID:               0,
+		Owner:            owner,
+		Spender:          spender,
+		Tick:             tick,
+		Amount:           decimal.Zero,
+		SignNonce:        decimal.Zero,
+		LastUpdatedBlock: 0,
+		CreatedAt:        time.Time{},
+		UpdatedAt:        time.Time{},
+	}
+}
+
+func (entity *Allowance) Key() AllowanceKey {
+	return NewAllowanceKey(entity.Owner, entity.Spender, entity.Tick)
+}
+
+// 设置授权额度. 与 erc-20 一致, 新的额度覆盖旧的额度, 额度为0表示取消授权
+func (entity *Allowance) Approve(blockNumber uint64, amount decimal.Decimal) {
+	entity.Amount = amount
+	entity.LastUpdatedBlock = blockNumber
+}
+
+// 签名授权. 记录签名使用的 nonce
+func (entity *Allowance) ApproveWithSign(blockNumber uint64, amount, nonce decimal.Decimal) {
+	entity.Approve(blockNumber, amount)
+	entity.SignNonce = nonce
+}
+
+// 使用授权额度
+func (entity *Allowance) Spend(blockNumber uint64, amount decimal.Decimal) {
+	entity.Amount = entity.Amount.Sub(amount)
+	entity.LastUpdatedBlock = blockNumber
+}
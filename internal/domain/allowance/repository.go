@@ -0,0 +1,12 @@
+package allowance
+
+import (
+	"context"
+)
+
+type AllowanceRepository interface {
+	Save(ctx context.Context, entities ...*Allowance) error
+	Load(ctx context.Context, key AllowanceKey) (*Allowance, error)
+	// 查询授权. owner、spender、tick 为空时不作为过滤条件
+	Query(ctx context.Context, owner, spender, tick string) ([]*Allowance, error)
+}
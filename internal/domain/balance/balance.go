@@ -5,17 +5,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/shopspring/decimal"
 )
 
 type BalanceKey struct {
-	Address string
+	Address address.Address
 	Tick    string
 }
 
-func NewBalanceKey(address, tick string) BalanceKey {
+// 地址统一转换为规范格式, 避免同一个持有人因为大小写不同产生多条余额记录
+func NewBalanceKey(addr, tick string) BalanceKey {
 	return BalanceKey{
-		Address: address,
+		Address: address.Normalize(addr),
 		Tick:    tick,
 	}
 }
@@ -36,10 +38,10 @@ type Balance struct {
 	UpdatedAt        time.Time
 }
 
-func NewBalance(address, tick string) *Balance {
+func NewBalance(addr, tick string) *Balance {
 	return &Balance{
 		ID:               0,
-		Address:          address,
+		Address:          address.Canonical(addr),
 		Tick:             tick,
 		Available:        decimal.Zero,
 		Freeze:           decimal.Zero,
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -37,3 +38,29 @@ func (s *TestBalanceSuite) TestBalanceKey() {
 		spew.Dump(key, fmt.Sprintf("%p", key))
 	}
 }
+
+func (s *TestBalanceSuite) TestBalanceKeyCanonical() {
+	key1 := NewBalanceKey("0xABcd", "ethi")
+	key2 := NewBalanceKey(" 0xabCD ", "ethi")
+	s.Equal(key1, key2)
+	s.Equal("0xabcd", NewBalance("0xABCD", "ethi").Address)
+}
+
+func (s *TestBalanceSuite) TestHolderCursor() {
+	entity := NewBalance("0xabcd", "ethi")
+	entity.AddAvailable(1, decimal.RequireFromString("1.5"))
+	entity.FreezeBalance(1, decimal.RequireFromString("0.5"))
+
+	cursor := HolderCursor(entity)
+	s.Equal("1.5_0xabcd", cursor)
+
+	total, addr, err := ParseHolderCursor(cursor)
+	s.NoError(err)
+	s.True(total.Equal(decimal.RequireFromString("1.5")))
+	s.Equal("0xabcd", addr)
+
+	for _, invalid := range []string{"0xabcd", "abc_0xabcd", "1_"} {
+		_, _, err := ParseHolderCursor(invalid)
+		s.ErrorIs(err, ErrInvalidCursor, invalid)
+	}
+}
@@ -2,9 +2,58 @@ package balance
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
 )
 
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// 余额查询条件
+type BalanceQuery struct {
+	Address     string // 按地址查询时必填
+	Tick        string // 按 tick 查询时必填
+	Cursor      string // 上一页返回的游标, 为空时从第一页开始
+	Limit       int
+	IncludeZero bool // 是否包含余额为 0 的记录. 只对按地址查询有效
+}
+
+// 余额查询结果. BlockNumber 为结果对应的索引高度(最后处理的区块), 与余额在同一个快照中读取
+type Snapshot struct {
+	BlockNumber uint64
+	Balances    []*Balance
+}
+
 type BalanceRepository interface {
 	Save(ctx context.Context, entities ...*Balance) error
 	Load(ctx context.Context, key BalanceKey) (*Balance, error)
+	// 查询余额及对应的索引高度. 余额不存在时 Balances 为空
+	Get(ctx context.Context, key BalanceKey) (*Snapshot, error)
+	// 在同一个快照中批量查询余额. 不存在的余额不在结果中
+	GetMany(ctx context.Context, keys ...BalanceKey) (*Snapshot, error)
+	// 按 tick 正序查询地址的余额. 游标为上一页最后一条记录的 tick
+	QueryByAddress(ctx context.Context, query *BalanceQuery) (*Snapshot, error)
+	// 按持仓数量(可用 + 冻结)倒序查询 tick 的持仓地址. 游标由 HolderCursor 生成
+	QueryByTick(ctx context.Context, query *BalanceQuery) (*Snapshot, error)
+}
+
+// 按 tick 查询持仓时的翻页游标: 持仓数量_地址
+func HolderCursor(entity *Balance) string {
+	return fmt.Sprintf("%s_%s", entity.Total().String(), entity.Address)
+}
+
+func ParseHolderCursor(cursor string) (decimal.Decimal, string, error) {
+	total, addr, ok := strings.Cut(cursor, "_")
+	if !ok || addr == "" {
+		return decimal.Zero, "", ErrInvalidCursor
+	}
+
+	amount, err := decimal.NewFromString(total)
+	if err != nil {
+		return decimal.Zero, "", ErrInvalidCursor
+	}
+
+	return amount, addr, nil
 }
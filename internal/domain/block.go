@@ -78,3 +78,23 @@ type Transaction struct {
 
 	IERCTransaction protocol.IERCTransaction
 }
+
+// 交易处理状态
+type TransactionState int32
+
+const (
+	TransactionPending   TransactionState = iota + 1 // 待处理
+	TransactionProcessed                             // 处理成功. 每条记录的处理结果见对应的事件
+	TransactionInvalid                               // 无效交易. 整笔交易没有执行
+)
+
+func (t *Transaction) State() TransactionState {
+	switch {
+	case !t.IsProcessed:
+		return TransactionPending
+	case t.Code != 0:
+		return TransactionInvalid
+	default:
+		return TransactionProcessed
+	}
+}
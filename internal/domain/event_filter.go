@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"slices"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+)
+
+// 事件订阅过滤条件. 字段为空时不过滤, 字段之间为 AND 关系, 字段内为 OR 关系
+type EventFilter struct {
+	Ticks       []string
+	Addresses   []string // 规范格式的地址, 匹配 ierc_from、ierc_to、eth_from 中的任意一个
+	Operates    []protocol.Operate
+	EventKinds  []EventKind
+	SuccessOnly bool // 只推送处理成功的事件
+}
+
+// 是否没有任何过滤条件
+func (f *EventFilter) IsEmpty() bool {
+	return f == nil ||
+		(len(f.Ticks) == 0 && len(f.Addresses) == 0 && len(f.Operates) == 0 && len(f.EventKinds) == 0 && !f.SuccessOnly)
+}
+
+func (f *EventFilter) Match(e Event) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if f.SuccessOnly && e.GetErrCode() != 0 {
+		return false
+	}
+
+	if len(f.Operates) != 0 && !slices.Contains(f.Operates, e.GetOperate()) {
+		return false
+	}
+
+	if len(f.EventKinds) != 0 && !slices.Contains(f.EventKinds, e.GetEventKind()) {
+		return false
+	}
+
+	if len(f.Ticks) == 0 && len(f.Addresses) == 0 {
+		return true
+	}
+
+	index := IndexEvent(e)
+	if len(f.Ticks) != 0 && !slices.Contains(f.Ticks, index.Tick) {
+		return false
+	}
+
+	if len(f.Addresses) != 0 &&
+		!slices.Contains(f.Addresses, address.Canonical(index.IERCFrom)) &&
+		!slices.Contains(f.Addresses, address.Canonical(index.IERCTo)) &&
+		!slices.Contains(f.Addresses, address.Canonical(index.ETHFrom)) {
+		return false
+	}
+
+	return true
+}
+
+// Apply 返回区块中命中过滤条件的事件, 没有命中时返回 nil.
+// 过滤后的区块, 上一个区块号为 prevBlock(上一个命中过滤条件的区块), 客户端仍然可以用于检验是否有区块缺失
+func (f *EventFilter) Apply(block *EventsByBlock, prevBlock uint64) *EventsByBlock {
+	if f.IsEmpty() {
+		return block
+	}
+
+	var events []Event
+	for _, e := range block.Events {
+		if f.Match(e) {
+			events = append(events, e)
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return &EventsByBlock{
+		BlockNumber: block.BlockNumber,
+		Events:      events,
+		filtered:    true,
+		prevBlock:   prevBlock,
+	}
+}
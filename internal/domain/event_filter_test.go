@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEventFilter(t *testing.T) {
+	suite.Run(t, new(TestEventFilterSuite))
+}
+
+type TestEventFilterSuite struct {
+	suite.Suite
+}
+
+func (s *TestEventFilterSuite) SetupSuite() {
+	RegisterEventCodec(NewEventCodec(func(e *IERC20TransferredEvent) EventIndex {
+		return EventIndex{ETHFrom: e.From, IERCFrom: e.Data.From, IERCTo: e.Data.To, Tick: e.Data.Tick}
+	}))
+}
+
+func (s *TestEventFilterSuite) newTransferred(block uint64, tick, from, to string, errCode int32) Event {
+	return &IERC20TransferredEvent{
+		BlockNumber: block,
+		From:        from,
+		Data: &IERC20Transferred{
+			Protocol: protocol.ProtocolIERC20,
+			Operate:  protocol.OpTransfer,
+			Tick:     tick,
+			From:     from,
+			To:       to,
+		},
+		ErrCode: errCode,
+	}
+}
+
+func (s *TestEventFilterSuite) TestMatch() {
+	const (
+		alice = "0x00000000000000000000000000000000000a11ce"
+		bob   = "0x0000000000000000000000000000000000000b0b"
+	)
+
+	var (
+		ok     = s.newTransferred(10, "ethi", "0x00000000000000000000000000000000000A11CE", bob, 0)
+		failed = s.newTransferred(10, "ethi", bob, alice, int32(protocol.InsufficientAvailableFunds))
+	)
+
+	var filter *EventFilter
+	s.True(filter.IsEmpty())
+	s.True(filter.Match(ok))
+
+	filter = &EventFilter{Addresses: []string{alice}}
+	s.True(filter.Match(ok))
+	s.True(filter.Match(failed))
+
+	filter.SuccessOnly = true
+	s.True(filter.Match(ok))
+	s.False(filter.Match(failed))
+
+	s.False((&EventFilter{Ticks: []string{"other"}}).Match(ok))
+	s.False((&EventFilter{Operates: []protocol.Operate{protocol.OpBurn}}).Match(ok))
+	s.True((&EventFilter{EventKinds: []EventKind{EventKindIERC20Transferred}}).Match(ok))
+}
+
+func (s *TestEventFilterSuite) TestApply() {
+	const bob = "0x0000000000000000000000000000000000000b0b"
+
+	block := &EventsByBlock{
+		BlockNumber: 20,
+		Events: []Event{
+			s.newTransferred(20, "ethi", bob, "0x00000000000000000000000000000000000a11ce", 0),
+			s.newTransferred(20, "ierc", bob, "0x00000000000000000000000000000000000a11ce", 0),
+		},
+	}
+
+	filter := &EventFilter{Ticks: []string{"ierc"}}
+	filtered := filter.Apply(block, 12)
+	s.Require().NotNil(filtered)
+	s.Len(filtered.Events, 1)
+	s.Equal(uint64(12), filtered.PreviousBlock())
+
+	s.Nil((&EventFilter{Ticks: []string{"none"}}).Apply(block, 12))
+
+	var empty *EventFilter
+	s.Same(block, empty.Apply(block, 12))
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidEventCursor = errors.New("invalid event cursor")
+
+// 事件处理结果过滤
+type EventStatus int32
+
+const (
+	EventStatusAll     EventStatus = iota // 不过滤
+	EventStatusSuccess                    // 只查询处理成功的事件. err_code = 0
+	EventStatusFailed                     // 只查询处理失败的事件. err_code != 0
+)
+
+// 事件位置. 按 (区块号, 事件ID) 排序, 在所有事件中唯一
+type EventCursor struct {
+	BlockNumber uint64
+	ID          int64
+}
+
+// Encode 生成不透明的游标字符串, 客户端不需要也不应该解析
+func (c EventCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d_%d", c.BlockNumber, c.ID)))
+}
+
+func ParseEventCursor(s string) (EventCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return EventCursor{}, ErrInvalidEventCursor
+	}
+
+	var cursor EventCursor
+	if n, err := fmt.Sscanf(string(data), "%d_%d", &cursor.BlockNumber, &cursor.ID); err != nil || n != 2 || cursor.ID <= 0 {
+		return EventCursor{}, ErrInvalidEventCursor
+	}
+
+	return cursor, nil
+}
+
+// 事件查询条件. 字符串为空表示不过滤, 多个条件之间为 AND 关系
+type EventQuery struct {
+	Address    string      // 匹配 ierc_from、ierc_to、eth_from 中的任意一个
+	IERCFrom   string      //
+	IERCTo     string      //
+	ETHFrom    string      //
+	Tick       string      //
+	Operate    string      //
+	EventKinds []EventKind // 为空时不过滤
+	TxHash     string      //
+	Status     EventStatus //
+	After      string      // 查询该游标之后的事件(下一页). 与 Before 互斥
+	Before     string      // 查询该游标之前的事件(上一页)
+	Desc       bool        // 是否按 (区块号, 事件ID) 倒序返回
+	Limit      int
+}
+
+// 事件查询结果. Events 的顺序与查询的排序方向一致
+type EventPage struct {
+	Events     []Event
+	NextCursor string // 存在下一页时不为空, 作为下一次查询的 After
+	PrevCursor string // 存在上一页时不为空, 作为下一次查询的 Before
+}
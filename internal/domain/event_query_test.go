@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEventQuery(t *testing.T) {
+	suite.Run(t, new(TestEventQuerySuite))
+}
+
+type TestEventQuerySuite struct {
+	suite.Suite
+}
+
+func (s *TestEventQuerySuite) TestEventCursor() {
+	cursor := EventCursor{BlockNumber: 18_000_001, ID: 42}
+
+	parsed, err := ParseEventCursor(cursor.Encode())
+	s.NoError(err)
+	s.Equal(cursor, parsed)
+
+	for _, invalid := range []string{
+		"",
+		"not base64!",
+		base64.RawURLEncoding.EncodeToString([]byte("18000001")),
+		base64.RawURLEncoding.EncodeToString([]byte("18000001_0")),
+		base64.RawURLEncoding.EncodeToString([]byte("abc_42")),
+	} {
+		_, err := ParseEventCursor(invalid)
+		s.ErrorIs(err, ErrInvalidEventCursor, invalid)
+	}
+}
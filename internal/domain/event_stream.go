@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// 事件订阅的位置. 续订令牌由它编码而来, 断线重连时从这个位置继续推送
+type EventStreamPosition struct {
+	Cursor    uint64 // 已经处理到的区块(包含没有命中过滤条件的区块), 之后只推送大于它的区块
+	LastBlock uint64 // 最后一个推送了事件的区块, 作为下一次推送的 prev_block_number
+}
+
+// Token 生成不透明的续订令牌. 续订时需要使用与之前相同的过滤条件
+func (p EventStreamPosition) Token() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d_%d", p.Cursor, p.LastBlock)))
+}
+
+func ParseResumeToken(token string) (EventStreamPosition, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return EventStreamPosition{}, ErrInvalidResumeToken
+	}
+
+	var position EventStreamPosition
+	if n, err := fmt.Sscanf(string(data), "%d_%d", &position.Cursor, &position.LastBlock); err != nil || n != 2 || position.LastBlock > position.Cursor {
+		return EventStreamPosition{}, ErrInvalidResumeToken
+	}
+
+	return position, nil
+}
+
+// 事件订阅推送的消息
+type EventNotification struct {
+	Block    *EventsByBlock      // 命中过滤条件的事件. 追平通知时为空
+	Position EventStreamPosition // 推送这条消息之后的订阅位置
+	CaughtUp bool                // 是否已经追平最新处理的区块. 为 false 时表示还在从数据库回放历史事件
+}
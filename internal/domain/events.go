@@ -13,12 +13,16 @@ import (
 type EventKind uint8
 
 const (
-	_EventKindIERC20TickCreated EventKind = iota
-	_EventKindIERC20Minted
-	_EventKindIERCPoWTickCreated
-	_EventKindIERCPoWMinted
-	_EventKindIERC20Transferred
-	_EventKindStakingPoolUpdated
+	EventKindIERC20TickCreated EventKind = iota
+	EventKindIERC20Minted
+	EventKindIERCPoWTickCreated
+	EventKindIERCPoWMinted
+	EventKindIERC20Transferred
+	EventKindStakingPoolUpdated
+	EventKindIERC20Burned
+	EventKindIERC20Approved
+	EventKindIERC20VestingCreated
+	EventKindIERC20VestingClaimed
 )
 
 type EventDetail interface {
@@ -115,6 +119,45 @@ type (
 		Sign        string            `json:"sign,omitempty"`         // 签名
 	}
 
+	IERC20Burned struct {
+		Protocol protocol.Protocol `json:"protocol"`
+		Operate  protocol.Operate  `json:"operate"`
+		Tick     string            `json:"tick"`
+		From     string            `json:"from"`   // 销毁者地址
+		Amount   decimal.Decimal   `json:"amount"` // 销毁数量
+	}
+
+	IERC20Approved struct {
+		Protocol  protocol.Protocol `json:"protocol"`
+		Operate   protocol.Operate  `json:"operate"`
+		Tick      string            `json:"tick"`
+		Owner     string            `json:"owner"`                // 资产所有者
+		Spender   string            `json:"spender"`              // 被授权者
+		Amount    decimal.Decimal   `json:"amount"`               // 授权额度
+		SignNonce string            `json:"sign_nonce,omitempty"` // 签名nonce
+		Sign      string            `json:"sign,omitempty"`       // 签名. 为空表示链上授权
+	}
+
+	IERC20VestingCreated struct {
+		Protocol   protocol.Protocol `json:"protocol"`
+		Operate    protocol.Operate  `json:"operate"`
+		Tick       string            `json:"tick"`
+		From       string            `json:"from"`        // 锁仓发起人
+		To         string            `json:"to"`          // 接收者
+		Amount     decimal.Decimal   `json:"amount"`      // 锁仓数量
+		StartBlock uint64            `json:"start_block"` // 开始释放区块
+		CliffBlock uint64            `json:"cliff_block"` // 锁定期结束区块
+		EndBlock   uint64            `json:"end_block"`   // 全部释放区块
+	}
+
+	IERC20VestingClaimed struct {
+		Protocol protocol.Protocol `json:"protocol"`
+		Operate  protocol.Operate  `json:"operate"`
+		Tick     string            `json:"tick"`
+		To       string            `json:"to"`     // 接收者
+		Amount   decimal.Decimal   `json:"amount"` // 本次领取数量
+	}
+
 	StakingPoolUpdated struct {
 		Protocol  protocol.Protocol            `json:"protocol"`
 		Operate   protocol.Operate             `json:"operate"`
@@ -132,27 +175,43 @@ type (
 
 func (i *IERC20TickCreated) GetProtocol() protocol.Protocol { return i.Protocol }
 func (i *IERC20TickCreated) GetOperate() protocol.Operate   { return i.Operate }
-func (i *IERC20TickCreated) Kind() EventKind                { return _EventKindIERC20TickCreated }
+func (i *IERC20TickCreated) Kind() EventKind                { return EventKindIERC20TickCreated }
 
 func (i *IERC20Minted) GetProtocol() protocol.Protocol { return i.Protocol }
 func (i *IERC20Minted) GetOperate() protocol.Operate   { return i.Operate }
-func (i *IERC20Minted) Kind() EventKind                { return _EventKindIERC20Minted }
+func (i *IERC20Minted) Kind() EventKind                { return EventKindIERC20Minted }
 
 func (i *IERCPoWTickCreated) GetProtocol() protocol.Protocol { return i.Protocol }
 func (i *IERCPoWTickCreated) GetOperate() protocol.Operate   { return i.Operate }
-func (i *IERCPoWTickCreated) Kind() EventKind                { return _EventKindIERCPoWTickCreated }
+func (i *IERCPoWTickCreated) Kind() EventKind                { return EventKindIERCPoWTickCreated }
 
 func (i *IERCPoWMinted) GetProtocol() protocol.Protocol { return i.Protocol }
 func (i *IERCPoWMinted) GetOperate() protocol.Operate   { return i.Operate }
-func (i *IERCPoWMinted) Kind() EventKind                { return _EventKindIERCPoWMinted }
+func (i *IERCPoWMinted) Kind() EventKind                { return EventKindIERCPoWMinted }
 
 func (i *IERC20Transferred) GetProtocol() protocol.Protocol { return i.Protocol }
 func (i *IERC20Transferred) GetOperate() protocol.Operate   { return i.Operate }
-func (i *IERC20Transferred) Kind() EventKind                { return _EventKindIERC20Transferred }
+func (i *IERC20Transferred) Kind() EventKind                { return EventKindIERC20Transferred }
+
+func (i *IERC20Burned) GetProtocol() protocol.Protocol { return i.Protocol }
+func (i *IERC20Burned) GetOperate() protocol.Operate   { return i.Operate }
+func (i *IERC20Burned) Kind() EventKind                { return EventKindIERC20Burned }
+
+func (i *IERC20Approved) GetProtocol() protocol.Protocol { return i.Protocol }
+func (i *IERC20Approved) GetOperate() protocol.Operate   { return i.Operate }
+func (i *IERC20Approved) Kind() EventKind                { return EventKindIERC20Approved }
+
+func (i *IERC20VestingCreated) GetProtocol() protocol.Protocol { return i.Protocol }
+func (i *IERC20VestingCreated) GetOperate() protocol.Operate   { return i.Operate }
+func (i *IERC20VestingCreated) Kind() EventKind                { return EventKindIERC20VestingCreated }
+
+func (i *IERC20VestingClaimed) GetProtocol() protocol.Protocol { return i.Protocol }
+func (i *IERC20VestingClaimed) GetOperate() protocol.Operate   { return i.Operate }
+func (i *IERC20VestingClaimed) Kind() EventKind                { return EventKindIERC20VestingClaimed }
 
 func (i *StakingPoolUpdated) GetProtocol() protocol.Protocol { return i.Protocol }
 func (i *StakingPoolUpdated) GetOperate() protocol.Operate   { return i.Operate }
-func (i *StakingPoolUpdated) Kind() EventKind                { return _EventKindStakingPoolUpdated }
+func (i *StakingPoolUpdated) Kind() EventKind                { return EventKindStakingPoolUpdated }
 
 var (
 	_ EventDetail = (*IERC20TickCreated)(nil)
@@ -160,6 +219,10 @@ var (
 	_ EventDetail = (*IERCPoWTickCreated)(nil)
 	_ EventDetail = (*IERCPoWMinted)(nil)
 	_ EventDetail = (*IERC20Transferred)(nil)
+	_ EventDetail = (*IERC20Burned)(nil)
+	_ EventDetail = (*IERC20Approved)(nil)
+	_ EventDetail = (*IERC20VestingCreated)(nil)
+	_ EventDetail = (*IERC20VestingClaimed)(nil)
 	_ EventDetail = (*StakingPoolUpdated)(nil)
 )
 
@@ -231,6 +294,11 @@ type (
 	IERCPoWMintedEvent      = event[*IERCPoWMinted]
 
 	IERC20TransferredEvent = event[*IERC20Transferred]
+	IERC20BurnedEvent      = event[*IERC20Burned]
+	IERC20ApprovedEvent    = event[*IERC20Approved]
+
+	IERC20VestingCreatedEvent = event[*IERC20VestingCreated]
+	IERC20VestingClaimedEvent = event[*IERC20VestingClaimed]
 
 	StakingPoolUpdatedEvent = event[*StakingPoolUpdated]
 )
@@ -241,50 +309,81 @@ var (
 	_ Event = (*IERCPoWTickCreatedEvent)(nil)
 	_ Event = (*IERCPoWMintedEvent)(nil)
 	_ Event = (*IERC20TransferredEvent)(nil)
+	_ Event = (*IERC20BurnedEvent)(nil)
+	_ Event = (*IERC20ApprovedEvent)(nil)
+	_ Event = (*IERC20VestingCreatedEvent)(nil)
+	_ Event = (*IERC20VestingClaimedEvent)(nil)
 	_ Event = (*StakingPoolUpdatedEvent)(nil)
 )
 
+// 事件索引字段, 用于事件落库时建立查询索引
+type EventIndex struct {
+	ETHFrom  string          // ETH 交易的发起者
+	ETHTo    string          // ETH 交易的接受者
+	IERCFrom string          // 协议层面的转出地址
+	IERCTo   string          // 协议层面的转入地址
+	Tick     string          // 事件相关的tick
+	Amount   decimal.Decimal // 事件相关的数量
+	Sign     string          // 签名
+}
+
+// 事件编解码器, 由协议模块注册
+type EventCodec struct {
+	Kind  EventKind
+	New   func() Event           // 创建一个空事件, 用于反序列化
+	Index func(Event) EventIndex // 提取事件的索引字段
+}
+
+// 根据事件类型创建事件编解码器
+func NewEventCodec[T EventDetail](index func(e *event[T]) EventIndex) EventCodec {
+	var detail T
+	return EventCodec{
+		Kind:  detail.Kind(),
+		New:   func() Event { return new(event[T]) },
+		Index: func(e Event) EventIndex { return index(e.(*event[T])) },
+	}
+}
+
+var eventCodecs = make(map[EventKind]EventCodec)
+
+// 注册事件编解码器. 同一种事件只能注册一次
+func RegisterEventCodec(codecs ...EventCodec) {
+	for _, codec := range codecs {
+		if _, existed := eventCodecs[codec.Kind]; existed {
+			panic(fmt.Sprintf("event codec already registered. kind: %d", codec.Kind))
+		}
+		eventCodecs[codec.Kind] = codec
+	}
+}
+
 func NewEventFromData(kind uint8, data []byte) Event {
-	switch EventKind(kind) {
-	case _EventKindIERC20TickCreated:
-		var event IERC20TickCreatedEvent
-		_ = jsoniter.Unmarshal(data, &event)
-		return &event
-
-	case _EventKindIERC20Minted:
-		var event IERC20MintedEvent
-		_ = jsoniter.Unmarshal(data, &event)
-		return &event
-
-	case _EventKindIERCPoWTickCreated:
-		var event IERCPoWTickCreatedEvent
-		_ = jsoniter.Unmarshal(data, &event)
-		return &event
-
-	case _EventKindIERCPoWMinted:
-		var event IERCPoWMintedEvent
-		_ = jsoniter.Unmarshal(data, &event)
-		return &event
-
-	case _EventKindIERC20Transferred:
-		var event IERC20TransferredEvent
-		_ = jsoniter.Unmarshal(data, &event)
-		return &event
-
-	case _EventKindStakingPoolUpdated:
-		var event StakingPoolUpdatedEvent
-		_ = jsoniter.Unmarshal(data, &event)
-		return &event
-
-	default:
+	codec, existed := eventCodecs[EventKind(kind)]
+	if !existed {
 		panic("invalid event model")
 	}
+
+	event := codec.New()
+	_ = jsoniter.Unmarshal(data, event)
+	return event
+}
+
+// 获取事件的索引字段
+func IndexEvent(e Event) EventIndex {
+	codec, existed := eventCodecs[e.GetEventKind()]
+	if !existed {
+		panic("invalid event type")
+	}
+
+	return codec.Index(e)
 }
 
 // 事件集合, 记录某一个区块的所有事件
 type EventsByBlock struct {
 	BlockNumber uint64
 	Events      []Event
+
+	filtered  bool   // 是否是经过 EventFilter 过滤后的事件集合
+	prevBlock uint64 // 过滤后的事件集合, 上一个命中过滤条件的区块
 }
 
 func (e *EventsByBlock) CurrentBlock() uint64 {
@@ -292,6 +391,10 @@ func (e *EventsByBlock) CurrentBlock() uint64 {
 }
 
 func (e *EventsByBlock) PreviousBlock() uint64 {
+	if e.filtered {
+		return e.prevBlock
+	}
+
 	if len(e.Events) == 0 {
 		return 0
 	}
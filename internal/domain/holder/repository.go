@@ -0,0 +1,15 @@
+package holder
+
+import (
+	"context"
+)
+
+type HolderRepository interface {
+	SaveStats(ctx context.Context, stats ...*Stats) error
+	// 不存在的 tick 不返回
+	LoadStats(ctx context.Context, ticks ...string) ([]*Stats, error)
+	// 按持仓数量倒序查询, 不包含余额为 0 的地址
+	TopHolders(ctx context.Context, tick string, limit int) ([]*Holder, error)
+	// 统计为空时根据当前余额全量重建
+	Rebuild(ctx context.Context) error
+}
@@ -0,0 +1,165 @@
+package holder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 持仓分布区间的边界. 区间为左闭右开, 第一个区间为 (0, 1), 最后一个区间为 [1e8, +∞)
+var BucketBounds = []decimal.Decimal{
+	decimal.NewFromInt(1),
+	decimal.NewFromInt(10),
+	decimal.NewFromInt(100),
+	decimal.NewFromInt(1_000),
+	decimal.NewFromInt(10_000),
+	decimal.NewFromInt(100_000),
+	decimal.NewFromInt(1_000_000),
+	decimal.NewFromInt(10_000_000),
+	decimal.NewFromInt(100_000_000),
+}
+
+// 持仓数量所在的区间
+func BucketOf(amount decimal.Decimal) int {
+	for idx, bound := range BucketBounds {
+		if amount.LessThan(bound) {
+			return idx
+		}
+	}
+
+	return len(BucketBounds)
+}
+
+// 区间的描述. 例如: 0-1, 1-10, 100000000+
+func BucketLabel(idx int) string {
+	switch {
+	case idx <= 0:
+		return fmt.Sprintf("0-%s", BucketBounds[0])
+	case idx >= len(BucketBounds):
+		return fmt.Sprintf("%s+", BucketBounds[len(BucketBounds)-1])
+	default:
+		return fmt.Sprintf("%s-%s", BucketBounds[idx-1], BucketBounds[idx])
+	}
+}
+
+// 持仓者. 余额为 可用 + 冻结
+type Holder struct {
+	Address string
+	Amount  decimal.Decimal
+}
+
+// 余额变化
+type BalanceChange struct {
+	Tick    string
+	Address string
+	Before  decimal.Decimal // 变化前的余额
+	After   decimal.Decimal // 变化后的余额
+}
+
+// tick 的持仓统计. 由余额变化增量维护
+type Stats struct {
+	Tick               string
+	Holders            int64     // 持仓地址数
+	Buckets            []int64   // 各区间的持仓地址数, 与 BucketBounds 对应
+	FirstActivityBlock uint64    // 第一次余额变化的区块
+	FirstActivityAt    time.Time // 第一次余额变化的时间
+	LastActivityBlock  uint64    // 最后一次余额变化的区块
+	LastActivityAt     time.Time // 最后一次余额变化的时间
+	LastUpdatedBlock   uint64    //
+}
+
+func NewStats(tick string) *Stats {
+	return &Stats{
+		Tick:    tick,
+		Buckets: make([]int64, len(BucketBounds)+1),
+	}
+}
+
+func (s *Stats) Copy() *Stats {
+	entity := *s
+	entity.Buckets = make([]int64, len(BucketBounds)+1)
+	copy(entity.Buckets, s.Buckets)
+	return &entity
+}
+
+// 累加一次余额变化
+func (s *Stats) Apply(blockNumber uint64, blockTime time.Time, change *BalanceChange) {
+	if len(s.Buckets) != len(BucketBounds)+1 {
+		buckets := make([]int64, len(BucketBounds)+1)
+		copy(buckets, s.Buckets)
+		s.Buckets = buckets
+	}
+
+	if change.Before.GreaterThan(decimal.Zero) {
+		s.Holders--
+		s.Buckets[BucketOf(change.Before)]--
+	}
+	if change.After.GreaterThan(decimal.Zero) {
+		s.Holders++
+		s.Buckets[BucketOf(change.After)]++
+	}
+
+	if s.FirstActivityBlock == 0 {
+		s.FirstActivityBlock = blockNumber
+		s.FirstActivityAt = blockTime
+	}
+	s.LastActivityBlock = blockNumber
+	s.LastActivityAt = blockTime
+	s.LastUpdatedBlock = blockNumber
+}
+
+// 变化涉及的所有 tick
+func ChangedTicks(changes []*BalanceChange) []string {
+	var (
+		ticks = make([]string, 0)
+		seen  = make(map[string]struct{})
+	)
+	for _, change := range changes {
+		if _, existed := seen[change.Tick]; existed {
+			continue
+		}
+		seen[change.Tick] = struct{}{}
+		ticks = append(ticks, change.Tick)
+	}
+
+	return ticks
+}
+
+// 将一个区块的余额变化合并到持仓统计中, 返回需要更新的统计.
+// 已经包含该区块变化的统计不会重复累加, 区块重复处理时结果不变
+func MergeStats(blockNumber uint64, blockTime time.Time, existing []*Stats, changes []*BalanceChange) []*Stats {
+	var (
+		statsMap = make(map[string]*Stats, len(existing))
+		updated  = make(map[string]struct{})
+		result   = make([]*Stats, 0)
+	)
+	for _, stats := range existing {
+		statsMap[stats.Tick] = stats
+	}
+
+	for _, change := range changes {
+		// 余额没有变化
+		if change.Before.Equal(change.After) {
+			continue
+		}
+
+		stats, existed := statsMap[change.Tick]
+		if !existed {
+			stats = NewStats(change.Tick)
+			statsMap[change.Tick] = stats
+		}
+
+		if _, existed := updated[change.Tick]; !existed {
+			if stats.LastUpdatedBlock >= blockNumber {
+				continue
+			}
+			updated[change.Tick] = struct{}{}
+			result = append(result, stats)
+		}
+
+		stats.Apply(blockNumber, blockTime, change)
+	}
+
+	return result
+}
@@ -0,0 +1,75 @@
+package holder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHolderStats(t *testing.T) {
+	suite.Run(t, new(TestHolderStatsSuite))
+}
+
+type TestHolderStatsSuite struct {
+	suite.Suite
+}
+
+func (s *TestHolderStatsSuite) change(address string, before, after string) *BalanceChange {
+	return &BalanceChange{
+		Tick:    "ethi",
+		Address: address,
+		Before:  decimal.RequireFromString(before),
+		After:   decimal.RequireFromString(after),
+	}
+}
+
+func (s *TestHolderStatsSuite) TestBucket() {
+	s.Equal(0, BucketOf(decimal.RequireFromString("0.5")))
+	s.Equal(1, BucketOf(decimal.NewFromInt(1)))
+	s.Equal(3, BucketOf(decimal.NewFromInt(999)))
+	s.Equal(len(BucketBounds), BucketOf(decimal.NewFromInt(1_000_000_000)))
+
+	s.Equal("0-1", BucketLabel(0))
+	s.Equal("10-100", BucketLabel(2))
+	s.Equal("100000000+", BucketLabel(len(BucketBounds)))
+}
+
+func (s *TestHolderStatsSuite) TestMergeStats() {
+	t1 := time.Unix(1700000000, 0)
+	changes := []*BalanceChange{
+		s.change("0x01", "0", "1000"),
+		s.change("0x02", "0", "5"),
+		s.change("0x03", "0", "0"), // 没有变化
+	}
+
+	result := MergeStats(100, t1, nil, changes)
+	s.Require().Len(result, 1)
+
+	stats := result[0]
+	s.Equal(int64(2), stats.Holders)
+	s.Equal(int64(1), stats.Buckets[BucketOf(decimal.NewFromInt(1000))])
+	s.Equal(int64(1), stats.Buckets[BucketOf(decimal.NewFromInt(5))])
+	s.Equal(uint64(100), stats.FirstActivityBlock)
+	s.Equal(uint64(100), stats.LastActivityBlock)
+
+	// 转出全部余额, 持仓地址数减少
+	t2 := t1.Add(time.Minute)
+	changes = []*BalanceChange{
+		s.change("0x01", "1000", "0"),
+		s.change("0x02", "5", "1005"),
+	}
+	result = MergeStats(101, t2, []*Stats{stats}, changes)
+	s.Require().Len(result, 1)
+	s.Equal(int64(1), stats.Holders)
+	s.Equal(int64(1), stats.Buckets[BucketOf(decimal.NewFromInt(1005))])
+	s.Equal(int64(0), stats.Buckets[BucketOf(decimal.NewFromInt(5))])
+	s.Equal(uint64(100), stats.FirstActivityBlock)
+	s.Equal(uint64(101), stats.LastActivityBlock)
+	s.Equal(t2, stats.LastActivityAt)
+
+	// 重复处理同一个区块不会重复累加
+	s.Empty(MergeStats(101, t2, []*Stats{stats}, changes))
+	s.Equal(int64(1), stats.Holders)
+}
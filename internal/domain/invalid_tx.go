@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+)
+
+// 无效交易. 命中的交易不会被处理, 交易状态记为 protocol.InvalidTxHash
+type InvalidTx struct {
+	TxHash         string    // 交易hash
+	Category       string    // 分类. 例如 freeze_sell、transfer, 对应配置文件中的 key
+	Reason         string    // 原因
+	EffectiveBlock uint64    // 生效区块. 只对该区块及之后的交易生效, 保证重放时结果一致
+	CreatedAt      time.Time // 创建时间
+}
+
+// 无效交易集合. 只读快照, 处理区块时使用, 不允许修改
+type InvalidTxSet map[string]*InvalidTx
+
+func NewInvalidTxSet(entries ...*InvalidTx) InvalidTxSet {
+	var set = make(InvalidTxSet, len(entries))
+	for _, entry := range entries {
+		set[entry.TxHash] = entry
+	}
+	return set
+}
+
+// 交易在指定区块是否无效
+func (s InvalidTxSet) Contains(txHash string, blockNumber uint64) bool {
+	entry, existed := s[txHash]
+	return existed && blockNumber >= entry.EffectiveBlock
+}
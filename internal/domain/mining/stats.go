@@ -0,0 +1,103 @@
+package mining
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 按错误码统计的 mint 失败次数
+type Rejection struct {
+	Code   int32  `json:"code"`
+	Reason string `json:"reason"`
+	Count  int64  `json:"count"`
+}
+
+// tick 在单个区块内的挖矿统计
+type BlockStats struct {
+	ID            int64
+	Tick          string
+	BlockNumber   uint64
+	Miners        int64           // mint 成功的矿工数
+	PoWMiners     int64           // 获得 pow 份额的矿工数
+	PoSMiners     int64           // 获得 pos 份额的矿工数
+	DualMiners    int64           // 同时获得 pow 和 pos 份额的矿工数
+	PoWTotalShare decimal.Decimal // pow 总份额
+	PoSTotalShare decimal.Decimal // pos 总份额
+	PoWOutput     decimal.Decimal // pow mint 的数量
+	PoSOutput     decimal.Decimal // pos mint 的数量
+	BurnAmount    decimal.Decimal // 销毁的数量
+	Rejected      int64           // mint 失败次数
+	Rejections    []*Rejection    // 按错误码统计的失败次数, 按错误码排序
+	CreatedAt     time.Time
+}
+
+func NewBlockStats(tick string, blockNumber uint64) *BlockStats {
+	return &BlockStats{
+		Tick:          tick,
+		BlockNumber:   blockNumber,
+		PoWTotalShare: decimal.Zero,
+		PoSTotalShare: decimal.Zero,
+		PoWOutput:     decimal.Zero,
+		PoSOutput:     decimal.Zero,
+		BurnAmount:    decimal.Zero,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (s *BlockStats) SetTotalShare(powTotalShare, posTotalShare decimal.Decimal) {
+	s.PoWTotalShare = powTotalShare
+	s.PoSTotalShare = posTotalShare
+}
+
+// 记录一次成功的 mint
+func (s *BlockStats) AddMinted(powShare, posShare, powAmount, posAmount decimal.Decimal) {
+	var (
+		isPoW = powShare.GreaterThan(decimal.Zero)
+		isPoS = posShare.GreaterThan(decimal.Zero)
+	)
+
+	s.Miners++
+	if isPoW {
+		s.PoWMiners++
+	}
+	if isPoS {
+		s.PoSMiners++
+	}
+	if isPoW && isPoS {
+		s.DualMiners++
+	}
+
+	s.PoWOutput = s.PoWOutput.Add(powAmount)
+	s.PoSOutput = s.PoSOutput.Add(posAmount)
+}
+
+func (s *BlockStats) AddBurned(amount decimal.Decimal) {
+	s.BurnAmount = s.BurnAmount.Add(amount)
+}
+
+// 记录一次失败的 mint
+func (s *BlockStats) AddRejected(code int32, reason string) {
+	s.Rejected++
+
+	for _, rejection := range s.Rejections {
+		if rejection.Code == code {
+			rejection.Count++
+			return
+		}
+	}
+
+	s.Rejections = append(s.Rejections, &Rejection{Code: code, Reason: reason, Count: 1})
+	sort.Slice(s.Rejections, func(i, j int) bool {
+		return s.Rejections[i].Code < s.Rejections[j].Code
+	})
+}
+
+// 挖矿统计查询条件
+type StatsQuery struct {
+	Tick       string
+	StartBlock uint64 // 包含
+	EndBlock   uint64 // 包含, 0 表示不限制
+	Limit      int
+}
@@ -0,0 +1,46 @@
+package mining
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBlockStats(t *testing.T) {
+	suite.Run(t, new(TestBlockStatsSuite))
+}
+
+type TestBlockStatsSuite struct {
+	suite.Suite
+}
+
+func (s *TestBlockStatsSuite) TestAddMinted() {
+	stats := NewBlockStats("ethpi", 100)
+
+	stats.AddMinted(decimal.NewFromInt(1), decimal.Zero, decimal.NewFromInt(10), decimal.Zero)
+	stats.AddMinted(decimal.Zero, decimal.NewFromInt(5), decimal.Zero, decimal.NewFromInt(20))
+	stats.AddMinted(decimal.NewFromInt(2), decimal.NewFromInt(5), decimal.NewFromInt(20), decimal.NewFromInt(20))
+	stats.AddBurned(decimal.NewFromInt(3))
+
+	s.Equal(int64(3), stats.Miners)
+	s.Equal(int64(2), stats.PoWMiners)
+	s.Equal(int64(2), stats.PoSMiners)
+	s.Equal(int64(1), stats.DualMiners)
+	s.True(stats.PoWOutput.Equal(decimal.NewFromInt(30)))
+	s.True(stats.PoSOutput.Equal(decimal.NewFromInt(40)))
+	s.True(stats.BurnAmount.Equal(decimal.NewFromInt(3)))
+}
+
+func (s *TestBlockStatsSuite) TestAddRejected() {
+	stats := NewBlockStats("ethpi", 100)
+
+	stats.AddRejected(30, "block expires")
+	stats.AddRejected(10, "has been minted")
+	stats.AddRejected(30, "block expires")
+
+	s.Equal(int64(3), stats.Rejected)
+	s.Len(stats.Rejections, 2)
+	s.Equal(&Rejection{Code: 10, Reason: "has been minted", Count: 1}, stats.Rejections[0])
+	s.Equal(&Rejection{Code: 30, Reason: "block expires", Count: 2}, stats.Rejections[1])
+}
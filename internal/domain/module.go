@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+)
+
+// 协议处理器. 由协议模块实现, 聚合根和区块服务通过它来处理各个协议的交易, 不再关心具体的命令类型
+type ProtocolHandler interface {
+	// 收集交易依赖的状态(tick、余额、签名以及模块自有的状态). 不支持的交易返回 false
+	CollectDependencies(tx protocol.IERCTransaction, deps *Dependencies) bool
+	// 区块处理前的准备工作. 例如 pow mint 需要先统计整个区块的份额
+	Prepare(root *AggregateRoot)
+	// 处理交易. 不支持的交易返回 false
+	Handle(root *AggregateRoot, tx protocol.IERCTransaction) (bool, error)
+}
+
+// 协议模块自有的状态, 例如 ierc-20 的授权和锁仓. 每个区块创建一份:
+// 模块在 CollectDependencies 中登记需要的数据, 区块服务在预处理时调用 Load 加载,
+// 模块在 Handle 中读写, 处理完成后区块服务在区块事务中调用 Save 保存
+type ModuleState interface {
+	// 状态名称, 模块通过名称找到自己的状态
+	Name() string
+	// 加载登记的数据. 与 tick、余额等并发加载
+	Load(ctx context.Context) error
+	// 保存当前区块变更的数据
+	Save(ctx context.Context, blockNumber uint64) error
+}
+
+// 模块状态的工厂. 由协议模块提供, 持有加载和保存状态需要的仓储
+type ModuleStateFactory interface {
+	NewState() ModuleState
+}
+
+// 当前区块的模块状态
+type ModuleStates []ModuleState
+
+// 根据名称获取模块状态, 不存在时返回 nil
+func (s ModuleStates) Get(name string) ModuleState {
+	for _, state := range s {
+		if state.Name() == name {
+			return state
+		}
+	}
+	return nil
+}
+
+// 区块处理依赖的状态
+type Dependencies struct {
+	Ticks         mapset.Set[string]             // 当前区块涉及到的所有tick
+	Balances      mapset.Set[balance.BalanceKey] // 当前区块涉及到的所有余额信息
+	Signatures    mapset.Set[string]             // 当前区块涉及到的所有签名
+	UnfreezeSigns mapset.Set[string]             // 当前区块涉及到的解冻事件相关的签名
+	States        ModuleStates                   // 模块自有的状态, 由模块自己登记需要加载的数据
+}
+
+func NewDependencies(states ...ModuleState) *Dependencies {
+	return &Dependencies{
+		Ticks:         mapset.NewSet[string](),
+		Balances:      mapset.NewSet[balance.BalanceKey](),
+		Signatures:    mapset.NewSet[string](),
+		UnfreezeSigns: mapset.NewSet[string](),
+		States:        states,
+	}
+}
+
+func (d *Dependencies) AddTick(tick string) {
+	d.Ticks.Add(tick)
+}
+
+func (d *Dependencies) AddBalance(address, tick string) {
+	d.Balances.Add(balance.NewBalanceKey(address, tick))
+}
+
+func (d *Dependencies) AddSignature(sign string) {
+	d.Signatures.Add(sign)
+}
+
+// 解冻的签名需要在加载签名事件之后, 再补全冻结时的 tick 和余额
+func (d *Dependencies) AddUnfreezeSignature(sign string) {
+	d.Signatures.Add(sign)
+	d.UnfreezeSigns.Add(sign)
+}
@@ -0,0 +1,292 @@
+package module
+
+import (
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol/parser"
+)
+
+// ierc-20 协议模块. 包含 terc-20、ierc-20 的部署、mint、划转、销毁、授权、锁仓、挂单、结算以及质押
+type ierc20Module struct {
+	parser parser.Parser
+}
+
+func NewIERC20Module() Module {
+	return &ierc20Module{
+		parser: parser.NewIERC20Parser(protocol.ProtocolHeader, protocol.TickETHI),
+	}
+}
+
+func (m *ierc20Module) Name() string { return string(protocol.ProtocolIERC20) }
+
+func (m *ierc20Module) Protocols() []protocol.Protocol {
+	return []protocol.Protocol{protocol.ProtocolTERC20, protocol.ProtocolIERC20}
+}
+
+func (m *ierc20Module) Parser() parser.Parser { return m.parser }
+
+// 获取模块自有的状态, 由区块服务通过 NewStateFactories 创建
+func (m *ierc20Module) state(states domain.ModuleStates) *ierc20State {
+	return states.Get(m.Name()).(*ierc20State)
+}
+
+func (m *ierc20Module) CollectDependencies(tx protocol.IERCTransaction, deps *domain.Dependencies) bool {
+	switch t := tx.(type) {
+	// 部署
+	case *protocol.DeployCommand:
+		deps.AddTick(t.Tick)
+
+	// 挖矿
+	case *protocol.MintCommand:
+		deps.AddTick(t.Tick)
+		deps.AddBalance(t.From, t.Tick)
+
+	// 划转
+	case *protocol.TransferCommand:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.From, record.Tick)
+			deps.AddBalance(record.Recv, record.Tick)
+		}
+
+	// 销毁
+	case *protocol.BurnCommand:
+		deps.AddTick(t.Tick)
+		deps.AddBalance(t.From, t.Tick)
+
+	// 授权
+	case *protocol.ApproveCommand:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			m.state(deps.States).addAllowance(record.Owner, record.Spender, record.Tick)
+		}
+
+	// 授权划转
+	case *protocol.TransferFromCommand:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.From, record.Tick)
+			deps.AddBalance(record.Recv, record.Tick)
+			m.state(deps.States).addAllowance(record.From, record.Spender, record.Tick)
+		}
+
+	// 锁仓
+	case *protocol.VestCommand:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.From, record.Tick)
+		}
+
+	// 领取锁仓
+	case *protocol.ClaimVestedCommand:
+		deps.AddTick(t.Tick)
+		deps.AddBalance(t.From, t.Tick)
+		m.state(deps.States).addVesting(t.From, t.Tick)
+
+	// 冻结
+	case *protocol.FreezeSellCommand:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.Seller, record.Tick)
+			deps.AddSignature(record.SellerSign)
+		}
+
+	// 冻结V4
+	case *protocol.FreezeSellCommandV4:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.Seller, record.Tick)
+			deps.AddSignature(record.SellerSign)
+		}
+
+	// 解冻
+	case *protocol.UnfreezeSellCommand:
+		for _, record := range t.Records {
+			deps.AddUnfreezeSignature(record.Sign)
+		}
+
+	// 结算
+	case *protocol.ProxyTransferCommand:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.From, record.Tick)
+			deps.AddBalance(record.To, record.Tick)
+			deps.AddSignature(record.Sign)
+		}
+
+	// 结算v4
+	case *protocol.ProxyTransferCommandV4:
+		for _, record := range t.Records {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.From, record.Tick)
+			deps.AddBalance(record.To, record.Tick)
+			deps.AddSignature(record.Sign)
+		}
+
+	// 配置质押池
+	case *protocol.ConfigStakeCommand:
+		for _, record := range t.Details {
+			deps.AddTick(record.Tick)
+		}
+
+	// 质押
+	case *protocol.StakingCommand:
+		for _, record := range t.Details {
+			deps.AddTick(record.Tick)
+			deps.AddBalance(record.Pool, record.Tick)
+			deps.AddBalance(record.Staker, record.Tick)
+		}
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (m *ierc20Module) Prepare(_ *domain.AggregateRoot) {}
+
+func (m *ierc20Module) Handle(root *domain.AggregateRoot, tx protocol.IERCTransaction) (bool, error) {
+	var err error
+	switch t := tx.(type) {
+	case *protocol.DeployCommand:
+		err = root.HandleDeploy(t)
+
+	case *protocol.MintCommand:
+		err = root.HandleMint(t)
+
+	case *protocol.TransferCommand:
+		err = root.HandleTransfer(t)
+
+	case *protocol.BurnCommand:
+		err = root.HandleBurn(t)
+
+	case *protocol.ApproveCommand:
+		err = m.handleApprove(root, m.state(root.States), t)
+
+	case *protocol.TransferFromCommand:
+		err = m.handleTransferFrom(root, m.state(root.States), t)
+
+	case *protocol.VestCommand:
+		err = m.handleVest(root, m.state(root.States), t)
+
+	case *protocol.ClaimVestedCommand:
+		err = m.handleClaimVested(root, m.state(root.States), t)
+
+	case *protocol.UnfreezeSellCommand:
+		err = root.HandleUnfreezeSell(t)
+
+	case *protocol.FreezeSellCommandV4:
+		err = root.HandleFreezeSellV4(t)
+
+	case *protocol.FreezeSellCommand:
+		err = root.HandleFreezeSell(t)
+
+	case *protocol.ProxyTransferCommandV4:
+		err = root.HandleProxyTransferV4(t)
+
+	case *protocol.ProxyTransferCommand:
+		err = root.HandleProxyTransfer(t)
+
+	case *protocol.ConfigStakeCommand:
+		err = root.HandleConfigStaking(t)
+
+	case *protocol.StakingCommand:
+		switch t.Operate {
+		case protocol.OpStaking:
+			err = root.HandleStaking(t)
+		case protocol.OpUnStaking:
+			err = root.HandleUnStaking(t)
+		case protocol.OpProxyUnStaking:
+			err = root.HandleProxyUnStaking(t)
+		}
+
+	default:
+		return false, nil
+	}
+
+	return true, err
+}
+
+func (m *ierc20Module) EventCodecs() []domain.EventCodec {
+	return []domain.EventCodec{
+		domain.NewEventCodec(func(e *domain.IERC20TickCreatedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.From,
+				IERCTo:   e.To,
+				Tick:     e.Data.Tick,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.IERC20MintedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.Data.From,
+				IERCTo:   e.Data.To,
+				Tick:     e.Data.Tick,
+				Amount:   e.Data.MintedAmount,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.IERC20TransferredEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.Data.From,
+				IERCTo:   e.Data.To,
+				Tick:     e.Data.Tick,
+				Amount:   e.Data.Amount,
+				Sign:     e.Data.Sign,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.IERC20BurnedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.Data.From,
+				IERCTo:   protocol.ZeroAddress,
+				Tick:     e.Data.Tick,
+				Amount:   e.Data.Amount,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.IERC20ApprovedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.Data.Owner,
+				IERCTo:   e.Data.Spender,
+				Tick:     e.Data.Tick,
+				Amount:   e.Data.Amount,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.IERC20VestingCreatedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.Data.From,
+				IERCTo:   e.Data.To,
+				Tick:     e.Data.Tick,
+				Amount:   e.Data.Amount,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.IERC20VestingClaimedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.From,
+				IERCTo:   e.Data.To,
+				Tick:     e.Data.Tick,
+				Amount:   e.Data.Amount,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.StakingPoolUpdatedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.From,
+				IERCTo:   e.To,
+			}
+		}),
+	}
+}
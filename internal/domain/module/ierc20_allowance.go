@@ -0,0 +1,170 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+)
+
+func (m *ierc20Module) handleApprove(root *domain.AggregateRoot, state *ierc20State, command *protocol.ApproveCommand) error {
+
+	for idx, record := range command.Records {
+
+		ee := &domain.IERC20ApprovedEvent{
+			BlockNumber:       command.BlockNumber,
+			PrevBlockNumber:   root.PreviousBlock,
+			TxHash:            command.TxHash,
+			PositionInIERCTxs: idx,
+			From:              command.From,
+			To:                command.To,
+			Value:             command.TxValue.String(),
+			Data: &domain.IERC20Approved{
+				Protocol:  record.Protocol,
+				Operate:   record.Operate,
+				Tick:      record.Tick,
+				Owner:     record.Owner,
+				Spender:   record.Spender,
+				Amount:    record.Amount,
+				SignNonce: record.SignNonce,
+				Sign:      record.Sign,
+			},
+			ErrCode:   0,
+			ErrReason: "",
+			EventAt:   command.EventAt,
+		}
+
+		root.Events = append(root.Events, ee)
+
+		if err := root.CheckTxHash(command.TxHash); err != nil {
+			ee.SetError(err)
+			continue
+		}
+
+		if err := m.handleApproveRecord(root, state, &record); err != nil {
+			ee.SetError(err)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (m *ierc20Module) handleApproveRecord(root *domain.AggregateRoot, state *ierc20State, record *protocol.ApproveRecord) error {
+	if err := record.ValidateParams(); err != nil {
+		return err
+	}
+
+	if _, existed := root.TicksMap[record.Tick]; !existed {
+		return protocol.NewProtocolError(protocol.TickNotExist, "tick not exist")
+	}
+
+	entity := state.getOrCreateAllowance(record.Owner, record.Spender, record.Tick)
+
+	// 链上授权, 交易发起人即资产所有者
+	if !record.IsSigned() {
+		entity.Approve(root.Block.Number, record.Amount)
+		return nil
+	}
+
+	// 签名授权, 任何人都可以提交签名
+	if record.Expire != 0 && root.Block.Number > record.Expire {
+		return protocol.NewProtocolError(
+			protocol.SignatureExpired,
+			fmt.Sprintf("signature expired. expire(%d) < block(%d)", record.Expire, root.Block.Number),
+		)
+	}
+
+	nonce, err := decimal.NewFromString(record.SignNonce)
+	if err != nil {
+		return protocol.NewProtocolError(protocol.InvalidSignNonce, fmt.Sprintf("invalid sign nonce(%s)", record.SignNonce))
+	}
+
+	// nonce 必须递增, 防止签名被重复使用
+	if !nonce.GreaterThan(entity.SignNonce) {
+		return protocol.NewProtocolError(
+			protocol.InvalidSignNonce,
+			fmt.Sprintf("sign nonce(%s) must be greater than the last used nonce(%s)", nonce, entity.SignNonce),
+		)
+	}
+
+	if err := record.ValidateSignature(root.Block.Number); err != nil {
+		return err
+	}
+
+	entity.ApproveWithSign(root.Block.Number, record.Amount, nonce)
+	return nil
+}
+
+func (m *ierc20Module) handleTransferFrom(root *domain.AggregateRoot, state *ierc20State, command *protocol.TransferFromCommand) error {
+
+	for idx, record := range command.Records {
+
+		ee := &domain.IERC20TransferredEvent{
+			BlockNumber:       command.BlockNumber,
+			PrevBlockNumber:   root.PreviousBlock,
+			TxHash:            command.TxHash,
+			PositionInIERCTxs: idx,
+			From:              command.From,
+			To:                command.To,
+			Value:             command.TxValue.String(),
+			Data: &domain.IERC20Transferred{
+				Protocol: record.Protocol,
+				Operate:  record.Operate,
+				Tick:     record.Tick,
+				From:     record.From,
+				To:       record.Recv,
+				Amount:   record.Amount,
+			},
+			ErrCode:   0,
+			ErrReason: "",
+			EventAt:   command.EventAt,
+		}
+
+		root.Events = append(root.Events, ee)
+
+		if err := root.CheckTxHash(command.TxHash); err != nil {
+			ee.SetError(err)
+			continue
+		}
+
+		if _, existed := root.TicksMap[record.Tick]; !existed {
+			ee.SetError(protocol.NewProtocolError(protocol.TickNotExist, "tick not exist"))
+			continue
+		}
+
+		if err := m.handleTransferFromRecord(root, state, &record); err != nil {
+			ee.SetError(err)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (m *ierc20Module) handleTransferFromRecord(root *domain.AggregateRoot, state *ierc20State, record *protocol.TransferFromRecord) error {
+	// 剩余授权额度 < 划转数量, 划转失败
+	entity := state.getOrCreateAllowance(record.From, record.Spender, record.Tick)
+	if entity.Amount.LessThan(record.Amount) {
+		return protocol.NewProtocolError(
+			protocol.InsufficientAllowance,
+			fmt.Sprintf("insufficient allowance. allowance(%s) < transfer(%s)", entity.Amount, record.Amount),
+		)
+	}
+
+	err := root.TransferAvailable(&protocol.TransferRecord{
+		Protocol: record.Protocol,
+		Operate:  record.Operate,
+		Tick:     record.Tick,
+		From:     record.From,
+		Recv:     record.Recv,
+		Amount:   record.Amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	entity.Spend(root.Block.Number, record.Amount)
+	return nil
+}
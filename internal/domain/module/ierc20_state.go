@@ -0,0 +1,171 @@
+package module
+
+import (
+	"context"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/allowance"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
+	"golang.org/x/sync/errgroup"
+)
+
+// ierc-20 模块自有的状态: 授权和锁仓
+type ierc20State struct {
+	allowanceRepo allowance.AllowanceRepository
+	vestingRepo   vesting.VestingRepository
+
+	allowanceKeys mapset.Set[allowance.AllowanceKey] // 当前区块涉及到的所有授权
+	vestingKeys   mapset.Set[vesting.VestingKey]     // 当前区块涉及到的所有锁仓
+
+	allowances map[allowance.AllowanceKey]*allowance.Allowance // 当前区块相关的授权
+	vestings   map[vesting.VestingKey][]*vesting.Vesting       // 当前区块相关的锁仓
+}
+
+func newIERC20State(allowanceRepo allowance.AllowanceRepository, vestingRepo vesting.VestingRepository) *ierc20State {
+	return &ierc20State{
+		allowanceRepo: allowanceRepo,
+		vestingRepo:   vestingRepo,
+		allowanceKeys: mapset.NewSet[allowance.AllowanceKey](),
+		vestingKeys:   mapset.NewSet[vesting.VestingKey](),
+		allowances:    make(map[allowance.AllowanceKey]*allowance.Allowance),
+		vestings:      make(map[vesting.VestingKey][]*vesting.Vesting),
+	}
+}
+
+func (s *ierc20State) Name() string { return string(protocol.ProtocolIERC20) }
+
+func (s *ierc20State) addAllowance(owner, spender, tick string) {
+	s.allowanceKeys.Add(allowance.NewAllowanceKey(owner, spender, tick))
+}
+
+func (s *ierc20State) addVesting(recipient, tick string) {
+	s.vestingKeys.Add(vesting.NewVestingKey(recipient, tick))
+}
+
+func (s *ierc20State) Load(ctx context.Context) error {
+	eg, gCtx := errgroup.WithContext(ctx)
+	// 加载授权信息
+	eg.Go(func() error {
+		return s.loadAllowances(gCtx)
+	})
+
+	// 加载锁仓信息
+	eg.Go(func() error {
+		return s.loadVestings(gCtx)
+	})
+
+	return eg.Wait()
+}
+
+// 加载授权信息
+func (s *ierc20State) loadAllowances(ctx context.Context) error {
+	for _, key := range s.allowanceKeys.ToSlice() {
+		if _, existed := s.allowances[key]; existed {
+			continue
+		}
+
+		entity, err := s.allowanceRepo.Load(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		if entity == nil {
+			continue
+		}
+
+		s.allowances[entity.Key()] = entity
+	}
+
+	return nil
+}
+
+// 加载锁仓信息. 只加载还未领取完的锁仓
+func (s *ierc20State) loadVestings(ctx context.Context) error {
+	for _, key := range s.vestingKeys.ToSlice() {
+		if _, existed := s.vestings[key]; existed {
+			continue
+		}
+
+		entities, err := s.vestingRepo.Load(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		if len(entities) == 0 {
+			continue
+		}
+
+		s.vestings[key] = entities
+	}
+
+	return nil
+}
+
+func (s *ierc20State) Save(ctx context.Context, blockNumber uint64) error {
+	var (
+		needUpdateAllowances = make([]*allowance.Allowance, 0, len(s.allowances))
+		needUpdateVestings   = make([]*vesting.Vesting, 0)
+	)
+
+	// 统计需要更新的 allowance
+	for _, entity := range s.allowances {
+		if entity.LastUpdatedBlock < blockNumber {
+			continue
+		}
+
+		needUpdateAllowances = append(needUpdateAllowances, entity)
+	}
+
+	// 统计需要更新的 vesting
+	for _, entities := range s.vestings {
+		for _, entity := range entities {
+			if entity.LastUpdatedBlock < blockNumber {
+				continue
+			}
+
+			needUpdateVestings = append(needUpdateVestings, entity)
+		}
+	}
+
+	// 更新授权
+	if err := s.allowanceRepo.Save(ctx, needUpdateAllowances...); err != nil {
+		return err
+	}
+
+	// 更新锁仓
+	return s.vestingRepo.Save(ctx, needUpdateVestings...)
+}
+
+func (s *ierc20State) getOrCreateAllowance(owner, spender, tick string) *allowance.Allowance {
+	key := allowance.NewAllowanceKey(owner, spender, tick)
+	entity, existed := s.allowances[key]
+	if existed {
+		return entity
+	}
+
+	entity = allowance.NewAllowance(owner, spender, tick)
+	entity.CreatedAt = time.Now()
+	entity.UpdatedAt = time.Now()
+
+	s.allowances[key] = entity
+
+	return entity
+}
+
+// ierc-20 模块状态的工厂
+type ierc20StateFactory struct {
+	allowanceRepo allowance.AllowanceRepository
+	vestingRepo   vesting.VestingRepository
+}
+
+func (f *ierc20StateFactory) NewState() domain.ModuleState {
+	return newIERC20State(f.allowanceRepo, f.vestingRepo)
+}
+
+var (
+	_ domain.ModuleState        = (*ierc20State)(nil)
+	_ domain.ModuleStateFactory = (*ierc20StateFactory)(nil)
+)
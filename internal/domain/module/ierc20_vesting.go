@@ -0,0 +1,173 @@
+package module
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
+	"github.com/shopspring/decimal"
+)
+
+func (m *ierc20Module) handleVest(root *domain.AggregateRoot, state *ierc20State, command *protocol.VestCommand) error {
+
+	for idx, record := range command.Records {
+
+		// 未指定开始区块时, 从当前区块开始释放
+		startBlock := record.StartBlock
+		if startBlock == 0 {
+			startBlock = root.Block.Number
+		}
+
+		ee := &domain.IERC20VestingCreatedEvent{
+			BlockNumber:       command.BlockNumber,
+			PrevBlockNumber:   root.PreviousBlock,
+			TxHash:            command.TxHash,
+			PositionInIERCTxs: idx,
+			From:              command.From,
+			To:                command.To,
+			Value:             command.TxValue.String(),
+			Data: &domain.IERC20VestingCreated{
+				Protocol:   record.Protocol,
+				Operate:    record.Operate,
+				Tick:       record.Tick,
+				From:       record.From,
+				To:         record.Recv,
+				Amount:     record.Amount,
+				StartBlock: startBlock,
+				CliffBlock: record.CliffBlock,
+				EndBlock:   record.EndBlock,
+			},
+			ErrCode:   0,
+			ErrReason: "",
+			EventAt:   command.EventAt,
+		}
+
+		root.Events = append(root.Events, ee)
+
+		if err := root.CheckTxHash(command.TxHash); err != nil {
+			ee.SetError(err)
+			continue
+		}
+
+		tickEntity, existed := root.TicksMap[record.Tick]
+		if !existed {
+			ee.SetError(protocol.NewProtocolError(protocol.TickNotExist, "tick not exist"))
+			continue
+		}
+
+		if err := checkVestingSchedule(root.Block.Number, startBlock, record.CliffBlock, record.EndBlock); err != nil {
+			ee.SetError(err)
+			continue
+		}
+
+		// 可用余额 < 锁仓数量, 锁仓失败
+		fromBalance := root.GetOrCreateBalance(record.From, record.Tick)
+		if fromBalance.Available.LessThan(record.Amount) {
+			ee.SetError(protocol.NewProtocolError(
+				protocol.InsufficientAvailableFunds,
+				fmt.Sprintf("insufficient balance. available(%s) < vest(%s)", fromBalance.Available, record.Amount),
+			))
+			continue
+		}
+
+		entity := vesting.NewVesting(
+			vesting.NewVestID(command.TxHash, idx),
+			record.Tick,
+			record.From,
+			record.Recv,
+			record.Amount,
+			startBlock,
+			record.CliffBlock,
+			record.EndBlock,
+			int32(tickEntity.GetDecimals()),
+		)
+		entity.LastUpdatedBlock = root.Block.Number
+		entity.CreatedAt = time.Now()
+		entity.UpdatedAt = time.Now()
+
+		fromBalance.SubAvailable(root.Block.Number, record.Amount)
+		state.vestings[entity.Key()] = append(state.vestings[entity.Key()], entity)
+	}
+
+	return nil
+}
+
+// 确定开始区块后校验释放计划. 不允许从已经过去的区块开始释放, 否则锁仓创建后立即可以领取;
+// 未指定开始区块时锁定期也需要在 [start, end] 内
+func checkVestingSchedule(currentBlock, startBlock, cliffBlock, endBlock uint64) error {
+	if startBlock < currentBlock {
+		return protocol.NewProtocolError(
+			protocol.InvalidVestingSchedule,
+			fmt.Sprintf("start(%d) < current block(%d)", startBlock, currentBlock),
+		)
+	}
+
+	if startBlock > endBlock {
+		return protocol.NewProtocolError(
+			protocol.InvalidVestingSchedule,
+			fmt.Sprintf("start(%d) > end(%d)", startBlock, endBlock),
+		)
+	}
+
+	if cliffBlock != 0 && (cliffBlock < startBlock || cliffBlock > endBlock) {
+		return protocol.NewProtocolError(
+			protocol.InvalidVestingSchedule,
+			fmt.Sprintf("cliff(%d) must be in [start(%d), end(%d)]", cliffBlock, startBlock, endBlock),
+		)
+	}
+
+	return nil
+}
+
+func (m *ierc20Module) handleClaimVested(root *domain.AggregateRoot, state *ierc20State, command *protocol.ClaimVestedCommand) (err error) {
+
+	ee := &domain.IERC20VestingClaimedEvent{
+		BlockNumber:       command.BlockNumber,
+		PrevBlockNumber:   root.PreviousBlock,
+		TxHash:            command.TxHash,
+		PositionInIERCTxs: 0,
+		From:              command.From,
+		To:                command.To,
+		Value:             command.TxValue.String(),
+		Data: &domain.IERC20VestingClaimed{
+			Protocol: command.Protocol,
+			Operate:  command.Operate,
+			Tick:     command.Tick,
+			To:       command.From,
+			Amount:   decimal.Zero,
+		},
+		ErrCode:   0,
+		ErrReason: "",
+		EventAt:   command.EventAt,
+	}
+
+	defer func() {
+		ee.SetError(err)
+		root.Events = append(root.Events, ee)
+	}()
+
+	if err = root.CheckTxHash(command.TxHash); err != nil {
+		return
+	}
+
+	if _, existed := root.TicksMap[command.Tick]; !existed {
+		return protocol.NewProtocolError(protocol.TickNotExist, "tick not exist")
+	}
+
+	// 领取所有锁仓中已释放的部分
+	var claimed = decimal.Zero
+	for _, entity := range state.vestings[vesting.NewVestingKey(command.From, command.Tick)] {
+		claimed = claimed.Add(entity.Release(root.Block.Number))
+	}
+
+	if claimed.IsZero() {
+		return protocol.NewProtocolError(protocol.VestingNothingToClaim, "nothing to claim")
+	}
+
+	root.GetOrCreateBalance(command.From, command.Tick).AddAvailable(root.Block.Number, claimed)
+	ee.Data.Amount = claimed
+
+	return
+}
@@ -0,0 +1,104 @@
+package module
+
+import (
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol/parser"
+)
+
+// ierc-pow 协议模块. 包含 pow 的部署、mint、修改以及领取空投.
+// ierc-pow 的划转、挂单、结算复用 ierc-20 的命令, 由 ierc-20 模块处理
+type iercPoWModule struct {
+	parser parser.Parser
+}
+
+func NewIERCPoWModule() Module {
+	return &iercPoWModule{
+		parser: parser.NewIERCPoWParser(protocol.ProtocolHeader),
+	}
+}
+
+func (m *iercPoWModule) Name() string { return string(protocol.ProtocolIERCPoW) }
+
+func (m *iercPoWModule) Protocols() []protocol.Protocol {
+	return []protocol.Protocol{protocol.ProtocolIERCPoW}
+}
+
+func (m *iercPoWModule) Parser() parser.Parser { return m.parser }
+
+func (m *iercPoWModule) CollectDependencies(tx protocol.IERCTransaction, deps *domain.Dependencies) bool {
+	switch t := tx.(type) {
+	case *protocol.DeployPoWCommand:
+		deps.AddTick(t.Tick)
+
+	case *protocol.MintPoWCommand:
+		tickName := t.Tick()
+		deps.AddTick(tickName)
+		deps.AddBalance(t.From, tickName)
+		// pow mint 可能存在销毁, 所以加载零地址的余额
+		deps.AddBalance(protocol.ZeroAddress, tickName)
+
+	case *protocol.ModifyCommand:
+		deps.AddTick(t.Tick)
+
+	case *protocol.ClaimAirdropCommand:
+		deps.AddTick(t.Tick)
+		deps.AddBalance(t.From, t.Tick)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// pow mint 的收益按整个区块的总份额分配, 需要在处理交易之前统计
+func (m *iercPoWModule) Prepare(root *domain.AggregateRoot) {
+	root.PreparePoWMint()
+}
+
+func (m *iercPoWModule) Handle(root *domain.AggregateRoot, tx protocol.IERCTransaction) (bool, error) {
+	var err error
+	switch t := tx.(type) {
+	case *protocol.DeployPoWCommand:
+		err = root.HandleDeployPoW(t)
+
+	case *protocol.MintPoWCommand:
+		err = root.HandleMintPoW(t)
+
+	case *protocol.ModifyCommand:
+		err = root.HandleModify(t)
+
+	case *protocol.ClaimAirdropCommand:
+		err = root.HandleClaimAirdrop(t)
+
+	default:
+		return false, nil
+	}
+
+	return true, err
+}
+
+func (m *iercPoWModule) EventCodecs() []domain.EventCodec {
+	return []domain.EventCodec{
+		domain.NewEventCodec(func(e *domain.IERCPoWTickCreatedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.From,
+				IERCTo:   e.To,
+				Tick:     e.Data.Tick,
+			}
+		}),
+		domain.NewEventCodec(func(e *domain.IERCPoWMintedEvent) domain.EventIndex {
+			return domain.EventIndex{
+				ETHFrom:  e.From,
+				ETHTo:    e.To,
+				IERCFrom: e.Data.From,
+				IERCTo:   e.Data.To,
+				Tick:     e.Data.Tick,
+				Amount:   e.Data.PoSMintedAmount.Add(e.Data.PoWMintedAmount),
+			}
+		}),
+	}
+}
@@ -0,0 +1,92 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/allowance"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol/parser"
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
+)
+
+// 协议模块.
+// 一个协议模块提供: 协议解析器、交易依赖的状态、交易处理逻辑以及事件编解码器.
+// 新增协议时只需要实现并注册一个模块, 解析、预处理、处理和事件持久化都会通过注册表进行分发.
+// 模块需要自己的状态时(例如授权、锁仓), 实现 domain.ModuleState 并在 NewStateFactories 中提供工厂,
+// 区块服务会负责加载和保存.
+type Module interface {
+	domain.ProtocolHandler
+
+	// 模块名称
+	Name() string
+	// 模块支持的协议
+	Protocols() []protocol.Protocol
+	// 协议解析器
+	Parser() parser.Parser
+	// 模块产生的事件
+	EventCodecs() []domain.EventCodec
+}
+
+var (
+	modules   []Module
+	protocols = make(map[protocol.Protocol]Module)
+)
+
+// 注册协议模块. 协议和事件都不允许重复注册
+func Register(m Module) {
+	for _, existed := range modules {
+		if existed.Name() == m.Name() {
+			panic(fmt.Sprintf("module already registered. name: %s", m.Name()))
+		}
+	}
+
+	for _, p := range m.Protocols() {
+		if existed, ok := protocols[p]; ok {
+			panic(fmt.Sprintf("protocol %s already registered by module %s", p, existed.Name()))
+		}
+		protocols[p] = m
+	}
+
+	domain.RegisterEventCodec(m.EventCodecs()...)
+	modules = append(modules, m)
+}
+
+// 获取所有已注册的模块, 按注册顺序返回
+func Modules() []Module {
+	return modules
+}
+
+// 获取所有模块的协议处理器, 按注册顺序返回
+func Handlers() []domain.ProtocolHandler {
+	var handlers = make([]domain.ProtocolHandler, 0, len(modules))
+	for _, m := range modules {
+		handlers = append(handlers, m)
+	}
+	return handlers
+}
+
+// 创建协议模块自有状态的工厂, 由依赖注入提供给区块服务
+func NewStateFactories(allowanceRepo allowance.AllowanceRepository, vestingRepo vesting.VestingRepository) []domain.ModuleStateFactory {
+	return []domain.ModuleStateFactory{
+		&ierc20StateFactory{allowanceRepo: allowanceRepo, vestingRepo: vestingRepo},
+	}
+}
+
+// 创建协议解析器, 根据协议分发给对应模块的解析器
+func NewParser() parser.Parser {
+	var parsers = make(map[protocol.Protocol]parser.Parser, len(protocols))
+	for _, m := range modules {
+		p := m.Parser()
+		for _, name := range m.Protocols() {
+			parsers[name] = p
+		}
+	}
+
+	return parser.NewParser(parsers)
+}
+
+func init() {
+	Register(NewIERC20Module())
+	Register(NewIERCPoWModule())
+}
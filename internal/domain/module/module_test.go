@@ -0,0 +1,356 @@
+package module
+
+import (
+	"context"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/allowance"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestModule(t *testing.T) {
+	suite.Run(t, new(TestModuleSuite))
+}
+
+type TestModuleSuite struct {
+	suite.Suite
+}
+
+func (s *TestModuleSuite) TestRegistered() {
+	s.Len(Modules(), 2)
+	s.Len(Handlers(), 2)
+
+	for _, p := range []protocol.Protocol{protocol.ProtocolTERC20, protocol.ProtocolIERC20, protocol.ProtocolIERCPoW} {
+		_, existed := protocols[p]
+		s.True(existed, p)
+	}
+
+	s.Panics(func() { Register(NewIERC20Module()) })
+}
+
+func (s *TestModuleSuite) TestCollectDependencies() {
+	var (
+		deps     = domain.NewDependencies()
+		mintPoW  = protocol.NewMintPoWCommand(protocol.IERCTransactionBase{From: "0x01"}, "ethpi", decimal.Zero, 0, 0)
+		unfreeze = &protocol.UnfreezeSellCommand{
+			Records: []protocol.UnfreezeRecord{{Sign: "0xsign"}},
+		}
+	)
+
+	s.True(collect(mintPoW, deps))
+	s.True(deps.Balances.Contains(balance.NewBalanceKey(protocol.ZeroAddress, "ethpi")))
+
+	s.True(collect(unfreeze, deps))
+	s.True(deps.Signatures.Contains("0xsign"))
+	s.True(deps.UnfreezeSigns.Contains("0xsign"))
+
+	s.False(collect(&protocol.IERCTransactionBase{}, deps))
+}
+
+func (s *TestModuleSuite) TestEventCodec() {
+	event := &domain.IERC20TransferredEvent{
+		BlockNumber: 100,
+		TxHash:      "0xhash",
+		From:        "0x01",
+		To:          "0x02",
+		Data: &domain.IERC20Transferred{
+			Protocol: protocol.ProtocolIERC20,
+			Operate:  protocol.OpTransfer,
+			Tick:     "ethi",
+			From:     "0x03",
+			To:       "0x04",
+			Amount:   decimal.NewFromInt(10),
+		},
+	}
+
+	data, err := jsoniter.Marshal(event)
+	s.NoError(err)
+
+	decoded := domain.NewEventFromData(uint8(event.GetEventKind()), data)
+	s.IsType(event, decoded)
+	s.Equal(event.TxHash, decoded.GetTxHash())
+
+	index := domain.IndexEvent(decoded)
+	s.Equal("0x03", index.IERCFrom)
+	s.Equal("0x04", index.IERCTo)
+	s.Equal("ethi", index.Tick)
+	s.True(index.Amount.Equal(decimal.NewFromInt(10)))
+}
+
+func (s *TestModuleSuite) TestBurn() {
+	var (
+		burner = "0x0000000000000000000000000000000000000001"
+		txs    = []*domain.Transaction{
+			{Hash: "0x01", From: burner, To: protocol.ZeroAddress, TxData: protocol.ProtocolHeader + `{"p":"ierc-20","op":"burn","tick":"ethi","amt":"40"}`},
+			{Hash: "0x02", From: burner, To: protocol.ZeroAddress, TxData: protocol.ProtocolHeader + `{"p":"ierc-20","op":"burn","tick":"ethi","amt":"100"}`},
+		}
+	)
+
+	for _, tx := range txs {
+		command, err := NewParser().Parse(tx)
+		s.NoError(err)
+		s.NoError(command.Validate())
+		tx.IERCTransaction = command
+	}
+
+	root := domain.NewBlockAggregate(0, &domain.Block{Number: 10, Transactions: txs}, nil, 0, Handlers()...)
+	root.TicksMap["ethi"] = &tick.IERC20Tick{Tick: "ethi", Supply: decimal.NewFromInt(1000)}
+	root.BalancesMap[balance.NewBalanceKey(burner, "ethi")] = &balance.Balance{
+		Address:   burner,
+		Tick:      "ethi",
+		Available: decimal.NewFromInt(100),
+	}
+	root.Handle()
+
+	// 第二笔余额不足, 销毁失败
+	s.Len(root.Events, 2)
+	s.EqualValues(0, root.Events[0].GetErrCode())
+	s.EqualValues(protocol.InsufficientAvailableFunds, root.Events[1].GetErrCode())
+	s.EqualValues(protocol.InsufficientAvailableFunds, txs[1].Code)
+
+	tickEntity := root.TicksMap["ethi"].(*tick.IERC20Tick)
+	s.True(tickEntity.BurnedAmount.Equal(decimal.NewFromInt(40)))
+	s.True(tickEntity.CirculatingSupply().Equal(decimal.NewFromInt(960)))
+	s.True(root.BalancesMap[balance.NewBalanceKey(burner, "ethi")].Available.Equal(decimal.NewFromInt(60)))
+
+	index := domain.IndexEvent(root.Events[0])
+	s.Equal(burner, index.IERCFrom)
+	s.Equal(protocol.ZeroAddress, index.IERCTo)
+}
+
+func (s *TestModuleSuite) TestApproveAndTransferFrom() {
+	var (
+		owner   = "0x0000000000000000000000000000000000000001"
+		spender = "0x0000000000000000000000000000000000000002"
+		recv    = "0x0000000000000000000000000000000000000003"
+		txs     = []*domain.Transaction{
+			{Hash: "0x01", From: owner, To: protocol.ZeroAddress, TxData: protocol.ProtocolHeader + `{"p":"ierc-20","op":"approve","approve":[{"tick":"ethi","spender":"` + spender + `","amt":"50"}]}`},
+			{Hash: "0x02", From: spender, To: protocol.ZeroAddress, TxData: protocol.ProtocolHeader + `{"p":"ierc-20","op":"transfer_from","from":[{"tick":"ethi","from":"` + owner + `","recv":"` + recv + `","amt":"30"},{"tick":"ethi","from":"` + owner + `","recv":"` + recv + `","amt":"30"}]}`},
+		}
+	)
+
+	for _, tx := range txs {
+		command, err := NewParser().Parse(tx)
+		s.NoError(err)
+		s.NoError(command.Validate())
+		tx.IERCTransaction = command
+	}
+
+	state := newIERC20State(nil, nil)
+	s.True(collect(txs[1].IERCTransaction, domain.NewDependencies(state)))
+	s.True(state.allowanceKeys.Contains(allowance.NewAllowanceKey(owner, spender, "ethi")))
+
+	root := domain.NewBlockAggregate(0, &domain.Block{Number: 10, Transactions: txs}, nil, 0, Handlers()...)
+	root.States = domain.ModuleStates{state}
+	root.TicksMap["ethi"] = &tick.IERC20Tick{Tick: "ethi", Supply: decimal.NewFromInt(1000)}
+	root.BalancesMap[balance.NewBalanceKey(owner, "ethi")] = &balance.Balance{
+		Address:   owner,
+		Tick:      "ethi",
+		Available: decimal.NewFromInt(100),
+	}
+	root.Handle()
+
+	// 第二条划转超过剩余授权额度, 划转失败
+	s.Len(root.Events, 3)
+	s.EqualValues(0, root.Events[0].GetErrCode())
+	s.EqualValues(0, root.Events[1].GetErrCode())
+	s.EqualValues(protocol.InsufficientAllowance, root.Events[2].GetErrCode())
+
+	entity := state.allowances[allowance.NewAllowanceKey(owner, spender, "ethi")]
+	s.True(entity.Amount.Equal(decimal.NewFromInt(20)))
+	s.EqualValues(10, entity.LastUpdatedBlock)
+	s.True(root.BalancesMap[balance.NewBalanceKey(owner, "ethi")].Available.Equal(decimal.NewFromInt(70)))
+	s.True(root.BalancesMap[balance.NewBalanceKey(recv, "ethi")].Available.Equal(decimal.NewFromInt(30)))
+
+	index := domain.IndexEvent(root.Events[0])
+	s.Equal(owner, index.IERCFrom)
+	s.Equal(spender, index.IERCTo)
+}
+
+func (s *TestModuleSuite) TestVestAndClaim() {
+	var (
+		team   = "0x0000000000000000000000000000000000000001"
+		member = "0x0000000000000000000000000000000000000002"
+		vestTx = &domain.Transaction{Hash: "0x01", From: team, To: protocol.ZeroAddress, TxData: protocol.ProtocolHeader + `{"p":"ierc-20","op":"vest","tick":"ethi","vest":[{"recv":"` + member + `","amt":"100","cliff":"12","end":"20"}]}`}
+		claim  = protocol.ProtocolHeader + `{"p":"ierc-20","op":"claim_vested","tick":"ethi"}`
+	)
+
+	parse := func(tx *domain.Transaction) {
+		command, err := NewParser().Parse(tx)
+		s.NoError(err)
+		s.NoError(command.Validate())
+		tx.IERCTransaction = command
+	}
+
+	// 第10个区块锁仓, 同一个区块领取时还未释放
+	claimTx := &domain.Transaction{Hash: "0x02", From: member, To: protocol.ZeroAddress, TxData: claim}
+	parse(vestTx)
+	parse(claimTx)
+
+	state := newIERC20State(nil, nil)
+	root := domain.NewBlockAggregate(0, &domain.Block{Number: 10, Transactions: []*domain.Transaction{vestTx, claimTx}}, nil, 0, Handlers()...)
+	root.States = domain.ModuleStates{state}
+	root.TicksMap["ethi"] = &tick.IERC20Tick{Tick: "ethi", Supply: decimal.NewFromInt(1000)}
+	root.BalancesMap[balance.NewBalanceKey(team, "ethi")] = &balance.Balance{
+		Address:   team,
+		Tick:      "ethi",
+		Available: decimal.NewFromInt(100),
+	}
+	root.Handle()
+
+	s.Len(root.Events, 2)
+	s.EqualValues(0, root.Events[0].GetErrCode())
+	s.EqualValues(protocol.VestingNothingToClaim, root.Events[1].GetErrCode())
+	s.True(root.BalancesMap[balance.NewBalanceKey(team, "ethi")].Available.IsZero())
+
+	key := vesting.NewVestingKey(member, "ethi")
+	s.Len(state.vestings[key], 1)
+	s.Equal("0x01-0", state.vestings[key][0].VestID)
+	s.EqualValues(10, state.vestings[key][0].StartBlock)
+
+	// 第15个区块领取一半
+	claimTx = &domain.Transaction{Hash: "0x03", From: member, To: protocol.ZeroAddress, TxData: claim}
+	parse(claimTx)
+
+	next := domain.NewBlockAggregate(10, &domain.Block{Number: 15, Transactions: []*domain.Transaction{claimTx}}, nil, 0, Handlers()...)
+	nextState := newIERC20State(nil, nil)
+	nextState.vestings[key] = state.vestings[key]
+	next.TicksMap = root.TicksMap
+	next.States = domain.ModuleStates{nextState}
+	next.Handle()
+
+	s.Len(next.Events, 1)
+	s.EqualValues(0, next.Events[0].GetErrCode())
+	s.True(next.BalancesMap[balance.NewBalanceKey(member, "ethi")].Available.Equal(decimal.NewFromInt(50)))
+	s.True(nextState.vestings[key][0].Released.Equal(decimal.NewFromInt(50)))
+}
+
+// pow tick 的锁仓复用 ierc-20 的命令. 未指定开始区块时, 锁定期不能早于当前区块
+func (s *TestModuleSuite) TestVestPoWTick() {
+	var (
+		team   = "0x0000000000000000000000000000000000000001"
+		member = "0x0000000000000000000000000000000000000002"
+		vest   = func(hash, schedule string) *domain.Transaction {
+			tx := &domain.Transaction{Hash: hash, From: team, To: protocol.ZeroAddress, TxData: protocol.ProtocolHeader + `{"p":"ierc-20","op":"vest","tick":"ethpi","vest":[{"recv":"` + member + `","amt":"100",` + schedule + `}]}`}
+			command, err := NewParser().Parse(tx)
+			s.NoError(err)
+			s.NoError(command.Validate())
+			tx.IERCTransaction = command
+			return tx
+		}
+		txs = []*domain.Transaction{
+			vest("0x01", `"cliff":"5","end":"20"`),
+			vest("0x02", `"start":"8","end":"20"`),
+			vest("0x03", `"cliff":"12","end":"20"`),
+		}
+	)
+
+	state := newIERC20State(nil, nil)
+	root := domain.NewBlockAggregate(0, &domain.Block{Number: 10, Transactions: txs}, nil, 0, Handlers()...)
+	root.States = domain.ModuleStates{state}
+	root.TicksMap["ethpi"] = &tick.IERCPoWTick{Tick: "ethpi", Protocol: protocol.ProtocolIERCPoW, Decimals: 18, MaxSupply: decimal.NewFromInt(1000)}
+	root.BalancesMap[balance.NewBalanceKey(team, "ethpi")] = &balance.Balance{
+		Address:   team,
+		Tick:      "ethpi",
+		Available: decimal.NewFromInt(100),
+	}
+	root.Handle()
+
+	s.Len(root.Events, 3)
+	s.EqualValues(protocol.InvalidVestingSchedule, root.Events[0].GetErrCode())
+	s.EqualValues(protocol.InvalidVestingSchedule, root.Events[1].GetErrCode())
+	s.EqualValues(0, root.Events[2].GetErrCode())
+	s.True(root.BalancesMap[balance.NewBalanceKey(team, "ethpi")].Available.IsZero())
+
+	key := vesting.NewVestingKey(member, "ethpi")
+	s.Require().Len(state.vestings[key], 1)
+	s.Equal("0x03-0", state.vestings[key][0].VestID)
+	s.EqualValues(10, state.vestings[key][0].StartBlock)
+	s.EqualValues(12, state.vestings[key][0].CliffBlock)
+}
+
+// 模块状态只加载登记过的数据, 只保存当前区块变更过的数据
+func (s *TestModuleSuite) TestStateLoadAndSave() {
+	var (
+		owner      = "0x0000000000000000000000000000000000000001"
+		spender    = "0x0000000000000000000000000000000000000002"
+		allowances = &memoryAllowanceRepo{entities: map[allowance.AllowanceKey]*allowance.Allowance{}}
+		vestings   = &memoryVestingRepo{}
+	)
+
+	stored := allowance.NewAllowance(owner, spender, "ethi")
+	stored.Amount = decimal.NewFromInt(10)
+	stored.LastUpdatedBlock = 5
+	allowances.entities[stored.Key()] = stored
+
+	factories := NewStateFactories(allowances, vestings)
+	s.Require().Len(factories, 1)
+
+	states := domain.ModuleStates{factories[0].NewState()}
+	s.Nil(states.Get("unknown"))
+
+	approve := &protocol.ApproveCommand{Records: []protocol.ApproveRecord{
+		{Tick: "ethi", Owner: owner, Spender: spender},
+		{Tick: "ethi", Owner: owner, Spender: "0x0000000000000000000000000000000000000003"},
+	}}
+	s.True(collect(approve, domain.NewDependencies(states...)))
+	s.NoError(states[0].Load(context.Background()))
+
+	state := states.Get(string(protocol.ProtocolIERC20)).(*ierc20State)
+	s.Len(state.allowances, 1)
+	s.Same(stored, state.allowances[stored.Key()])
+
+	// 未变更的授权不保存
+	s.NoError(states[0].Save(context.Background(), 10))
+	s.Empty(allowances.saved)
+
+	state.getOrCreateAllowance(owner, spender, "ethi").Approve(10, decimal.NewFromInt(20))
+	s.NoError(states[0].Save(context.Background(), 10))
+	s.Equal([]*allowance.Allowance{stored}, allowances.saved)
+	s.Empty(vestings.saved)
+}
+
+func collect(tx protocol.IERCTransaction, deps *domain.Dependencies) bool {
+	for _, handler := range Handlers() {
+		if handler.CollectDependencies(tx, deps) {
+			return true
+		}
+	}
+	return false
+}
+
+type memoryAllowanceRepo struct {
+	allowance.AllowanceRepository
+	entities map[allowance.AllowanceKey]*allowance.Allowance
+	saved    []*allowance.Allowance
+}
+
+func (r *memoryAllowanceRepo) Load(_ context.Context, key allowance.AllowanceKey) (*allowance.Allowance, error) {
+	return r.entities[key], nil
+}
+
+func (r *memoryAllowanceRepo) Save(_ context.Context, entities ...*allowance.Allowance) error {
+	r.saved = append(r.saved, entities...)
+	return nil
+}
+
+type memoryVestingRepo struct {
+	vesting.VestingRepository
+	saved []*vesting.Vesting
+}
+
+func (r *memoryVestingRepo) Load(_ context.Context, _ vesting.VestingKey) ([]*vesting.Vesting, error) {
+	return nil, nil
+}
+
+func (r *memoryVestingRepo) Save(_ context.Context, entities ...*vesting.Vesting) error {
+	r.saved = append(r.saved, entities...)
+	return nil
+}
@@ -0,0 +1,127 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 价格的精度
+const pricePrecision = 18
+
+var ErrOrderClosed = errors.New("order closed")
+
+type Status string
+
+const (
+	StatusListed          Status = "listed"           // 已挂单. freeze_sell 成功
+	StatusPartiallyFilled Status = "partially_filled" // 部分成交. proxy_transfer 数量小于挂单数量, 签名已使用, 剩余部分仍然冻结
+	StatusSold            Status = "sold"             // 全部成交
+	StatusCancelled       Status = "cancelled"        // 已撤单. unfreeze_sell 成功
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusListed, StatusPartiallyFilled, StatusSold, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// 生成订单ID. 由挂单交易hash和交易中的位置组成
+func NewOrderID(txHash string, position int) string {
+	return fmt.Sprintf("%s-%d", txHash, position)
+}
+
+// 市场订单. 由 freeze_sell 创建, 由签名对应的 unfreeze_sell 或 proxy_transfer 关闭.
+// 同一个签名撤单后可以再次挂单, 每次挂单都是一个新的订单
+type Order struct {
+	ID               int64
+	OrderID          string          // 订单ID
+	Sign             string          // 卖家签名
+	SignNonce        string          // 签名 nonce
+	Tick             string          // tick
+	Seller           string          // 卖家
+	Buyer            string          // 买家, 成交后填充
+	Amount           decimal.Decimal // 挂单数量
+	Value            decimal.Decimal // 挂单总价, 单位 ETH
+	Price            decimal.Decimal // 单价. value / amount
+	FilledAmount     decimal.Decimal // 成交数量
+	FilledValue      decimal.Decimal // 成交总价, 单位 ETH
+	Status           Status          // 订单状态
+	ListTxHash       string          // 挂单交易
+	ListBlock        uint64          // 挂单区块
+	CloseTxHash      string          // 成交或撤单的交易
+	CloseBlock       uint64          // 成交或撤单的区块
+	LastUpdatedBlock uint64          //
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func NewOrder(orderID, sign, signNonce, tick, seller string, amount, value decimal.Decimal, txHash string, blockNumber uint64) *Order {
+	price := decimal.Zero
+	if amount.GreaterThan(decimal.Zero) {
+		price = value.DivRound(amount, pricePrecision)
+	}
+
+	return &Order{
+		OrderID:          orderID,
+		Sign:             sign,
+		SignNonce:        signNonce,
+		Tick:             tick,
+		Seller:           seller,
+		Amount:           amount,
+		Value:            value,
+		Price:            price,
+		FilledAmount:     decimal.Zero,
+		FilledValue:      decimal.Zero,
+		Status:           StatusListed,
+		ListTxHash:       txHash,
+		ListBlock:        blockNumber,
+		LastUpdatedBlock: blockNumber,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+}
+
+func (o *Order) IsClosed() bool {
+	return o.Status != StatusListed
+}
+
+// 成交. 一个签名只能成交一次, 成交数量小于挂单数量时为部分成交
+func (o *Order) Fill(blockNumber uint64, txHash, buyer string, amount, value decimal.Decimal) error {
+	if o.IsClosed() {
+		return ErrOrderClosed
+	}
+
+	o.Buyer = buyer
+	o.FilledAmount = amount
+	o.FilledValue = value
+	o.Status = StatusSold
+	if amount.LessThan(o.Amount) {
+		o.Status = StatusPartiallyFilled
+	}
+	o.close(blockNumber, txHash)
+	return nil
+}
+
+// 撤单
+func (o *Order) Cancel(blockNumber uint64, txHash string) error {
+	if o.IsClosed() {
+		return ErrOrderClosed
+	}
+
+	o.Status = StatusCancelled
+	o.close(blockNumber, txHash)
+	return nil
+}
+
+func (o *Order) close(blockNumber uint64, txHash string) {
+	o.CloseTxHash = txHash
+	o.CloseBlock = blockNumber
+	o.LastUpdatedBlock = blockNumber
+	o.UpdatedAt = time.Now()
+}
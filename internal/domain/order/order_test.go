@@ -0,0 +1,66 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestOrder(t *testing.T) {
+	suite.Run(t, new(TestOrderSuite))
+}
+
+type TestOrderSuite struct {
+	suite.Suite
+}
+
+func (s *TestOrderSuite) newOrder() *Order {
+	return NewOrder(NewOrderID("0xaa", 0), "0xsign", "1", "ethi", "0xseller",
+		decimal.NewFromInt(1000), decimal.RequireFromString("0.5"), "0xaa", 100)
+}
+
+func (s *TestOrderSuite) TestNewOrder() {
+	o := s.newOrder()
+
+	s.Equal("0xaa-0", o.OrderID)
+	s.Equal(StatusListed, o.Status)
+	s.False(o.IsClosed())
+	s.True(o.Price.Equal(decimal.RequireFromString("0.0005")))
+	s.Equal(uint64(100), o.LastUpdatedBlock)
+}
+
+func (s *TestOrderSuite) TestFill() {
+	o := s.newOrder()
+
+	s.NoError(o.Fill(101, "0xbb", "0xbuyer", decimal.NewFromInt(1000), decimal.RequireFromString("0.5")))
+	s.Equal(StatusSold, o.Status)
+	s.Equal("0xbuyer", o.Buyer)
+	s.Equal("0xbb", o.CloseTxHash)
+	s.Equal(uint64(101), o.CloseBlock)
+	s.Equal(uint64(101), o.LastUpdatedBlock)
+	s.ErrorIs(o.Fill(102, "0xcc", "0xbuyer", decimal.NewFromInt(1), decimal.Zero), ErrOrderClosed)
+}
+
+func (s *TestOrderSuite) TestPartiallyFill() {
+	o := s.newOrder()
+
+	s.NoError(o.Fill(101, "0xbb", "0xbuyer", decimal.NewFromInt(400), decimal.RequireFromString("0.2")))
+	s.Equal(StatusPartiallyFilled, o.Status)
+	s.True(o.FilledAmount.Equal(decimal.NewFromInt(400)))
+	s.True(o.IsClosed())
+}
+
+func (s *TestOrderSuite) TestCancel() {
+	o := s.newOrder()
+
+	s.NoError(o.Cancel(101, "0xbb"))
+	s.Equal(StatusCancelled, o.Status)
+	s.ErrorIs(o.Cancel(102, "0xcc"), ErrOrderClosed)
+	s.ErrorIs(o.Fill(102, "0xcc", "0xbuyer", decimal.NewFromInt(1), decimal.Zero), ErrOrderClosed)
+}
+
+func (s *TestOrderSuite) TestStatus() {
+	s.True(StatusSold.IsValid())
+	s.False(Status("unknown").IsValid())
+}
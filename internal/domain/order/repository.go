@@ -0,0 +1,25 @@
+package order
+
+import (
+	"context"
+)
+
+// 订单查询条件. 为空的条件不参与过滤
+type OrderQuery struct {
+	Tick   string
+	Seller string
+	Buyer  string
+	Status Status
+	Cursor int64 // 上一页最后一条记录的ID, 0 表示第一页
+	Limit  int
+}
+
+type OrderRepository interface {
+	Save(ctx context.Context, orders ...*Order) error
+	// 根据订单ID查询, 不存在时返回 nil
+	Get(ctx context.Context, orderID string) (*Order, error)
+	// 查询签名最近的一个订单, 不存在时返回 nil
+	GetBySign(ctx context.Context, sign string) (*Order, error)
+	// 按挂单倒序查询
+	Query(ctx context.Context, query *OrderQuery) ([]*Order, error)
+}
@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// 其他实例的中继正在发布消息
+var ErrRelayLocked = errors.New("outbox relay locked by another instance")
+
+// 区块事件的主题. 消息体为序列化后的区块事件集合
+const TopicEvents = "indexer.events"
+
+// 发件箱消息. 与区块数据在同一个事务中写入, 由中继发布到消息队列
+type Message struct {
+	ID          int64 // 自增ID, 按写入顺序递增. 发布失败时整批重试, 接收方可以用于去重
+	Topic       string
+	BlockNumber uint64
+	Payload     []byte
+	CreatedAt   time.Time
+	PublishedAt time.Time // 发布成功的时间, 未发布时为零值
+}
+
+type OutboxRepository interface {
+	// 保存消息. 只在区块的持久化事务中调用, 同一个主题的区块重复处理时忽略
+	Save(ctx context.Context, messages ...*Message) error
+	// 按ID升序加载未发布的消息
+	FetchPending(ctx context.Context, limit int) ([]*Message, error)
+	MarkPublished(ctx context.Context, ids []int64, now time.Time) error
+	// 删除 before 之前已经发布的消息, 返回删除的数量
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	// 持有中继锁执行 fn, 保证多个实例同时运行时只有一个中继在发布消息.
+	// 锁被其他实例持有时不等待, 直接返回 ErrRelayLocked
+	WithRelayLock(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// 消息发布方. 接入 Kafka、NATS、Redis Streams 等消息队列时实现该接口
+type Publisher interface {
+	// 发布方名称, 用于日志
+	Name() string
+	// Publish 按顺序发布一批消息. 只有返回 nil 时消息才会被标记为已发布,
+	// 返回错误时整批重试, 已经发布的部分会重复发布
+	Publish(ctx context.Context, messages []*Message) error
+}
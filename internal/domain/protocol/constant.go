@@ -18,6 +18,7 @@ const (
 
 	SignatureTitle            = "ierc-20 one approve"
 	CreateOrderSignatureTitle = "ierc-20 seller approve"
+	ApproveSignatureTitle     = "ierc-20 allowance approve"
 
 	// tick 名称长度最大限制
 	TickMaxLength = 64
@@ -64,6 +65,11 @@ const (
 	OpStaking        = "stake"
 	OpUnStaking      = "unstake"
 	OpProxyUnStaking = "proxy_unstake"
+	OpBurn           = "burn"
+	OpApprove        = "approve"
+	OpTransferFrom   = "transfer_from"
+	OpVest           = "vest"
+	OpClaimVested    = "claim_vested"
 
 	OpPoWModify       = "modify"
 	OpPoWClaimAirdrop = "airdrop_claim"
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/shopspring/decimal"
 )
@@ -30,6 +31,9 @@ type (
 		Freeze   []IERC20Freeze        `json:"freeze"`
 		Unfreeze []IERC20Unfreeze      `json:"unfreeze"`
 		Proxy    []IERC20ProxyTransfer `json:"proxy"`
+		Approve  []IERC20Approve       `json:"approve"`
+		From     []IERC20TransferFrom  `json:"from"`
+		Vest     []IERC20Vest          `json:"vest"`
 	}
 
 	IERC20Transfer struct {
@@ -37,6 +41,33 @@ type (
 		Amt  interface{} `json:"amt"`
 	}
 
+	// 签名授权时 owner 为签名者, 链上授权时忽略 owner, 以交易发起人为准
+	IERC20Approve struct {
+		Tick    string      `json:"tick"`
+		Owner   string      `json:"owner"`
+		Spender string      `json:"spender"`
+		Amt     interface{} `json:"amt"`
+		Sign    string      `json:"sign"`
+		Nonce   string      `json:"nonce"`
+		Expire  string      `json:"expire"`
+	}
+
+	IERC20TransferFrom struct {
+		Tick string      `json:"tick"`
+		From string      `json:"from"`
+		Recv string      `json:"recv"`
+		Amt  interface{} `json:"amt"`
+	}
+
+	// 区块号均为字符串
+	IERC20Vest struct {
+		Recv  string      `json:"recv"`
+		Amt   interface{} `json:"amt"`
+		Start Uint64      `json:"start"`
+		Cliff Uint64      `json:"cliff"`
+		End   Uint64      `json:"end"`
+	}
+
 	IERC20Freeze struct {
 		Tick     string                         `json:"tick"`
 		Platform string                         `json:"platform"`
@@ -137,8 +168,8 @@ func (parser *IERC20Parser) Parse(tx *domain.Transaction) (protocol.IERCTransact
 		TxHash:             tx.Hash,
 		TxValue:            tx.TxValue,
 		PositionInBlockTxs: tx.PositionInTxs,
-		From:               strings.ToLower(tx.From),
-		To:                 strings.ToLower(tx.To),
+		From:               address.Canonical(tx.From),
+		To:                 address.Canonical(tx.To),
 		Gas:                tx.Gas,
 		GasPrice:           tx.GasPrice,
 		EventAt:            tx.CreatedAt,
@@ -160,6 +191,21 @@ func (parser *IERC20Parser) Parse(tx *domain.Transaction) (protocol.IERCTransact
 	case protocol.OpTransfer:
 		return parser.parseTransfer(base, &ierc20)
 
+	case protocol.OpBurn:
+		return parser.parseBurn(base, &ierc20)
+
+	case protocol.OpApprove:
+		return parser.parseApprove(base, &ierc20)
+
+	case protocol.OpTransferFrom:
+		return parser.parseTransferFrom(base, &ierc20)
+
+	case protocol.OpVest:
+		return parser.parseVest(base, &ierc20)
+
+	case protocol.OpClaimVested:
+		return &protocol.ClaimVestedCommand{IERCTransactionBase: base, Tick: strings.TrimSpace(ierc20.Tick)}, nil
+
 	case protocol.OpFreezeSell:
 		if signVersion == 3 {
 			return parser.parseFreezeSell(base, &ierc20)
@@ -339,7 +385,7 @@ func (parser *IERC20Parser) parseTransfer(base protocol.IERCTransactionBase, ier
 			Operate:  base.Operate,
 			Tick:     tick,
 			From:     base.From,
-			Recv:     strings.ToLower(strings.TrimSpace(to.Recv)),
+			Recv:     address.Canonical(to.Recv),
 			Amount:   amount,
 		})
 	}
@@ -347,6 +393,113 @@ func (parser *IERC20Parser) parseTransfer(base protocol.IERCTransactionBase, ier
 	return &protocol.TransferCommand{IERCTransactionBase: base, Records: records}, nil
 }
 
+func (parser *IERC20Parser) parseBurn(base protocol.IERCTransactionBase, ierc20 *IERC20) (*protocol.BurnCommand, error) {
+	amount, err := decimal.NewFromString(strings.TrimSpace(ierc20.Amt))
+	if err != nil {
+		return nil, protocol.NewProtocolError(protocol.InvalidProtocolParams, "invalid amount")
+	}
+
+	return &protocol.BurnCommand{
+		IERCTransactionBase: base,
+		Tick:                strings.TrimSpace(ierc20.Tick),
+		Amount:              amount,
+	}, nil
+}
+
+func (parser *IERC20Parser) parseApprove(base protocol.IERCTransactionBase, ierc20 *IERC20) (*protocol.ApproveCommand, error) {
+	var records = make([]protocol.ApproveRecord, 0, len(ierc20.Approve))
+	for _, approve := range ierc20.Approve {
+
+		rawAmount := strings.TrimSpace(fmt.Sprintf("%v", approve.Amt))
+		amount, err := decimal.NewFromString(rawAmount)
+		if err != nil {
+			return nil, protocol.NewProtocolError(protocol.InvalidProtocolParams, "invalid amount")
+		}
+
+		record := protocol.ApproveRecord{
+			Protocol:  base.Protocol,
+			Operate:   base.Operate,
+			Tick:      strings.TrimSpace(approve.Tick),
+			Owner:     base.From,
+			Spender:   address.Canonical(approve.Spender),
+			Amount:    amount,
+			RawAmount: rawAmount,
+			Sign:      strings.TrimSpace(approve.Sign),
+		}
+
+		// 签名授权: 资产所有者为签名者, nonce 与过期区块必填
+		if record.IsSigned() {
+			nonce, err := parser.parseNonce(record.Tick, approve.Nonce)
+			if err != nil {
+				return nil, err
+			}
+
+			expire, err := strconv.ParseUint(strings.TrimSpace(approve.Expire), 10, 64)
+			if err != nil {
+				return nil, protocol.NewProtocolError(protocol.InvalidProtocolParams, fmt.Sprintf("invalid expire(%s)", approve.Expire))
+			}
+
+			record.Owner = address.Canonical(approve.Owner)
+			record.SignNonce = nonce.String()
+			record.Expire = expire
+		}
+
+		records = append(records, record)
+	}
+
+	return &protocol.ApproveCommand{IERCTransactionBase: base, Records: records}, nil
+}
+
+func (parser *IERC20Parser) parseTransferFrom(base protocol.IERCTransactionBase, ierc20 *IERC20) (*protocol.TransferFromCommand, error) {
+	var records = make([]protocol.TransferFromRecord, 0, len(ierc20.From))
+	for _, item := range ierc20.From {
+
+		amount, err := decimal.NewFromString(strings.TrimSpace(fmt.Sprintf("%v", item.Amt)))
+		if err != nil {
+			return nil, protocol.NewProtocolError(protocol.InvalidProtocolParams, "invalid amount")
+		}
+
+		records = append(records, protocol.TransferFromRecord{
+			Protocol: base.Protocol,
+			Operate:  base.Operate,
+			Tick:     strings.TrimSpace(item.Tick),
+			From:     address.Canonical(item.From),
+			Spender:  base.From,
+			Recv:     address.Canonical(item.Recv),
+			Amount:   amount,
+		})
+	}
+
+	return &protocol.TransferFromCommand{IERCTransactionBase: base, Records: records}, nil
+}
+
+func (parser *IERC20Parser) parseVest(base protocol.IERCTransactionBase, ierc20 *IERC20) (*protocol.VestCommand, error) {
+	var tick = strings.TrimSpace(ierc20.Tick)
+
+	var records = make([]protocol.VestRecord, 0, len(ierc20.Vest))
+	for _, vest := range ierc20.Vest {
+
+		amount, err := decimal.NewFromString(strings.TrimSpace(fmt.Sprintf("%v", vest.Amt)))
+		if err != nil {
+			return nil, protocol.NewProtocolError(protocol.InvalidProtocolParams, "invalid amount")
+		}
+
+		records = append(records, protocol.VestRecord{
+			Protocol:   base.Protocol,
+			Operate:    base.Operate,
+			Tick:       tick,
+			From:       base.From,
+			Recv:       address.Canonical(vest.Recv),
+			Amount:     amount,
+			StartBlock: uint64(vest.Start),
+			CliffBlock: uint64(vest.Cliff),
+			EndBlock:   uint64(vest.End),
+		})
+	}
+
+	return &protocol.VestCommand{IERCTransactionBase: base, Records: records}, nil
+}
+
 func (parser *IERC20Parser) parseFreezeSell(base protocol.IERCTransactionBase, ierc20 *IERC20) (*protocol.FreezeSellCommand, error) {
 	var tick = strings.TrimSpace(ierc20.Tick)
 
@@ -373,8 +526,8 @@ func (parser *IERC20Parser) parseFreezeSell(base protocol.IERCTransactionBase, i
 			Protocol:   base.Protocol,
 			Operate:    base.Operate,
 			Tick:       tick,
-			Platform:   strings.ToLower(strings.TrimSpace(freeze.Platform)),
-			Seller:     strings.ToLower(strings.TrimSpace(freeze.Seller)),
+			Platform:   address.Canonical(freeze.Platform),
+			Seller:     address.Canonical(freeze.Seller),
 			SellerSign: strings.TrimSpace(freeze.Sign),
 			SignNonce:  nonce.String(),
 			Amount:     amount,
@@ -411,8 +564,8 @@ func (parser *IERC20Parser) parseFreezeSellV4(base protocol.IERCTransactionBase,
 			Protocol:   base.Protocol,
 			Operate:    base.Operate,
 			Tick:       tick,
-			Platform:   strings.ToLower(strings.TrimSpace(freeze.Platform)),
-			Seller:     strings.ToLower(strings.TrimSpace(freeze.Seller)),
+			Platform:   address.Canonical(freeze.Platform),
+			Seller:     address.Canonical(freeze.Seller),
 			SellerSign: strings.TrimSpace(freeze.Sign),
 			SignNonce:  nonce.String(),
 			Amount:     amount,
@@ -467,8 +620,8 @@ func (parser *IERC20Parser) parseProxyTransfer(base protocol.IERCTransactionBase
 			Protocol:    base.Protocol,
 			Operate:     base.Operate,
 			Tick:        tick,
-			From:        strings.ToLower(strings.TrimSpace(proxy.From)),
-			To:          strings.ToLower(strings.TrimSpace(proxy.To)),
+			From:        address.Canonical(proxy.From),
+			To:          address.Canonical(proxy.To),
 			Amount:      amount,
 			Value:       ethValue,
 			Sign:        strings.TrimSpace(proxy.Sign),
@@ -504,8 +657,8 @@ func (parser *IERC20Parser) parseProxyTransferV4(base protocol.IERCTransactionBa
 			Protocol:    base.Protocol,
 			Operate:     base.Operate,
 			Tick:        tick,
-			From:        strings.ToLower(strings.TrimSpace(proxy.From)),
-			To:          strings.ToLower(strings.TrimSpace(proxy.To)),
+			From:        address.Canonical(proxy.From),
+			To:          address.Canonical(proxy.To),
 			Amount:      amount,
 			Value:       ethValue,
 			Sign:        strings.TrimSpace(proxy.Sign),
@@ -536,10 +689,10 @@ func (parser *IERC20Parser) parseConfigStaking(base protocol.IERCTransactionBase
 
 	return &protocol.ConfigStakeCommand{
 		IERCTransactionBase: base,
-		Pool:                strings.ToLower(e.Pool),
+		Pool:                address.Canonical(e.Pool),
 		PoolSubID:           uint64(e.PoolSubID),
 		Owner:               base.From,
-		Admins:              []string{strings.ToLower(e.Owner)},
+		Admins:              []string{address.Canonical(e.Owner)},
 		Name:                e.Name,
 		StopBlock:           uint64(e.StopBlock),
 		Details:             details,
@@ -553,7 +706,7 @@ func (parser *IERC20Parser) parseStakingOrUnStaking(base protocol.IERCTransactio
 	}
 
 	var (
-		pool      = strings.ToLower(e.Pool)
+		pool      = address.Canonical(e.Pool)
 		poolSubID = uint64(e.PoolSubID)
 	)
 
@@ -585,7 +738,7 @@ func (parser *IERC20Parser) parseProxyUnStaking(base protocol.IERCTransactionBas
 	}
 
 	var (
-		pool      = strings.ToLower(e.Pool)
+		pool      = address.Canonical(e.Pool)
 		poolSubID = uint64(e.PoolSubID)
 	)
 
@@ -594,7 +747,7 @@ func (parser *IERC20Parser) parseProxyUnStaking(base protocol.IERCTransactionBas
 		records = append(records, &protocol.StakingDetail{
 			Protocol:  base.Protocol,
 			Operate:   base.Operate,
-			Staker:    strings.ToLower(item.Staker), // 要解除质押的人
+			Staker:    address.Canonical(item.Staker), // 要解除质押的人
 			Pool:      pool,
 			PoolSubID: poolSubID,
 			Tick:      item.Tick,
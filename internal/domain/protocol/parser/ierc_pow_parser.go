@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/shopspring/decimal"
 )
@@ -108,7 +109,7 @@ type IERCPoWParser struct {
 	supportedAirDropTicks map[string]struct{} //
 }
 
-func newIERC20PoWParser(header string) Parser {
+func NewIERCPoWParser(header string) Parser {
 	p := &IERCPoWParser{
 		headerLength:          len(header),
 		supportedAirDropTicks: make(map[string]struct{}),
@@ -136,8 +137,8 @@ func (parser *IERCPoWParser) Parse(tx *domain.Transaction) (protocol.IERCTransac
 		TxHash:             tx.Hash,
 		TxValue:            tx.TxValue,
 		PositionInBlockTxs: tx.PositionInTxs,
-		From:               strings.ToLower(tx.From),
-		To:                 strings.ToLower(tx.To),
+		From:               address.Canonical(tx.From),
+		To:                 address.Canonical(tx.To),
 		Gas:                tx.Gas,
 		GasPrice:           tx.GasPrice,
 		EventAt:            tx.CreatedAt,
@@ -223,7 +224,7 @@ func (parser *IERCPoWParser) parseDeploy(base protocol.IERCTransactionBase, data
 			MinWorkC:          e.Rule.MinWorkc,
 			DifficultyRatio:   e.Rule.DifficultyRatio,
 			PosRatio:          e.Rule.Pos,
-			PosPool:           e.Rule.Pool,
+			PosPool:           address.Canonical(e.Rule.Pool),
 			MaxRewardBlockNum: maxBlockNum,
 		},
 	}, nil
@@ -278,7 +279,7 @@ func (parser *IERCPoWParser) parseTransfer(base protocol.IERCTransactionBase, da
 			Operate:  base.Operate,
 			Tick:     e.Tick,
 			From:     base.From,
-			Recv:     record.Recv,
+			Recv:     address.Canonical(record.Recv),
 			Amount:   record.Amount,
 		})
 	}
@@ -298,8 +299,8 @@ func (parser *IERCPoWParser) parseFreezeSell(base protocol.IERCTransactionBase,
 			Protocol:   base.Protocol,
 			Operate:    base.Operate,
 			Tick:       record.Tick,
-			Platform:   strings.ToLower(record.Platform),
-			Seller:     strings.ToLower(record.Seller),
+			Platform:   address.Canonical(record.Platform),
+			Seller:     address.Canonical(record.Seller),
 			SellerSign: record.Sign,
 			SignNonce:  record.Nonce,
 			Amount:     record.Amt,
@@ -349,8 +350,8 @@ func (parser *IERCPoWParser) parseProxyTransfer(base protocol.IERCTransactionBas
 			Protocol:    base.Protocol,
 			Operate:     base.Operate,
 			Tick:        record.Tick,
-			From:        strings.ToLower(record.From),
-			To:          strings.ToLower(record.To),
+			From:        address.Canonical(record.From),
+			To:          address.Canonical(record.To),
 			Amount:      record.Amount,
 			Value:       record.Value,
 			Sign:        record.Sign,
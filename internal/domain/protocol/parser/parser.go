@@ -73,15 +73,8 @@ func (p *parser) Parse(tx *domain.Transaction) (protocol.IERCTransaction, error)
 	return parser.Parse(tx)
 }
 
-func NewParser() Parser {
-
-	parsers := make(map[protocol.Protocol]Parser)
-
-	ierc20Parser := NewIERC20Parser(protocol.ProtocolHeader, protocol.TickETHI)
-	parsers[protocol.ProtocolTERC20] = ierc20Parser
-	parsers[protocol.ProtocolIERC20] = ierc20Parser
-	parsers[protocol.ProtocolIERCPoW] = newIERC20PoWParser(protocol.ProtocolHeader)
-
+// 根据协议对应的解析器创建解析器. 协议对应的解析器由协议模块提供
+func NewParser(parsers map[protocol.Protocol]Parser) Parser {
 	return &parser{
 		header:       protocol.ProtocolHeader,
 		headerLength: len(protocol.ProtocolHeader),
@@ -10,6 +10,8 @@ import (
 type IERCTransaction interface {
 	String() string
 	Validate() error
+	GetProtocol() Protocol
+	GetOperate() Operate
 }
 
 var (
@@ -18,6 +20,11 @@ var (
 	_ IERCTransaction = (*MintCommand)(nil)
 	_ IERCTransaction = (*MintPoWCommand)(nil)
 	_ IERCTransaction = (*TransferCommand)(nil)
+	_ IERCTransaction = (*BurnCommand)(nil)
+	_ IERCTransaction = (*ApproveCommand)(nil)
+	_ IERCTransaction = (*TransferFromCommand)(nil)
+	_ IERCTransaction = (*VestCommand)(nil)
+	_ IERCTransaction = (*ClaimVestedCommand)(nil)
 	_ IERCTransaction = (*FreezeSellCommand)(nil)
 	_ IERCTransaction = (*ProxyTransferCommand)(nil)
 	_ IERCTransaction = (*ProxyTransferCommandV4)(nil)
@@ -47,6 +54,10 @@ func (protocol *IERCTransactionBase) String() string {
 	)
 }
 
+func (protocol *IERCTransactionBase) GetProtocol() Protocol { return protocol.Protocol }
+
+func (protocol *IERCTransactionBase) GetOperate() Operate { return protocol.Operate }
+
 func (protocol *IERCTransactionBase) Validate() error {
 
 	switch protocol.Operate {
@@ -73,6 +73,91 @@ const (
 	MintErrPoWShareZero                                       // mint. pow份额为0
 )
 
+const (
+	AllowanceError        ProtocolErrCode = iota + 0x0a00
+	InsufficientAllowance                 // 授权额度不足
+	SignatureExpired                      // 签名已过期
+	InvalidSignNonce                      // 签名 nonce 无效
+)
+
+const (
+	VestingError           ProtocolErrCode = iota + 0x0b00
+	InvalidVestingSchedule                 // 锁仓. 释放计划无效
+	VestingNothingToClaim                  // 领取锁仓. 没有可领取的数量
+)
+
+// 错误码名称, 用于对外展示. 与常量名保持一致
+var protocolErrCodeNames = map[ProtocolErrCode]string{
+	ProtocolErr:                               "ProtocolErr",
+	NotProtocolData:                           "NotProtocolData",
+	InvalidProtocolFormat:                     "InvalidProtocolFormat",
+	InvalidProtocolParams:                     "InvalidProtocolParams",
+	UnknownProtocol:                           "UnknownProtocol",
+	UnknownProtocolOperate:                    "UnknownProtocolOperate",
+	InvalidTxHash:                             "InvalidTxHash",
+	TickNotExist:                              "TickNotExist",
+	TickExited:                                "TickExited",
+	InsufficientAvailableFunds:                "InsufficientAvailableFunds",
+	InsufficientFreezeFunds:                   "InsufficientFreezeFunds",
+	InsufficientValue:                         "InsufficientValue",
+	SignatureNotExist:                         "SignatureNotExist",
+	SignatureAlreadyUsed:                      "SignatureAlreadyUsed",
+	SignatureNotMatch:                         "SignatureNotMatch",
+	MintErr:                                   "MintErr",
+	MintErrTickNotFound:                       "MintErrTickNotFound",
+	MintErrTickNotSupportPoW:                  "MintErrTickNotSupportPoW",
+	MintErrTickProtocolNoMatch:                "MintErrTickProtocolNoMatch",
+	MintErrTickMinted:                         "MintErrTickMinted",
+	MintPoWInvalidHash:                        "MintPoWInvalidHash",
+	MintPoSInvalidShare:                       "MintPoSInvalidShare",
+	MintAlreadyMinted:                         "MintAlreadyMinted",
+	MintAmountExceedLimit:                     "MintAmountExceedLimit",
+	MintInvalidBlock:                          "MintInvalidBlock",
+	MintBlockExpires:                          "MintBlockExpires",
+	MintErrMaxAmountLessThanSupply:            "MintErrMaxAmountLessThanSupply",
+	MintErrNoPermissionToClaimAirdrop:         "MintErrNoPermissionToClaimAirdrop",
+	MintErrInvalidAirdropAmount:               "MintErrInvalidAirdropAmount",
+	MintErrAirdropAmountExceedsRemainSupply:   "MintErrAirdropAmountExceedsRemainSupply",
+	MintErrAirdropClaimFailed:                 "MintErrAirdropClaimFailed",
+	InvalidSignature:                          "InvalidSignature",
+	TickErr:                                   "TickErr",
+	ErrTickProtocolNoMatch:                    "ErrTickProtocolNoMatch",
+	ErrUpdateMaxSupplyNoPermission:            "ErrUpdateMaxSupplyNoPermission",
+	ErrUpdateAmountLessThanSupply:             "ErrUpdateAmountLessThanSupply",
+	ErrUpdateFailed:                           "ErrUpdateFailed",
+	UnknownError:                              "UnknownError",
+	StakingError:                              "StakingError",
+	StakingTickUnsupported:                    "StakingTickUnsupported",
+	StakingTickNotExisted:                     "StakingTickNotExisted",
+	StakingPoolNotFound:                       "StakingPoolNotFound",
+	StakingPoolAlreadyStopped:                 "StakingPoolAlreadyStopped",
+	StakingPoolIsFulled:                       "StakingPoolIsFulled",
+	StakingPoolIsEnded:                        "StakingPoolIsEnded",
+	StakingPoolMaxAmountLessThanCurrentAmount: "StakingPoolMaxAmountLessThanCurrentAmount",
+	StakeConfigPoolNotMatch:                   "StakeConfigPoolNotMatch",
+	StakeConfigNoPermission:                   "StakeConfigNoPermission",
+	UnStakingErrNoStake:                       "UnStakingErrNoStake",
+	UnStakingErrStakeAmountInsufficient:       "UnStakingErrStakeAmountInsufficient",
+	UnStakingErrNotYetUnlocked:                "UnStakingErrNotYetUnlocked",
+	ProxyUnStakingErrNotAdmin:                 "ProxyUnStakingErrNotAdmin",
+	UseRewardsErrNoStake:                      "UseRewardsErrNoStake",
+	UseRewardsErrRewardsInsufficient:          "UseRewardsErrRewardsInsufficient",
+	MintErrDPoSMintPointsTooLow:               "MintErrDPoSMintPointsTooLow",
+	MintErrPoWShareZero:                       "MintErrPoWShareZero",
+	AllowanceError:                            "AllowanceError",
+	InsufficientAllowance:                     "InsufficientAllowance",
+	SignatureExpired:                          "SignatureExpired",
+	InvalidSignNonce:                          "InvalidSignNonce",
+	VestingError:                              "VestingError",
+	InvalidVestingSchedule:                    "InvalidVestingSchedule",
+	VestingNothingToClaim:                     "VestingNothingToClaim",
+}
+
+// Name 返回错误码的名称. 未知错误码返回空字符串
+func (c ProtocolErrCode) Name() string {
+	return protocolErrCodeNames[c]
+}
+
 type ProtocolError struct {
 	code    ProtocolErrCode
 	message string
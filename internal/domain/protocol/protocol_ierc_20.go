@@ -130,6 +130,231 @@ func (t *TransferCommand) Validate() error {
 	return nil
 }
 
+// ================ burn =================
+
+type BurnCommand struct {
+	IERCTransactionBase
+	Tick   string          `json:"tick,omitempty"` // tick
+	Amount decimal.Decimal `json:"amount"`         // 销毁数量
+}
+
+func (b *BurnCommand) Validate() error {
+	if err := b.IERCTransactionBase.Validate(); err != nil {
+		return err
+	}
+
+	// check: len(tick) <= 64
+	if len(b.Tick) == 0 || len(b.Tick) > TickMaxLength {
+		return NewProtocolError(InvalidProtocolParams, "invalid tick. length must be 1 ~ 64")
+	}
+
+	if b.Amount.LessThanOrEqual(decimal.Zero) {
+		return NewProtocolError(InvalidProtocolParams, "invalid burn amount. amount <= 0")
+	}
+
+	return nil
+}
+
+// ================ approve =================
+
+type ApproveRecord struct {
+	Protocol  Protocol
+	Operate   Operate
+	Tick      string
+	Owner     string          // 资产所有者. 链上授权时为交易发起人, 签名授权时为签名者
+	Spender   string          // 被授权者
+	Amount    decimal.Decimal // 授权额度, 0 表示取消授权
+	RawAmount string          // 铭文中的原始授权额度, 签名授权时按原始内容校验签名
+	Sign      string          // 签名. 为空表示链上授权
+	SignNonce string          // 签名者 nonce, 必须递增
+	Expire    uint64          // 签名过期区块, 0 表示不过期
+}
+
+// 是否是签名授权
+func (record *ApproveRecord) IsSigned() bool {
+	return len(record.Sign) != 0
+}
+
+func (record *ApproveRecord) ValidateParams() error {
+	if len(record.Tick) == 0 || len(record.Tick) > TickMaxLength {
+		return NewProtocolError(InvalidProtocolParams, "invalid tick. length must be 1 ~ 64")
+	}
+
+	if !utils.IsHexAddressWith0xPrefix(record.Owner) {
+		return NewProtocolError(InvalidProtocolParams, fmt.Sprintf("invalid owner address: %s", record.Owner))
+	}
+
+	if !utils.IsHexAddressWith0xPrefix(record.Spender) {
+		return NewProtocolError(InvalidProtocolParams, fmt.Sprintf("invalid spender address: %s", record.Spender))
+	}
+
+	if record.Owner == record.Spender {
+		return NewProtocolError(InvalidProtocolParams, "spender can not be owner")
+	}
+
+	if record.Amount.LessThan(decimal.Zero) {
+		return NewProtocolError(InvalidProtocolParams, fmt.Sprintf("invalid amount. Amount(%s) < 0", record.Amount))
+	}
+
+	return nil
+}
+
+func (record *ApproveRecord) ValidateSignature(blockNumber uint64) error {
+
+	signature := NewSignature(
+		record.Tick,
+		record.Owner,
+		record.Spender,
+		record.Amount.String(),
+		"",
+		record.SignNonce,
+	)
+
+	return signature.ApproveValidSignature(blockNumber, record)
+}
+
+type ApproveCommand struct {
+	IERCTransactionBase
+	Records []ApproveRecord
+}
+
+func (a *ApproveCommand) Validate() error {
+	if err := a.IERCTransactionBase.Validate(); err != nil {
+		return err
+	}
+
+	if len(a.Records) == 0 {
+		return NewProtocolError(InvalidProtocolParams, "missing records")
+	}
+
+	return nil
+}
+
+// ================ transfer from =================
+
+type TransferFromRecord struct {
+	Protocol Protocol
+	Operate  Operate
+	Tick     string
+	From     string          // 资产所有者
+	Spender  string          // 被授权者, 即交易发起人
+	Recv     string          // 接收者
+	Amount   decimal.Decimal // 划转数量
+}
+
+type TransferFromCommand struct {
+	IERCTransactionBase
+	Records []TransferFromRecord
+}
+
+func (t *TransferFromCommand) Validate() error {
+	if err := t.IERCTransactionBase.Validate(); err != nil {
+		return err
+	}
+
+	if len(t.Records) == 0 {
+		return NewProtocolError(InvalidProtocolParams, "missing records")
+	}
+
+	for _, record := range t.Records {
+		if len(record.Tick) == 0 || len(record.Tick) > TickMaxLength {
+			return NewProtocolError(InvalidProtocolParams, "invalid tick. length must be 1 ~ 64")
+		}
+
+		if !utils.IsHexAddressWith0xPrefix(record.From) {
+			return NewProtocolError(InvalidProtocolParams, fmt.Sprintf("invalid from address: %s", record.From))
+		}
+
+		if !utils.IsHexAddressWith0xPrefix(record.Recv) {
+			return NewProtocolError(InvalidProtocolParams, "invalid recv address")
+		}
+
+		if record.Amount.LessThanOrEqual(decimal.Zero) {
+			return NewProtocolError(InvalidProtocolParams, "invalid amount. amount <= 0")
+		}
+	}
+
+	return nil
+}
+
+// ================ vest & claim vested =================
+
+// 锁仓记录. 在 [StartBlock, EndBlock] 之间线性释放, CliffBlock 之前不释放.
+// StartBlock == EndBlock 时为到期一次性释放
+type VestRecord struct {
+	Protocol   Protocol
+	Operate    Operate
+	Tick       string
+	From       string          // 锁仓发起人
+	Recv       string          // 接收者
+	Amount     decimal.Decimal // 锁仓数量
+	StartBlock uint64          // 开始释放区块, 0 表示从当前区块开始
+	CliffBlock uint64          // 锁定期结束区块, 0 表示没有锁定期
+	EndBlock   uint64          // 全部释放区块
+}
+
+type VestCommand struct {
+	IERCTransactionBase
+	Records []VestRecord
+}
+
+func (v *VestCommand) Validate() error {
+	if err := v.IERCTransactionBase.Validate(); err != nil {
+		return err
+	}
+
+	if len(v.Records) == 0 {
+		return NewProtocolError(InvalidProtocolParams, "missing records")
+	}
+
+	for _, record := range v.Records {
+		if len(record.Tick) == 0 || len(record.Tick) > TickMaxLength {
+			return NewProtocolError(InvalidProtocolParams, "invalid tick. length must be 1 ~ 64")
+		}
+
+		if !utils.IsHexAddressWith0xPrefix(record.Recv) {
+			return NewProtocolError(InvalidProtocolParams, "invalid recv address")
+		}
+
+		if record.Amount.LessThanOrEqual(decimal.Zero) {
+			return NewProtocolError(InvalidProtocolParams, "invalid amount. amount <= 0")
+		}
+
+		if record.EndBlock == 0 {
+			return NewProtocolError(InvalidVestingSchedule, "missing end block")
+		}
+
+		if record.StartBlock > record.EndBlock {
+			return NewProtocolError(InvalidVestingSchedule, fmt.Sprintf("start(%d) > end(%d)", record.StartBlock, record.EndBlock))
+		}
+
+		// 与当前区块相关的校验在处理时进行, 见 ierc-20 模块的 handleVest
+		if record.CliffBlock > record.EndBlock || (record.CliffBlock != 0 && record.CliffBlock < record.StartBlock) {
+			return NewProtocolError(InvalidVestingSchedule, fmt.Sprintf("cliff(%d) must be in [start(%d), end(%d)]", record.CliffBlock, record.StartBlock, record.EndBlock))
+		}
+	}
+
+	return nil
+}
+
+// 领取已释放的锁仓. 领取交易发起人在该 tick 下所有锁仓中已释放的数量
+type ClaimVestedCommand struct {
+	IERCTransactionBase
+	Tick string
+}
+
+func (c *ClaimVestedCommand) Validate() error {
+	if err := c.IERCTransactionBase.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.Tick) == 0 || len(c.Tick) > TickMaxLength {
+		return NewProtocolError(InvalidProtocolParams, "invalid tick. length must be 1 ~ 64")
+	}
+
+	return nil
+}
+
 // ================ freeze sell =================
 
 type FreezeRecord struct {
@@ -254,7 +479,7 @@ func (record *FreezeRecordV4) ValidateParamsV4() error {
 	return nil
 }
 
-func (record *FreezeRecordV4) ValidateSignatureV4() error {
+func (record *FreezeRecordV4) ValidateSignatureV4(blockNumber uint64) error {
 
 	signature := NewSignature(
 		record.Tick,
@@ -265,7 +490,7 @@ func (record *FreezeRecordV4) ValidateSignatureV4() error {
 		record.SignNonce,
 	)
 
-	return signature.FreezeValidSignatureV4(record)
+	return signature.FreezeValidSignatureV4(blockNumber, record)
 }
 
 type FreezeSellCommandV4 struct {
@@ -399,7 +624,7 @@ func (record *ProxyTransferRecordV4) ValidateParams() error {
 	return nil
 }
 
-func (record *ProxyTransferRecordV4) ValidateSignature() error {
+func (record *ProxyTransferRecordV4) ValidateSignature(blockNumber uint64) error {
 
 	signature := NewSignature(
 		record.Tick,
@@ -410,7 +635,7 @@ func (record *ProxyTransferRecordV4) ValidateSignature() error {
 		record.SignerNonce,
 	)
 
-	return signature.ProxyTransferValidSignatureV4(record)
+	return signature.ProxyTransferValidSignatureV4(blockNumber, record)
 }
 
 type ProxyTransferCommandV4 struct {
@@ -78,3 +78,19 @@ func (s *TestProtocolSuite) TestStakingCommand() {
 		}
 	}
 }
+
+func (s *TestProtocolSuite) TestErrCodeName() {
+	s.Equal("InsufficientAvailableFunds", InsufficientAvailableFunds.Name())
+	s.Equal("StakingPoolNotFound", StakingPoolNotFound.Name())
+	s.Equal("VestingNothingToClaim", VestingNothingToClaim.Name())
+	s.Equal("", ProtocolErrCode(0).Name())
+}
+
+func (s *TestProtocolSuite) TestCommandOperate() {
+	var command IERCTransaction = &ProxyTransferCommand{
+		IERCTransactionBase: IERCTransactionBase{Protocol: ProtocolIERC20, Operate: OpProxyTransfer},
+	}
+
+	s.Equal(ProtocolIERC20, command.GetProtocol())
+	s.Equal(Operate(OpProxyTransfer), command.GetOperate())
+}
@@ -5,12 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/shopspring/decimal"
-	"os"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -55,20 +53,26 @@ func (s *Signature) ValidSignature(signature string) error {
 	message, _ := json.MarshalIndent(s, "", "    ")
 	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%v%s", len(message), message)))
 
-	pubKey, err := crypto.SigToPub(hash, sig)
+	address, err := recoverSigner(hash, signature, func() (string, error) {
+		pubKey, err := crypto.SigToPub(hash, sig)
+		if err != nil {
+			return "", err
+		}
+
+		return crypto.PubkeyToAddress(*pubKey).Hex(), nil
+	})
 	if err != nil {
 		return NewProtocolError(InvalidSignature, err.Error())
 	}
 
-	key := crypto.PubkeyToAddress(*pubKey)
-	if strings.ToLower(key.Hex()) != s.Signer {
+	if strings.ToLower(address) != s.Signer {
 		return NewProtocolError(SignatureNotMatch, "signature not match")
 	}
 
 	return nil
 }
 
-func (s *Signature) FreezeValidSignatureV4(rec *FreezeRecordV4) error {
+func (s *Signature) FreezeValidSignatureV4(blockNumber uint64, rec *FreezeRecordV4) error {
 	if len(rec.SellerSign) == 0 || !strings.HasPrefix(strings.ToLower(rec.SellerSign), "0x") {
 		return NewProtocolError(InvalidSignature, "invalid sign format")
 	}
@@ -79,12 +83,11 @@ func (s *Signature) FreezeValidSignatureV4(rec *FreezeRecordV4) error {
 	if len(sig) != 65 {
 		return NewProtocolError(InvalidSignature, "ValidateEOASignature, signature is not of proper length")
 	}
-	typedData := s.formatFreezeTypedData(rec)
-	jsonData, _ := json.Marshal(typedData)
-	fmt.Println(string(jsonData))
-	LogOutput(string(jsonData))
-	address, err := verifyAuthTokenAddress(typedData, rec.SellerSign)
+	address, err := signatureDomains.recoverSigner(blockNumber, rec.SellerSign, rec.Seller, func(domain apitypes.TypedDataDomain) apitypes.TypedData {
+		return s.formatFreezeTypedData(domain, rec)
+	})
 	if err != nil {
+		// 保持原有的错误码, 恢复签名者失败时按未知错误记录
 		return err
 	}
 	if strings.ToLower(address) != rec.Seller {
@@ -94,7 +97,7 @@ func (s *Signature) FreezeValidSignatureV4(rec *FreezeRecordV4) error {
 	return nil
 }
 
-func (s *Signature) ProxyTransferValidSignatureV4(rec *ProxyTransferRecordV4) error {
+func (s *Signature) ProxyTransferValidSignatureV4(blockNumber uint64, rec *ProxyTransferRecordV4) error {
 	if len(rec.Sign) == 0 || !strings.HasPrefix(strings.ToLower(rec.Sign), "0x") {
 		return NewProtocolError(InvalidSignature, "invalid sign format")
 	}
@@ -105,14 +108,12 @@ func (s *Signature) ProxyTransferValidSignatureV4(rec *ProxyTransferRecordV4) er
 	if len(sig) != 65 {
 		return NewProtocolError(InvalidSignature, "ValidateEOASignature, signature is not of proper length")
 	}
-	typedData := s.formatProxyTransferTypedData(rec)
-	jsonData, _ := json.Marshal(typedData)
-	fmt.Println(string(jsonData))
-	LogOutput(string(jsonData))
-	address, err := verifyAuthTokenAddress(typedData, rec.Sign)
-	LogOutput(strings.ToLower(address))
+	address, err := signatureDomains.recoverSigner(blockNumber, rec.Sign, s.Signer, func(domain apitypes.TypedDataDomain) apitypes.TypedData {
+		return s.formatProxyTransferTypedData(domain, rec)
+	})
 	if err != nil {
-		return err
+		// 调用方会把错误断言为 *ProtocolError, 原始错误会导致 panic, 所以这里包装为签名错误
+		return NewProtocolError(InvalidSignature, err.Error())
 	}
 	if strings.ToLower(address) != s.Signer {
 		return NewProtocolError(InvalidSignature, "signature not match")
@@ -121,29 +122,32 @@ func (s *Signature) ProxyTransferValidSignatureV4(rec *ProxyTransferRecordV4) er
 	return nil
 }
 
-func LogOutput(str_content string) {
-	fd, _ := os.OpenFile("./logOutput.txt", os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
-	fd_time := time.Now().Format("2006-01-02 15:04:05")
-	fd_content := strings.Join([]string{"======", fd_time, "=====\n", str_content, "\n"}, "")
-	buf := []byte(fd_content)
-	fd.Write(buf)
-	fd.Close()
-}
-
-func (s *Signature) formatFreezeTypedData(rec *FreezeRecordV4) apitypes.TypedData {
-	typedDataDomain := apitypes.TypedDataDomain{
-		Name:              CreateOrderSignatureTitle,
-		Version:           "1",
-		ChainId:           math.NewHexOrDecimal256(1),
-		VerifyingContract: "0x33302dbff493ed81ba2e7e35e2e8e833db023333",
+func (s *Signature) ApproveValidSignature(blockNumber uint64, rec *ApproveRecord) error {
+	if len(rec.Sign) == 0 || !strings.HasPrefix(strings.ToLower(rec.Sign), "0x") {
+		return NewProtocolError(InvalidSignature, "invalid sign format")
 	}
-	types := make(apitypes.Types)
-	types["EIP712Domain"] = []apitypes.Type{
-		{"name", "string"},
-		{"version", "string"},
-		{"chainId", "uint256"},
-		{"verifyingContract", "address"},
+	sig, err := hex.DecodeString(strings.TrimPrefix(rec.Sign, "0x"))
+	if err != nil {
+		return NewProtocolError(InvalidSignature, "ValidateEOASignature, signature is an invalid hex string")
+	}
+	if len(sig) != 65 {
+		return NewProtocolError(InvalidSignature, "ValidateEOASignature, signature is not of proper length")
+	}
+	address, err := approveSignatureDomains.recoverSigner(blockNumber, rec.Sign, s.Signer, func(domain apitypes.TypedDataDomain) apitypes.TypedData {
+		return s.formatApproveTypedData(domain, rec)
+	})
+	if err != nil {
+		return NewProtocolError(InvalidSignature, err.Error())
+	}
+	if strings.ToLower(address) != s.Signer {
+		return NewProtocolError(SignatureNotMatch, "signature not match")
 	}
+
+	return nil
+}
+
+func (s *Signature) formatFreezeTypedData(typedDataDomain apitypes.TypedDataDomain, rec *FreezeRecordV4) apitypes.TypedData {
+	types := newTypedDataTypes()
 	types["Transaction"] = []apitypes.Type{
 		{"title", "string"},
 		{"version", "string"},
@@ -184,20 +188,8 @@ func (s *Signature) formatFreezeTypedData(rec *FreezeRecordV4) apitypes.TypedDat
 	return AuthData
 }
 
-func (s *Signature) formatProxyTransferTypedData(rec *ProxyTransferRecordV4) apitypes.TypedData {
-	typedDataDomain := apitypes.TypedDataDomain{
-		Name:              CreateOrderSignatureTitle,
-		Version:           "1",
-		ChainId:           math.NewHexOrDecimal256(1),
-		VerifyingContract: "0x33302dbff493ed81ba2e7e35e2e8e833db023333",
-	}
-	types := make(apitypes.Types)
-	types["EIP712Domain"] = []apitypes.Type{
-		{"name", "string"},
-		{"version", "string"},
-		{"chainId", "uint256"},
-		{"verifyingContract", "address"},
-	}
+func (s *Signature) formatProxyTransferTypedData(typedDataDomain apitypes.TypedDataDomain, rec *ProxyTransferRecordV4) apitypes.TypedData {
+	types := newTypedDataTypes()
 	types["Transaction"] = []apitypes.Type{
 		{"title", "string"},
 		{"version", "string"},
@@ -238,6 +230,46 @@ func (s *Signature) formatProxyTransferTypedData(rec *ProxyTransferRecordV4) api
 	return AuthData
 }
 
+func (s *Signature) formatApproveTypedData(typedDataDomain apitypes.TypedDataDomain, rec *ApproveRecord) apitypes.TypedData {
+	types := newTypedDataTypes()
+	types["Approve"] = []apitypes.Type{
+		{Name: "title", Type: "string"},
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "tick", Type: "string"},
+		{Name: "amt", Type: "string"},
+		{Name: "nonce", Type: "string"},
+		{Name: "expire", Type: "string"},
+	}
+
+	msg := make(apitypes.TypedDataMessage)
+	msg["title"] = ApproveSignatureTitle
+	msg["owner"] = s.Signer
+	msg["spender"] = s.To
+	msg["tick"] = s.Tick
+	msg["amt"] = rec.RawAmount
+	msg["nonce"] = s.Nonce
+	msg["expire"] = strconv.FormatUint(rec.Expire, 10)
+
+	return apitypes.TypedData{
+		Types:       types,
+		PrimaryType: "Approve",
+		Domain:      typedDataDomain,
+		Message:     msg,
+	}
+}
+
+func newTypedDataTypes() apitypes.Types {
+	types := make(apitypes.Types)
+	types["EIP712Domain"] = []apitypes.Type{
+		{"name", "string"},
+		{"version", "string"},
+		{"chainId", "uint256"},
+		{"verifyingContract", "address"},
+	}
+	return types
+}
+
 func verifyAuthTokenAddress(authToken apitypes.TypedData, sign string) (string, error) {
 	signature, err := hexutil.Decode(sign)
 	if err != nil {
@@ -264,20 +296,22 @@ func verifyAuthTokenAddress(authToken apitypes.TypedData, sign string) (string,
 	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
 	sighash := crypto.Keccak256(rawData)
 
-	signature[64] -= 27
+	return recoverSigner(sighash, sign, func() (string, error) {
+		signature[64] -= 27
 
-	// get the pubkey used to sign this signature
-	sigPubkey, err := crypto.Ecrecover(sighash, signature)
-	if err != nil {
-		return "", fmt.Errorf("ecrecover: %w", err)
-	}
+		// get the pubkey used to sign this signature
+		sigPubkey, err := crypto.Ecrecover(sighash, signature)
+		if err != nil {
+			return "", fmt.Errorf("ecrecover: %w", err)
+		}
 
-	// get the address to confirm it's the same one in the auth token
-	pubkey, err := crypto.UnmarshalPubkey(sigPubkey)
-	if err != nil {
-		return "", fmt.Errorf("unmarshal Pubkey: %w", err)
-	}
-	address := crypto.PubkeyToAddress(*pubkey)
+		// get the address to confirm it's the same one in the auth token
+		pubkey, err := crypto.UnmarshalPubkey(sigPubkey)
+		if err != nil {
+			return "", fmt.Errorf("unmarshal Pubkey: %w", err)
+		}
+		address := crypto.PubkeyToAddress(*pubkey)
 
-	return address.Hex(), nil
+		return address.Hex(), nil
+	})
 }
@@ -0,0 +1,70 @@
+package protocol
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+)
+
+// 签名恢复结果的缓存数量
+const signatureCacheSize = 100_000
+
+type signatureCacheKey struct {
+	hash common.Hash // 签名的消息哈希
+	sign string      // 签名
+}
+
+type recoveredSigner struct {
+	address string
+	err     error
+}
+
+// 签名恢复结果缓存. 同一个消息哈希和签名恢复出的地址是确定的, 可以在区块预处理时并发计算
+var signatureCache = lru.NewCache[signatureCacheKey, recoveredSigner](signatureCacheSize)
+
+// 恢复签名者地址, 结果按 (消息哈希, 签名) 缓存
+func recoverSigner(hash []byte, sign string, recover func() (string, error)) (string, error) {
+	key := signatureCacheKey{hash: common.BytesToHash(hash), sign: sign}
+	if result, ok := signatureCache.Get(key); ok {
+		return result.address, result.err
+	}
+
+	address, err := recover()
+	signatureCache.Add(key, recoveredSigner{address: address, err: err})
+	return address, err
+}
+
+// 交易中所有需要校验的签名. 校验与处理交易时完全相同, 可以并发执行, 结果会被缓存
+func SignatureChecks(tx IERCTransaction) []func() error {
+	var checks []func() error
+	switch command := tx.(type) {
+	case *ApproveCommand:
+		for idx := range command.Records {
+			record := &command.Records[idx]
+			checks = append(checks, func() error { return record.ValidateSignature(command.BlockNumber) })
+		}
+
+	case *FreezeSellCommand:
+		for idx := range command.Records {
+			checks = append(checks, command.Records[idx].ValidateSignature)
+		}
+
+	case *FreezeSellCommandV4:
+		for idx := range command.Records {
+			record := &command.Records[idx]
+			checks = append(checks, func() error { return record.ValidateSignatureV4(command.BlockNumber) })
+		}
+
+	case *ProxyTransferCommand:
+		for idx := range command.Records {
+			checks = append(checks, command.Records[idx].ValidateSignature)
+		}
+
+	case *ProxyTransferCommandV4:
+		for idx := range command.Records {
+			record := &command.Records[idx]
+			checks = append(checks, func() error { return record.ValidateSignature(command.BlockNumber) })
+		}
+	}
+
+	return checks
+}
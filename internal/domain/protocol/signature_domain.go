@@ -0,0 +1,194 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/kevin88886/eth_indexer/pkg/utils"
+)
+
+// v4 签名使用的 EIP-712 domain. 在 [StartBlock, EndBlock] 区间内生效, EndBlock 为 0 表示一直生效.
+// 迁移期间可以同时配置多个生效的 domain, 签名匹配任意一个即可
+type SignatureDomain struct {
+	Name              string
+	Version           string
+	ChainID           uint64
+	VerifyingContract string
+	StartBlock        uint64
+	EndBlock          uint64
+}
+
+func (d *SignatureDomain) IsActive(blockNumber uint64) bool {
+	return blockNumber >= d.StartBlock && (d.EndBlock == 0 || blockNumber <= d.EndBlock)
+}
+
+func (d *SignatureDomain) Validate() error {
+	if d.Name == "" || d.Version == "" {
+		return errors.New("missing name or version")
+	}
+
+	if d.ChainID == 0 {
+		return errors.New("missing chain id")
+	}
+
+	if !utils.IsHexAddressWith0xPrefix(d.VerifyingContract) {
+		return fmt.Errorf("invalid verifying contract: %s", d.VerifyingContract)
+	}
+
+	if d.EndBlock != 0 && d.EndBlock < d.StartBlock {
+		return fmt.Errorf("end block(%d) < start block(%d)", d.EndBlock, d.StartBlock)
+	}
+
+	return nil
+}
+
+func (d *SignatureDomain) typedDataDomain() apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              d.Name,
+		Version:           d.Version,
+		ChainId:           math.NewHexOrDecimal256(int64(d.ChainID)),
+		VerifyingContract: strings.ToLower(d.VerifyingContract),
+	}
+}
+
+// 内置的 domain. 没有配置时使用
+var defaultSignatureDomain = SignatureDomain{
+	Name:              CreateOrderSignatureTitle,
+	Version:           "1",
+	ChainID:           1,
+	VerifyingContract: "0x33302dbff493ed81ba2e7e35e2e8e833db023333",
+}
+
+// 签名授权内置的 domain. 授权不经过合约校验, verifyingContract 为零地址.
+// 与挂单签名使用不同的 domain, 挂单签名不能被当作授权签名使用
+var defaultApproveSignatureDomain = SignatureDomain{
+	Name:              ApproveSignatureTitle,
+	Version:           "1",
+	ChainID:           1,
+	VerifyingContract: ZeroAddress,
+}
+
+// 一组按区块范围生效的 domain
+type signatureDomainSet struct {
+	mu       sync.RWMutex
+	defaults SignatureDomain
+	domains  []SignatureDomain
+}
+
+func newSignatureDomainSet(defaults SignatureDomain) *signatureDomainSet {
+	return &signatureDomainSet{defaults: defaults, domains: []SignatureDomain{defaults}}
+}
+
+var (
+	signatureDomains        = newSignatureDomainSet(defaultSignatureDomain)        // 挂单、代理划转
+	approveSignatureDomains = newSignatureDomainSet(defaultApproveSignatureDomain) // 签名授权
+)
+
+// 设置 v4 签名的 domain, 启动时调用. 为空时恢复为内置的 domain.
+// 配置的 domain 必须从区块 0 开始连续覆盖所有区块, 否则缺失区间内的签名都会校验失败
+func SetSignatureDomains(domains ...SignatureDomain) error {
+	return signatureDomains.set(domains...)
+}
+
+// 设置签名授权的 domain, 启动时调用. 为空时恢复为内置的 domain. 覆盖要求同 SetSignatureDomains
+func SetApproveSignatureDomains(domains ...SignatureDomain) error {
+	return approveSignatureDomains.set(domains...)
+}
+
+func (set *signatureDomainSet) set(domains ...SignatureDomain) error {
+	for idx := range domains {
+		if err := domains[idx].Validate(); err != nil {
+			return fmt.Errorf("invalid signature domain[%d]: %w", idx, err)
+		}
+	}
+
+	if len(domains) == 0 {
+		domains = []SignatureDomain{set.defaults}
+	}
+
+	if err := checkSignatureDomainsCoverage(domains); err != nil {
+		return err
+	}
+
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	set.domains = append([]SignatureDomain(nil), domains...)
+	return nil
+}
+
+// 检查 domain 是否从区块 0 开始连续覆盖所有区块: 区间之间不能有空隙, 并且最后必须有一直生效的 domain
+func checkSignatureDomainsCoverage(domains []SignatureDomain) error {
+	sorted := append([]SignatureDomain(nil), domains...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].StartBlock < sorted[j].StartBlock })
+
+	var (
+		next      uint64 // 下一个没有被覆盖的区块
+		openEnded bool
+	)
+	for _, domain := range sorted {
+		if openEnded {
+			break
+		}
+
+		if domain.StartBlock > next {
+			return fmt.Errorf("signature domains do not cover blocks [%d, %d]", next, domain.StartBlock-1)
+		}
+
+		if domain.EndBlock == 0 {
+			openEnded = true
+		} else {
+			next = max(next, domain.EndBlock+1)
+		}
+	}
+
+	if !openEnded {
+		return fmt.Errorf("signature domains do not cover blocks from %d, the last domain must have end block 0", next)
+	}
+
+	return nil
+}
+
+// 区块中生效的 domain, 按配置顺序返回
+func (set *signatureDomainSet) at(blockNumber uint64) []SignatureDomain {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+
+	var result = make([]SignatureDomain, 0, len(set.domains))
+	for _, domain := range set.domains {
+		if domain.IsActive(blockNumber) {
+			result = append(result, domain)
+		}
+	}
+
+	return result
+}
+
+// 使用区块中生效的 domain 依次恢复签名者, 任意一个与 signer 匹配即返回. 都不匹配时返回第一个 domain 的结果
+func (set *signatureDomainSet) recoverSigner(blockNumber uint64, sign, signer string, format func(domain apitypes.TypedDataDomain) apitypes.TypedData) (string, error) {
+	domains := set.at(blockNumber)
+	if len(domains) == 0 {
+		return "", fmt.Errorf("no signature domain at block %d", blockNumber)
+	}
+
+	var (
+		firstAddress string
+		firstErr     error
+	)
+	for idx, domain := range domains {
+		address, err := verifyAuthTokenAddress(format(domain.typedDataDomain()), sign)
+		if err == nil && strings.ToLower(address) == signer {
+			return address, nil
+		}
+
+		if idx == 0 {
+			firstAddress, firstErr = address, err
+		}
+	}
+
+	return firstAddress, firstErr
+}
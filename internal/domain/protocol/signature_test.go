@@ -1,8 +1,14 @@
 package protocol
 
 import (
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -45,3 +51,181 @@ func (s *TestSignatureSuite) TestSignature1() {
 	err := signature.ValidSignature(sign)
 	s.Nil(err)
 }
+
+func (s *TestSignatureSuite) TestApproveSignature() {
+	key, err := crypto.GenerateKey()
+	s.NoError(err)
+
+	record := &ApproveRecord{
+		Protocol:  ProtocolIERC20,
+		Operate:   OpApprove,
+		Tick:      "ethi",
+		Owner:     strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex()),
+		Spender:   "0x1878d3363a02f1b5e13ce15287c5c29515000656",
+		Amount:    decimal.NewFromInt(100),
+		RawAmount: "100.0",
+		SignNonce: "1",
+		Expire:    20,
+	}
+
+	signature := NewSignature(record.Tick, record.Owner, record.Spender, record.Amount.String(), "", record.SignNonce)
+	hash, _, err := apitypes.TypedDataAndHash(signature.formatApproveTypedData(defaultApproveSignatureDomain.typedDataDomain(), record))
+	s.NoError(err)
+
+	sig, err := crypto.Sign(hash, key)
+	s.NoError(err)
+	sig[64] += 27
+
+	record.Sign = hexutil.Encode(sig)
+	s.NoError(record.ValidateSignature(100))
+
+	// 签名的是铭文中的原始额度, 数值相同但格式不同时签名不匹配
+	record.RawAmount = "100"
+	s.Error(record.ValidateSignature(100))
+
+	// 修改授权额度后签名不匹配
+	record.RawAmount = "101"
+	s.Error(record.ValidateSignature(100))
+
+	// 挂单签名的 domain 不能用于授权
+	record.RawAmount = "100.0"
+	hash, _, err = apitypes.TypedDataAndHash(signature.formatApproveTypedData(defaultSignatureDomain.typedDataDomain(), record))
+	s.NoError(err)
+	sig, err = crypto.Sign(hash, key)
+	s.NoError(err)
+	sig[64] += 27
+	record.Sign = hexutil.Encode(sig)
+	s.Error(record.ValidateSignature(100))
+}
+
+func (s *TestSignatureSuite) TestSignatureCache() {
+	key, err := crypto.GenerateKey()
+	s.NoError(err)
+
+	command := &ApproveCommand{}
+	for i := 0; i < 8; i++ {
+		record := ApproveRecord{
+			Protocol:  ProtocolIERC20,
+			Operate:   OpApprove,
+			Tick:      "ethi",
+			Owner:     strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex()),
+			Spender:   "0x1878d3363a02f1b5e13ce15287c5c29515000656",
+			Amount:    decimal.NewFromInt(int64(100 + i)),
+			RawAmount: decimal.NewFromInt(int64(100 + i)).String(),
+			SignNonce: "1",
+			Expire:    20,
+		}
+
+		signature := NewSignature(record.Tick, record.Owner, record.Spender, record.Amount.String(), "", record.SignNonce)
+		hash, _, err := apitypes.TypedDataAndHash(signature.formatApproveTypedData(defaultApproveSignatureDomain.typedDataDomain(), &record))
+		s.NoError(err)
+
+		sig, err := crypto.Sign(hash, key)
+		s.NoError(err)
+		sig[64] += 27
+
+		record.Sign = hexutil.Encode(sig)
+		command.Records = append(command.Records, record)
+	}
+
+	// 并发预校验
+	checks := SignatureChecks(command)
+	s.Len(checks, len(command.Records))
+
+	var wg sync.WaitGroup
+	for _, check := range checks {
+		wg.Add(1)
+		go func(check func() error) {
+			defer wg.Done()
+			s.NoError(check())
+		}(check)
+	}
+	wg.Wait()
+
+	// 处理时使用缓存的结果, 结果与预校验一致
+	for idx := range command.Records {
+		s.NoError(command.Records[idx].ValidateSignature(100))
+	}
+
+	// 签名内容不同时校验失败
+	record := command.Records[0]
+	record.Owner = "0x9ffc341849486014b340f8d7a3fad10e972aede6"
+	s.Error(record.ValidateSignature(100))
+
+	s.Empty(SignatureChecks(&TransferCommand{}))
+}
+
+func (s *TestSignatureSuite) TestSignatureDomains() {
+	key, err := crypto.GenerateKey()
+	s.NoError(err)
+
+	v1 := defaultApproveSignatureDomain
+	v1.EndBlock = 200
+	v2 := defaultApproveSignatureDomain
+	v2.Version = "2"
+	v2.StartBlock = 150
+
+	s.Require().NoError(SetApproveSignatureDomains(v1, v2))
+	defer func() {
+		s.NoError(SetApproveSignatureDomains())
+	}()
+
+	newRecord := func(domain SignatureDomain) *ApproveRecord {
+		record := &ApproveRecord{
+			Protocol:  ProtocolIERC20,
+			Operate:   OpApprove,
+			Tick:      "ethi",
+			Owner:     strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex()),
+			Spender:   "0x1878d3363a02f1b5e13ce15287c5c29515000656",
+			Amount:    decimal.NewFromInt(100),
+			RawAmount: "100",
+			SignNonce: domain.Version,
+			Expire:    20,
+		}
+
+		signature := NewSignature(record.Tick, record.Owner, record.Spender, record.Amount.String(), "", record.SignNonce)
+		hash, _, err := apitypes.TypedDataAndHash(signature.formatApproveTypedData(domain.typedDataDomain(), record))
+		s.NoError(err)
+
+		sig, err := crypto.Sign(hash, key)
+		s.NoError(err)
+		sig[64] += 27
+		record.Sign = hexutil.Encode(sig)
+		return record
+	}
+
+	// 旧版本签名在迁移窗口结束前有效
+	record := newRecord(v1)
+	s.NoError(record.ValidateSignature(100))
+	s.NoError(record.ValidateSignature(180))
+	s.Error(record.ValidateSignature(201))
+
+	// 新版本签名从迁移窗口开始有效
+	record = newRecord(v2)
+	s.Error(record.ValidateSignature(100))
+	s.NoError(record.ValidateSignature(180))
+	s.NoError(record.ValidateSignature(201))
+
+	// 配置校验
+	invalid := defaultSignatureDomain
+	invalid.VerifyingContract = "0x01"
+	s.Error(SetSignatureDomains(invalid))
+
+	// 必须从区块 0 开始连续覆盖所有区块
+	late := defaultSignatureDomain
+	late.StartBlock = 100
+	s.Error(SetSignatureDomains(late))
+
+	limited := defaultSignatureDomain
+	limited.EndBlock = 100
+	s.Error(SetSignatureDomains(limited))
+
+	gap := defaultSignatureDomain
+	gap.StartBlock = 102
+	s.Error(SetSignatureDomains(limited, gap))
+
+	adjacent := defaultSignatureDomain
+	adjacent.StartBlock = 101
+	s.NoError(SetSignatureDomains(adjacent, limited))
+	s.NoError(SetSignatureDomains())
+}
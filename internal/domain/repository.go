@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/kevin88886/eth_indexer/internal/domain/mining"
 )
 
 type BlockFetcher interface {
@@ -19,7 +20,10 @@ type BlockRepository interface {
 	GetPendingBlocksWithTransactionsByNumber(ctx context.Context, number uint64, bulkSize int) ([]*Block, error)
 	// 临时兼容接口
 	QueryLastProcessedBlock(ctx context.Context, blockNumber uint64) (*BlockHeader, error)
+	// 按hash查询交易. 交易不存在时返回 nil
 	QueryTransactionByHash(ctx context.Context, hash string) (*Transaction, error)
+	// 按hash批量查询交易. 不存在的交易不在结果中
+	QueryTransactionsByHashes(ctx context.Context, hashes ...string) ([]*Transaction, error)
 
 	BulkSaveBlock(ctx context.Context, blocks []*Block) error
 	Update(ctx context.Context, block *Block) error
@@ -76,10 +80,26 @@ type EventRepository interface {
 
 	GetBlockNumberByLastEvent(ctx context.Context) (uint64, error)
 	QueryEventBySignature(ctx context.Context, signs []string) (map[string]Event, error)
-	SubscribeEvent(ctx context.Context, startBlock uint64) (*Stream[EventsByBlock], error)
+	// 从订阅位置之后开始, 按区块顺序无缺失地推送事件. filter 为空时推送所有事件
+	SubscribeEvent(ctx context.Context, position EventStreamPosition, filter *EventFilter) (*Stream[EventNotification], error)
 	LoadEventsByBlocks(ctx context.Context, startBlock uint64, limit int) ([]*EventsByBlock, error)
 	QueryEventsByBlocks(ctx context.Context, startBlock uint64, blockNum int) ([]*EventsByBlock, error)
 	QueryEventsByHash(ctx context.Context, hash string) ([]Event, error)
+	// 按交易hash批量查询事件, 结果以交易hash分组
+	QueryEventsByHashes(ctx context.Context, hashes ...string) (map[string][]Event, error)
+	// 按条件分页查询事件. 游标无效时返回 ErrInvalidEventCursor
+	QueryEvents(ctx context.Context, query *EventQuery) (*EventPage, error)
+	// 在一次查询中执行多个分页查询, 结果与 queries 一一对应
+	QueryEventsBatch(ctx context.Context, queries []*EventQuery) ([]*EventPage, error)
+}
+
+// 挖矿统计仓储
+type MiningStatsRepository interface {
+	Save(ctx context.Context, stats ...*mining.BlockStats) error
+	// 按区块升序查询
+	Query(ctx context.Context, query *mining.StatsQuery) ([]*mining.BlockStats, error)
+	// 订阅 startBlock 之后的挖矿统计, tick 为空时订阅所有 tick
+	Subscribe(ctx context.Context, tick string, startBlock uint64) (*Stream[mining.BlockStats], error)
 }
 
 // 事务仓储
@@ -87,3 +107,11 @@ type TransactionRepository interface {
 	TransactionSave(ctx context.Context, fn func(ctx context.Context) error) error
 	UpdateCache(ctx context.Context, fn func(ctx context.Context) error) error
 }
+
+// 无效交易仓储
+type InvalidTxRepository interface {
+	LoadAll(ctx context.Context) ([]*InvalidTx, error)
+	// 保存无效交易, 已经存在的交易hash会被忽略. 返回实际新增的数量
+	Create(ctx context.Context, entries ...*InvalidTx) (int64, error)
+	Delete(ctx context.Context, hashes ...string) error
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"runtime"
+	"sort"
 	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
@@ -9,9 +11,15 @@ import (
 	"github.com/kevin88886/eth_indexer/internal/conf"
 	"github.com/kevin88886/eth_indexer/internal/domain"
 	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/domain/mining"
+	"github.com/kevin88886/eth_indexer/internal/domain/module"
+	"github.com/kevin88886/eth_indexer/internal/domain/order"
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/kevin88886/eth_indexer/internal/domain/staking"
 	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/kevin88886/eth_indexer/internal/domain/trade"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -24,10 +32,20 @@ type BlockService struct {
 	tickRepo        tick.TickRepository
 	balanceRepo     balance.BalanceRepository
 	stakingRepo     staking.StakingRepository
+	rewardsRepo     staking.RewardsRecordRepository
+	miningRepo      domain.MiningStatsRepository
+	orderRepo       order.OrderRepository
+	tradeRepo       trade.TradeRepository
+	candleRepo      trade.CandleRepository
+	holderRepo      holder.HolderRepository
+	outboxRepo      outbox.OutboxRepository
+	outboxEnabled   bool                        // 是否写入发件箱. 没有发布方时不写入
+	handlers        []domain.ProtocolHandler    // 协议处理器, 由已注册的协议模块提供
+	stateFactories  []domain.ModuleStateFactory // 协议模块自有状态的工厂
+	invalidTxs      *InvalidTxService           // 无效交易
 
 	// config
-	invalidHashMap map[string]struct{} // 无效交易Hash. 来自配置文件
-	feeStartBlock  uint64              // 开始收费的区块
+	feeStartBlock uint64 // 开始收费的区块
 
 	// runtime
 	lastHandleBlock uint64 // 最后处理的区块, 只记录带有事件的区块
@@ -42,12 +60,35 @@ func NewBlockService(
 	tickRepo tick.TickRepository,
 	balanceRepo balance.BalanceRepository,
 	stakingRepo staking.StakingRepository,
+	rewardsRepo staking.RewardsRecordRepository,
+	miningRepo domain.MiningStatsRepository,
+	orderRepo order.OrderRepository,
+	tradeRepo trade.TradeRepository,
+	candleRepo trade.CandleRepository,
+	holderRepo holder.HolderRepository,
+	outboxRepo outbox.OutboxRepository,
+	publishers []outbox.Publisher,
+	stateFactories []domain.ModuleStateFactory,
+	invalidTxs *InvalidTxService,
 ) (*BlockService, error) {
 	lastBlock, err := eventRepo.GetBlockNumberByLastEvent(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
+	// 设置 v4 签名的 EIP-712 domain
+	if err := protocol.SetSignatureDomains(convertSignatureDomains(c.Runtime.GetSignatureDomains())...); err != nil {
+		return nil, err
+	}
+	if err := protocol.SetApproveSignatureDomains(convertSignatureDomains(c.Runtime.GetApproveSignatureDomains())...); err != nil {
+		return nil, err
+	}
+
+	// 首次启动时根据当前余额生成持仓统计
+	if err := holderRepo.Rebuild(context.Background()); err != nil {
+		return nil, err
+	}
+
 	return &BlockService{
 		logger:          log.NewHelper(log.With(logger, "module", "BlockService")),
 		blockRepo:       blockRepo,
@@ -56,7 +97,17 @@ func NewBlockService(
 		tickRepo:        tickRepo,
 		balanceRepo:     balanceRepo,
 		stakingRepo:     stakingRepo,
-		invalidHashMap:  c.InvalidTxHash,
+		rewardsRepo:     rewardsRepo,
+		miningRepo:      miningRepo,
+		orderRepo:       orderRepo,
+		tradeRepo:       tradeRepo,
+		candleRepo:      candleRepo,
+		holderRepo:      holderRepo,
+		outboxRepo:      outboxRepo,
+		outboxEnabled:   len(publishers) != 0,
+		handlers:        module.Handlers(),
+		stateFactories:  stateFactories,
+		invalidTxs:      invalidTxs,
 		feeStartBlock:   c.Runtime.GetFeeStartBlock(),
 		lastHandleBlock: lastBlock,
 	}, nil
@@ -83,8 +134,12 @@ func (b *BlockService) HandleBlock(ctx context.Context, block *domain.Block) err
 		b.logger.Infof("handle block done. block_number: %d, events: %d, duration: %v", block.Number, eventCount, time.Since(start))
 	}()
 
+	// 处理完成之前不允许修改无效交易列表
+	invalidTxs, release := b.invalidTxs.Hold()
+	defer release()
+
 	// 预处理，加载相关的所有数据
-	aggregate, err := b.preprocessing(ctx, block)
+	aggregate, err := b.preprocessing(ctx, block, invalidTxs)
 	if err != nil {
 		return err
 	}
@@ -107,17 +162,16 @@ func (b *BlockService) HandleBlock(ctx context.Context, block *domain.Block) err
 }
 
 // 区块预处理
-func (b *BlockService) preprocessing(ctx context.Context, block *domain.Block) (*domain.AggregateRoot, error) {
+func (b *BlockService) preprocessing(ctx context.Context, block *domain.Block, invalidTxs domain.InvalidTxSet) (*domain.AggregateRoot, error) {
 
 	var (
-		aggregate = domain.NewBlockAggregate(b.lastHandleBlock, block, b.invalidHashMap, b.feeStartBlock)
-
-		tickSet         = mapset.NewSet[string]()             // 记录当前区块涉及到的所有tick
-		balanceSet      = mapset.NewSet[balance.BalanceKey]() // 记录当前区块涉及到的所有余额信息
-		signatureSet    = mapset.NewSet[string]()             // 记录当前区块涉及到的所有签名
-		unfreezeSignSet = mapset.NewSet[string]()             // 记录当前区块涉及到的解冻事件相关的签名
+		aggregate = domain.NewBlockAggregate(b.lastHandleBlock, block, invalidTxs, b.feeStartBlock, b.handlers...)
+		states    = b.newModuleStates()
+		deps      = domain.NewDependencies(states...) // 记录当前区块涉及到的所有 tick、余额、签名以及模块自有的状态
 	)
 
+	aggregate.States = states
+
 	// 直接加载所有质押池
 	pools, err := b.stakingRepo.LoadAllPools(ctx)
 	if err != nil {
@@ -147,98 +201,8 @@ loop:
 
 		b.logger.Debugf("preprocessing transaction: %v", transaction.IERCTransaction)
 
-		// 协议处理
-		switch t := transaction.IERCTransaction.(type) {
-		// 部署
-		case *protocol.DeployCommand:
-			tickSet.Add(t.Tick)
-
-		case *protocol.DeployPoWCommand:
-			tickSet.Add(t.Tick)
-
-		// 挖矿
-		case *protocol.MintCommand:
-			tickSet.Add(t.Tick)
-			balanceSet.Add(balance.NewBalanceKey(t.From, t.Tick))
-
-		case *protocol.MintPoWCommand:
-			tickName := t.Tick()
-			tickSet.Add(tickName)
-			balanceSet.Add(balance.NewBalanceKey(t.From, tickName))
-			// pow mint 可能存在销毁, 所以加载零地址的余额
-			balanceSet.Add(balance.NewBalanceKey(protocol.ZeroAddress, tickName))
-
-		case *protocol.ModifyCommand:
-			tickSet.Add(t.Tick)
-
-		case *protocol.ClaimAirdropCommand:
-			tickSet.Add(t.Tick)
-			balanceSet.Add(balance.NewBalanceKey(t.From, t.Tick))
-
-		// 划转
-		case *protocol.TransferCommand:
-			for _, record := range t.Records {
-				tickSet.Add(record.Tick)
-				balanceSet.Add(balance.NewBalanceKey(record.From, record.Tick))
-				balanceSet.Add(balance.NewBalanceKey(record.Recv, record.Tick))
-			}
-
-		// 冻结
-		case *protocol.FreezeSellCommand:
-			for _, record := range t.Records {
-				tickSet.Add(record.Tick)
-				balanceSet.Add(balance.NewBalanceKey(record.Seller, record.Tick))
-				signatureSet.Add(record.SellerSign)
-			}
-
-		// 冻结V4
-		case *protocol.FreezeSellCommandV4:
-			for _, record := range t.Records {
-				tickSet.Add(record.Tick)
-				balanceSet.Add(balance.NewBalanceKey(record.Seller, record.Tick))
-				signatureSet.Add(record.SellerSign)
-			}
-
-		// 解冻
-		case *protocol.UnfreezeSellCommand:
-			for _, record := range t.Records {
-				signatureSet.Add(record.Sign)
-				unfreezeSignSet.Add(record.Sign)
-			}
-
-		// 结算
-		case *protocol.ProxyTransferCommand:
-			for _, record := range t.Records {
-				tickSet.Add(record.Tick)
-				balanceSet.Add(balance.NewBalanceKey(record.From, record.Tick))
-				balanceSet.Add(balance.NewBalanceKey(record.To, record.Tick))
-				signatureSet.Add(record.Sign)
-			}
-
-		// 结算v4
-		case *protocol.ProxyTransferCommandV4:
-			for _, record := range t.Records {
-				tickSet.Add(record.Tick)
-				balanceSet.Add(balance.NewBalanceKey(record.From, record.Tick))
-				balanceSet.Add(balance.NewBalanceKey(record.To, record.Tick))
-				signatureSet.Add(record.Sign)
-			}
-
-		// 配置质押池
-		case *protocol.ConfigStakeCommand:
-			for _, record := range t.Details {
-				tickSet.Add(record.Tick)
-			}
-
-		// 质押
-		case *protocol.StakingCommand:
-			for _, record := range t.Details {
-				tickSet.Add(record.Tick)
-				balanceSet.Add(balance.NewBalanceKey(record.Pool, record.Tick))
-				balanceSet.Add(balance.NewBalanceKey(record.Staker, record.Tick))
-			}
-
-		default:
+		// 由协议模块收集交易依赖的状态
+		if !b.collectDependencies(transaction.IERCTransaction, deps) {
 			transaction.Code = int32(protocol.InvalidProtocolParams)
 			transaction.Remark = "invalid operate"
 			transaction.IsProcessed = true
@@ -255,17 +219,30 @@ loop:
 	eg, gCtx := errgroup.WithContext(ctx)
 	// 加载 tick 信息
 	eg.Go(func() error {
-		return b.loadTicks(gCtx, aggregate, tickSet.ToSlice())
+		return b.loadTicks(gCtx, aggregate, deps.Ticks.ToSlice())
 	})
 
 	// 加载持仓信息
 	eg.Go(func() error {
-		return b.loadBalances(gCtx, aggregate, balanceSet.ToSlice())
+		return b.loadBalances(gCtx, aggregate, deps.Balances.ToSlice())
+	})
+
+	// 加载模块自有的状态
+	for _, state := range states {
+		state := state
+		eg.Go(func() error {
+			return state.Load(gCtx)
+		})
+	}
+
+	// 并发预校验签名
+	eg.Go(func() error {
+		return b.preverifySignatures(gCtx, block)
 	})
 
 	// 加载签名相关的成功事件, 对于一个签名，只加载最后成功的那个事件
 	eg.Go(func() error {
-		return b.loadEventsBySignature(gCtx, aggregate, signatureSet.ToSlice())
+		return b.loadEventsBySignature(gCtx, aggregate, deps.Signatures.ToSlice())
 	})
 
 	// 等待并发执行完成
@@ -274,13 +251,68 @@ loop:
 	}
 
 	// 补全 unfreeze 事件相关的数据, 必须在上面这几个并发查询之后
-	if err := b.loadUnfreezeEventRelatedData(ctx, aggregate, unfreezeSignSet); err != nil {
+	if err := b.loadUnfreezeEventRelatedData(ctx, aggregate, deps.UnfreezeSigns); err != nil {
 		return nil, err
 	}
 
 	return aggregate, nil
 }
 
+// 预校验签名. 签名恢复是区块处理中最耗时的部分, 在预处理阶段与加载数据并发执行,
+// 校验结果按 (消息哈希, 签名) 缓存, 聚合根处理交易时直接命中缓存.
+// 这里只负责预热缓存, 校验失败不返回错误, 由聚合根按原有逻辑处理
+func (b *BlockService) preverifySignatures(ctx context.Context, block *domain.Block) error {
+	eg, gCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(runtime.NumCPU())
+
+	var count int
+	for _, transaction := range block.Transactions {
+		if transaction.IsProcessed || transaction.IERCTransaction == nil {
+			continue
+		}
+
+		for _, check := range protocol.SignatureChecks(transaction.IERCTransaction) {
+			if gCtx.Err() != nil {
+				return gCtx.Err()
+			}
+
+			check := check
+			eg.Go(func() error {
+				_ = check()
+				return nil
+			})
+			count++
+		}
+	}
+
+	if count != 0 {
+		b.logger.Debugf("preverify signatures. block_number: %d, signatures: %d", block.Number, count)
+	}
+
+	return eg.Wait()
+}
+
+// 收集交易依赖的状态, 没有协议模块支持的交易返回 false
+func (b *BlockService) collectDependencies(tx protocol.IERCTransaction, deps *domain.Dependencies) bool {
+	for _, handler := range b.handlers {
+		if handler.CollectDependencies(tx, deps) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// 创建当前区块的模块状态
+func (b *BlockService) newModuleStates() domain.ModuleStates {
+	var states = make(domain.ModuleStates, 0, len(b.stateFactories))
+	for _, factory := range b.stateFactories {
+		states = append(states, factory.NewState())
+	}
+
+	return states
+}
+
 // 加载Tick
 func (b *BlockService) loadTicks(ctx context.Context, root *domain.AggregateRoot, names []string) error {
 	// 先检查是否已存在
@@ -422,6 +454,9 @@ func (b *BlockService) saveToDBWithTx(ctx context.Context, root *domain.Aggregat
 		needUpdateTicks    = make([]tick.Tick, 0, len(root.TicksMap))
 		needUpdateBalances = make([]*balance.Balance, 0, len(root.BalancesMap))
 		pools              = poolsMapToSlice(root.StakingPools)
+		rewardsRecords     = collectRewardsRecords(pools)
+		miningStats        = miningStatsMapToSlice(root.MiningStats)
+		needUpdateOrders   = make([]*order.Order, 0, len(root.Orders))
 	)
 
 	// 统计需要更新的 tick
@@ -442,15 +477,55 @@ func (b *BlockService) saveToDBWithTx(ctx context.Context, root *domain.Aggregat
 		needUpdateBalances = append(needUpdateBalances, entity)
 	}
 
+	// 统计需要更新的订单, 按挂单顺序保存
+	for _, entity := range root.Orders {
+		if entity.LastUpdatedBlock < root.Block.Number {
+			continue
+		}
+
+		needUpdateOrders = append(needUpdateOrders, entity)
+	}
+	sort.Slice(needUpdateOrders, func(i, j int) bool {
+		if needUpdateOrders[i].ListBlock != needUpdateOrders[j].ListBlock {
+			return needUpdateOrders[i].ListBlock < needUpdateOrders[j].ListBlock
+		}
+		return needUpdateOrders[i].OrderID < needUpdateOrders[j].OrderID
+	})
+
+	// 将成交合并到K线
+	candles, err := b.mergeCandles(ctx, root)
+	if err != nil {
+		return err
+	}
+
+	// 将余额变化合并到持仓统计
+	holderStats, err := b.mergeHolderStats(ctx, root)
+	if err != nil {
+		return err
+	}
+
+	event := &domain.EventsByBlock{BlockNumber: root.Block.Number, Events: root.Events}
+
+	// 区块事件写入发件箱, 与区块数据在同一个事务中提交, 由 OutboxRelay 发布到消息队列.
+	// 没有发布方时不写入, 避免消息堆积
+	var messages []*outbox.Message
+	if b.outboxEnabled && len(event.Events) != 0 {
+		payload, err := marshalEventsByBlock(event)
+		if err != nil {
+			return err
+		}
+
+		messages = append(messages, &outbox.Message{Topic: outbox.TopicEvents, BlockNumber: event.BlockNumber, Payload: payload})
+	}
+
 	// 开启一个事务进行持久化保存
-	err := b.transactionRepo.TransactionSave(ctx, func(ctxWithTx context.Context) error {
+	err = b.transactionRepo.TransactionSave(ctx, func(ctxWithTx context.Context) error {
 		// 更新区块信息
 		if err := b.blockRepo.Update(ctxWithTx, root.Block); err != nil {
 			return err
 		}
 
 		// 更新事件
-		event := &domain.EventsByBlock{BlockNumber: root.Block.Number, Events: root.Events}
 		if err := b.eventRepo.Save(ctxWithTx, event); err != nil {
 			return err
 		}
@@ -465,26 +540,140 @@ func (b *BlockService) saveToDBWithTx(ctx context.Context, root *domain.Aggregat
 			return err
 		}
 
+		// 更新模块自有的状态
+		for _, state := range root.States {
+			if err := state.Save(ctxWithTx, root.Block.Number); err != nil {
+				return err
+			}
+		}
+
 		// 更新质押池信息
 		if err := b.stakingRepo.Save(ctxWithTx, root.Block.Number, pools...); err != nil {
 			return err
 		}
 
+		// 保存奖励变更记录
+		if err := b.rewardsRepo.Save(ctxWithTx, rewardsRecords...); err != nil {
+			return err
+		}
+
+		// 保存挖矿统计
+		if err := b.miningRepo.Save(ctxWithTx, miningStats...); err != nil {
+			return err
+		}
+
+		// 更新订单
+		if err := b.orderRepo.Save(ctxWithTx, needUpdateOrders...); err != nil {
+			return err
+		}
+
+		// 保存成交记录
+		if err := b.tradeRepo.Save(ctxWithTx, root.Trades...); err != nil {
+			return err
+		}
+
+		// 更新K线
+		if err := b.candleRepo.Save(ctxWithTx, candles...); err != nil {
+			return err
+		}
+
+		// 更新持仓统计
+		if err := b.holderRepo.SaveStats(ctxWithTx, holderStats...); err != nil {
+			return err
+		}
+
+		// 写入发件箱
+		if err := b.outboxRepo.Save(ctxWithTx, messages...); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
+	// 奖励记录已经保存, 清空
+	for _, pool := range pools {
+		pool.ClearRewardsRecords()
+	}
+
 	// 更新数据缓存
 	return b.transactionRepo.UpdateCache(ctx, func(ctxWithUpdateKind context.Context) error {
+		_ = b.eventRepo.Save(ctxWithUpdateKind, event)                         // 事务提交后推送事件
 		_ = b.tickRepo.Save(ctxWithUpdateKind, needUpdateTicks...)             // 更新tick缓存
 		_ = b.balanceRepo.Save(ctxWithUpdateKind, needUpdateBalances...)       // 更新balance缓存
 		_ = b.stakingRepo.Save(ctxWithUpdateKind, root.Block.Number, pools...) // 更新质押池缓存
+		_ = b.holderRepo.SaveStats(ctxWithUpdateKind, holderStats...)          // 更新持仓统计缓存
+		_ = b.miningRepo.Save(ctxWithUpdateKind, miningStats...)               // 事务提交后推送挖矿统计
 		return nil
 	})
 }
 
+// 加载成交涉及的K线, 并合并当前区块的成交
+func (b *BlockService) mergeCandles(ctx context.Context, root *domain.AggregateRoot) ([]*trade.Candle, error) {
+	if len(root.Trades) == 0 {
+		return nil, nil
+	}
+
+	existing, err := b.candleRepo.Load(ctx, trade.CandleKeys(root.Trades)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return trade.MergeCandles(root.Block.Number, existing, root.Trades), nil
+}
+
+// 加载余额变化涉及的持仓统计, 并合并当前区块的余额变化
+func (b *BlockService) mergeHolderStats(ctx context.Context, root *domain.AggregateRoot) ([]*holder.Stats, error) {
+	changes := root.BalanceChanges()
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	existing, err := b.holderRepo.LoadStats(ctx, holder.ChangedTicks(changes)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 区块时间以事件时间为准
+	blockTime := root.Block.CreatedAt
+	if len(root.Events) != 0 {
+		blockTime = root.Events[0].GetEventAt()
+	}
+
+	return holder.MergeStats(root.Block.Number, blockTime, existing, changes), nil
+}
+
+func convertSignatureDomains(domains []*conf.SignatureDomain) []protocol.SignatureDomain {
+	var result = make([]protocol.SignatureDomain, 0, len(domains))
+	for _, domain := range domains {
+		result = append(result, protocol.SignatureDomain{
+			Name:              domain.Name,
+			Version:           domain.Version,
+			ChainID:           domain.ChainId,
+			VerifyingContract: domain.VerifyingContract,
+			StartBlock:        domain.StartBlock,
+			EndBlock:          domain.EndBlock,
+		})
+	}
+
+	return result
+}
+
+func miningStatsMapToSlice(statsMap map[string]*mining.BlockStats) []*mining.BlockStats {
+	var result = make([]*mining.BlockStats, 0, len(statsMap))
+	for _, stats := range statsMap {
+		result = append(result, stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Tick < result[j].Tick
+	})
+
+	return result
+}
+
 func poolsMapToSlice(poolsMap map[string]*staking.PoolAggregate) []*staking.PoolAggregate {
 	var result = make([]*staking.PoolAggregate, 0, len(poolsMap))
 	for _, root := range poolsMap {
@@ -493,3 +682,17 @@ func poolsMapToSlice(poolsMap map[string]*staking.PoolAggregate) []*staking.Pool
 
 	return result
 }
+
+// 所有质押池在当前区块产生的奖励记录, 按池子地址排序. 事务提交后才清空
+func collectRewardsRecords(pools []*staking.PoolAggregate) []*staking.RewardsRecord {
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].PoolAddress < pools[j].PoolAddress
+	})
+
+	var records []*staking.RewardsRecord
+	for _, pool := range pools {
+		records = append(records, pool.RewardsRecords()...)
+	}
+
+	return records
+}
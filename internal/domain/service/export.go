@@ -0,0 +1,42 @@
+package service
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+)
+
+// 对外导出的事件格式, 用于回调推送和发件箱消息
+type exportedEvent struct {
+	Kind domain.EventKind `json:"kind"`
+	Name string           `json:"name"`
+	Data domain.Event     `json:"data"`
+}
+
+func newExportedEvents(events []domain.Event) []exportedEvent {
+	var result = make([]exportedEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, exportedEvent{
+			Kind: event.GetEventKind(),
+			Name: strings.TrimPrefix(event.EventName(), "*domain."),
+			Data: event,
+		})
+	}
+	return result
+}
+
+// 发件箱中区块事件的消息体
+type exportedBlock struct {
+	BlockNumber uint64          `json:"block_number"`
+	PrevBlock   uint64          `json:"prev_block"` // 上一个有事件的区块, 接收方可以用于检验是否有区块缺失
+	Events      []exportedEvent `json:"events"`
+}
+
+func marshalEventsByBlock(block *domain.EventsByBlock) ([]byte, error) {
+	return json.Marshal(&exportedBlock{
+		BlockNumber: block.BlockNumber,
+		PrevBlock:   block.PreviousBlock(),
+		Events:      newExportedEvents(block.Events),
+	})
+}
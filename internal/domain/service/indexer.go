@@ -31,9 +31,8 @@ type IndexDomainService struct {
 	handleEndBlock uint64             // 处理结束区块
 	handleQueue    chan *domain.Block // 处理队列
 
-	invalidHashMap map[string]struct{} // 无效交易Hash. 来自配置文件
-	feeStartBlock  uint64
-	status         *domain.BlockHandleStatus
+	feeStartBlock uint64
+	status        *domain.BlockHandleStatus
 
 	log log.Logger
 }
@@ -62,7 +61,6 @@ func NewIndexApplication(
 		enableHandle:   data.Runtime.EnableHandle,
 		handleEndBlock: data.Runtime.HandleEndBlock,
 		handleQueue:    make(chan *domain.Block, data.Runtime.HandleQueueSize),
-		invalidHashMap: data.InvalidTxHash,
 		feeStartBlock:  data.Runtime.GetFeeStartBlock(),
 		status:         new(domain.BlockHandleStatus),
 		log:            log,
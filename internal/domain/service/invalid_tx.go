@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+)
+
+var (
+	ErrInvalidTxHash         = errors.New("invalid tx hash")
+	ErrInvalidEffectiveBlock = errors.New("invalid effective block")
+	ErrLoadInvalidTxFile     = errors.New("load invalid tx file failed")
+)
+
+// 无效交易服务. 管理无效交易列表, 启动时从配置文件导入数据库, 运行时可以通过管理接口增删和重新加载.
+// 新增的记录只对还未处理的区块生效, 已经处理过的区块不受影响, 保证重放时结果一致
+type InvalidTxService struct {
+	logger    *log.Helper
+	repo      domain.InvalidTxRepository
+	blockRepo domain.BlockRepository
+	path      string // 无效交易Hash文件
+
+	mu      sync.RWMutex                        // 修改操作串行执行, 并且不能和区块处理同时进行
+	current atomic.Pointer[domain.InvalidTxSet] // 当前生效的无效交易快照
+}
+
+func NewInvalidTxService(
+	c *conf.Config,
+	logger log.Logger,
+	repo domain.InvalidTxRepository,
+	blockRepo domain.BlockRepository,
+) (*InvalidTxService, error) {
+	srv := &InvalidTxService{
+		logger:    log.NewHelper(log.With(logger, "module", "InvalidTxService")),
+		repo:      repo,
+		blockRepo: blockRepo,
+		path:      c.Runtime.GetInvalidTxHashPath(),
+	}
+
+	ctx := context.Background()
+	if err := srv.load(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := srv.importRecords(ctx, c.InvalidTxHash); err != nil {
+		return nil, err
+	}
+
+	return srv, nil
+}
+
+// 获取当前的无效交易快照. 快照只读, 处理一个区块时应该始终使用同一个快照
+func (srv *InvalidTxService) Snapshot() domain.InvalidTxSet {
+	if set := srv.current.Load(); set != nil {
+		return *set
+	}
+	return nil
+}
+
+// 获取当前的无效交易快照, 并阻止修改直到调用 release.
+// 处理区块时从预处理到保存完成都需要持有, 避免处理期间新增的记录因为生效区块检查已经通过而被跳过
+func (srv *InvalidTxService) Hold() (set domain.InvalidTxSet, release func()) {
+	srv.mu.RLock()
+	return srv.Snapshot(), srv.mu.RUnlock
+}
+
+// 查询无效交易, category 为空时返回全部. 按生效区块、交易hash排序
+func (srv *InvalidTxService) List(category string) []*domain.InvalidTx {
+	var entries []*domain.InvalidTx
+	for _, entry := range srv.Snapshot() {
+		if category != "" && entry.Category != category {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].EffectiveBlock != entries[j].EffectiveBlock {
+			return entries[i].EffectiveBlock < entries[j].EffectiveBlock
+		}
+		return entries[i].TxHash < entries[j].TxHash
+	})
+
+	return entries
+}
+
+// 新增无效交易. 未指定生效区块时, 从下一个未同步的区块开始生效.
+// 指定的生效区块必须大于已经处理的区块. 已经存在的交易hash会被忽略, 返回实际新增的数量
+func (srv *InvalidTxService) Add(ctx context.Context, entries ...*domain.InvalidTx) (int64, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	minBlock, err := srv.unhandledBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	nextBlock, err := srv.unindexedBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range entries {
+		entry.TxHash = strings.ToLower(strings.TrimSpace(entry.TxHash))
+		if entry.TxHash == "" {
+			return 0, ErrInvalidTxHash
+		}
+
+		if entry.EffectiveBlock == 0 {
+			entry.EffectiveBlock = nextBlock
+		}
+
+		if entry.EffectiveBlock < minBlock {
+			return 0, fmt.Errorf("%w: block %d has been handled. tx_hash: %s", ErrInvalidEffectiveBlock, entry.EffectiveBlock, entry.TxHash)
+		}
+	}
+
+	created, err := srv.repo.Create(ctx, entries...)
+	if err != nil {
+		return 0, err
+	}
+
+	srv.logger.Infof("add invalid tx. count: %d, created: %d", len(entries), created)
+	return created, srv.load(ctx)
+}
+
+// 删除无效交易. 只允许删除还未生效的记录, 已经生效的记录删除后会导致重放结果不一致
+func (srv *InvalidTxService) Remove(ctx context.Context, hashes ...string) error {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	minBlock, err := srv.unhandledBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		current = srv.Snapshot()
+		deletes = make([]string, 0, len(hashes))
+	)
+	for _, hash := range hashes {
+		hash = strings.ToLower(strings.TrimSpace(hash))
+		entry, existed := current[hash]
+		if !existed {
+			continue
+		}
+
+		if entry.EffectiveBlock < minBlock {
+			return fmt.Errorf("%w: tx hash has taken effect at block %d. tx_hash: %s", ErrInvalidEffectiveBlock, entry.EffectiveBlock, hash)
+		}
+
+		deletes = append(deletes, hash)
+	}
+
+	if err := srv.repo.Delete(ctx, deletes...); err != nil {
+		return err
+	}
+
+	srv.logger.Infof("remove invalid tx. count: %d", len(deletes))
+	return srv.load(ctx)
+}
+
+// 重新加载. 先导入配置文件中新增的记录, 再从数据库加载全部记录. 返回从文件新增的数量
+func (srv *InvalidTxService) Reload(ctx context.Context) (int64, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if err := srv.load(ctx); err != nil {
+		return 0, err
+	}
+
+	srv.logger.Infof("reload invalid tx hash. path: %s", srv.path)
+	records, err := conf.LoadInvalidHashFile(srv.path)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrLoadInvalidTxFile, err)
+	}
+
+	return srv.importRecords(ctx, records)
+}
+
+// 导入配置文件中的记录, 数据库中已经存在的记录保持不变.
+// 数据库为空时视为首次导入(兼容老系统), 从第0个区块开始生效; 否则从下一个未同步的区块开始生效
+func (srv *InvalidTxService) importRecords(ctx context.Context, records map[string][]string) (int64, error) {
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	var (
+		current        = srv.Snapshot()
+		effectiveBlock uint64
+	)
+	if len(current) != 0 {
+		block, err := srv.unindexedBlock(ctx)
+		if err != nil {
+			return 0, err
+		}
+		effectiveBlock = block
+	}
+
+	// 按分类排序, 保证同一个hash出现在多个分类时导入结果一致
+	var categories = make([]string, 0, len(records))
+	for category := range records {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	var (
+		entries []*domain.InvalidTx
+		seen    = make(map[string]struct{})
+	)
+	for _, category := range categories {
+		for _, hash := range records[category] {
+			hash = strings.ToLower(strings.TrimSpace(hash))
+			if _, existed := current[hash]; existed {
+				continue
+			}
+
+			if _, existed := seen[hash]; existed {
+				srv.logger.Infof("repeat hash: %s", hash)
+				continue
+			}
+			seen[hash] = struct{}{}
+
+			entries = append(entries, &domain.InvalidTx{
+				TxHash:         hash,
+				Category:       strings.TrimSpace(category),
+				Reason:         "import from config file",
+				EffectiveBlock: effectiveBlock,
+			})
+		}
+	}
+
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	created, err := srv.repo.Create(ctx, entries...)
+	if err != nil {
+		return 0, err
+	}
+
+	srv.logger.Infof("import invalid tx hash. created: %d, effective_block: %d", created, effectiveBlock)
+	return created, srv.load(ctx)
+}
+
+// 从数据库加载全部记录, 替换当前快照
+func (srv *InvalidTxService) load(ctx context.Context) error {
+	entries, err := srv.repo.LoadAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	set := domain.NewInvalidTxSet(entries...)
+	srv.current.Store(&set)
+	return nil
+}
+
+// 第一个还未处理的区块
+func (srv *InvalidTxService) unhandledBlock(ctx context.Context) (uint64, error) {
+	block, err := srv.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil || block == nil {
+		return 0, err
+	}
+
+	return block.Number + 1, nil
+}
+
+// 第一个还未同步的区块. 从这个区块开始生效时, 不会影响已经在处理队列中的区块
+func (srv *InvalidTxService) unindexedBlock(ctx context.Context) (uint64, error) {
+	block, err := srv.blockRepo.GetLastIndexedBlock(ctx)
+	if err != nil || block == nil {
+		return 0, err
+	}
+
+	return block.Number + 1, nil
+}
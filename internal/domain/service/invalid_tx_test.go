@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestInvalidTxService(t *testing.T) {
+	suite.Run(t, new(TestInvalidTxServiceSuite))
+}
+
+type TestInvalidTxServiceSuite struct {
+	suite.Suite
+	repo      *mockInvalidTxRepo
+	blockRepo *mockBlockRepo
+	srv       *InvalidTxService
+}
+
+func (s *TestInvalidTxServiceSuite) SetupTest() {
+	s.repo = &mockInvalidTxRepo{entries: make(map[string]*domain.InvalidTx)}
+	s.blockRepo = &mockBlockRepo{handled: 100, indexed: 200}
+
+	srv, err := NewInvalidTxService(&conf.Config{
+		Bootstrap: &conf.Bootstrap{Runtime: &conf.Runtime{}},
+		InvalidTxHash: map[string][]string{
+			"freeze_sell": {"0xAA", "0xbb"},
+			"transfer":    {"0xbb"},
+		},
+	}, log.DefaultLogger, s.repo, s.blockRepo)
+	s.NoError(err)
+	s.srv = srv
+}
+
+func (s *TestInvalidTxServiceSuite) TestImport() {
+	set := s.srv.Snapshot()
+	s.Len(set, 2)
+
+	// 首次导入从第0个区块开始生效, 重复的hash以排序后的第一个分类为准
+	s.True(set.Contains("0xaa", 0))
+	s.Equal("freeze_sell", set["0xbb"].Category)
+	s.Len(s.srv.List("transfer"), 0)
+}
+
+func (s *TestInvalidTxServiceSuite) TestAdd() {
+	ctx := context.Background()
+
+	created, err := s.srv.Add(ctx, &domain.InvalidTx{TxHash: "0xCC"})
+	s.NoError(err)
+	s.EqualValues(1, created)
+
+	// 未指定生效区块时, 从下一个未同步的区块开始生效
+	set := s.srv.Snapshot()
+	s.False(set.Contains("0xcc", 200))
+	s.True(set.Contains("0xcc", 201))
+
+	// 已经处理过的区块不允许生效
+	_, err = s.srv.Add(ctx, &domain.InvalidTx{TxHash: "0xdd", EffectiveBlock: 100})
+	s.ErrorIs(err, ErrInvalidEffectiveBlock)
+
+	_, err = s.srv.Add(ctx, &domain.InvalidTx{TxHash: " "})
+	s.ErrorIs(err, ErrInvalidTxHash)
+
+	// 已经存在的hash忽略
+	created, err = s.srv.Add(ctx, &domain.InvalidTx{TxHash: "0xaa", EffectiveBlock: 150})
+	s.NoError(err)
+	s.EqualValues(0, created)
+	s.EqualValues(0, s.srv.Snapshot()["0xaa"].EffectiveBlock)
+}
+
+func (s *TestInvalidTxServiceSuite) TestRemove() {
+	ctx := context.Background()
+
+	_, err := s.srv.Add(ctx, &domain.InvalidTx{TxHash: "0xcc", EffectiveBlock: 101})
+	s.NoError(err)
+
+	// 已经生效的记录不允许删除
+	s.ErrorIs(s.srv.Remove(ctx, "0xaa"), ErrInvalidEffectiveBlock)
+
+	s.NoError(s.srv.Remove(ctx, "0xcc", "0xee"))
+	s.NotContains(s.srv.Snapshot(), "0xcc")
+	s.Contains(s.srv.Snapshot(), "0xaa")
+}
+
+type mockInvalidTxRepo struct {
+	entries map[string]*domain.InvalidTx
+}
+
+func (m *mockInvalidTxRepo) LoadAll(_ context.Context) ([]*domain.InvalidTx, error) {
+	var entries = make([]*domain.InvalidTx, 0, len(m.entries))
+	for _, entry := range m.entries {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (m *mockInvalidTxRepo) Create(_ context.Context, entries ...*domain.InvalidTx) (int64, error) {
+	var created int64
+	for _, entry := range entries {
+		if _, existed := m.entries[entry.TxHash]; existed {
+			continue
+		}
+		m.entries[entry.TxHash] = entry
+		created++
+	}
+	return created, nil
+}
+
+func (m *mockInvalidTxRepo) Delete(_ context.Context, hashes ...string) error {
+	for _, hash := range hashes {
+		delete(m.entries, hash)
+	}
+	return nil
+}
+
+type mockBlockRepo struct {
+	domain.BlockRepository
+	handled uint64
+	indexed uint64
+}
+
+func (m *mockBlockRepo) GetLastHandleBlock(_ context.Context) (*domain.BlockHeader, error) {
+	return &domain.BlockHeader{Number: m.handled}, nil
+}
+
+func (m *mockBlockRepo) GetLastIndexedBlock(_ context.Context) (*domain.BlockHeader, error) {
+	return &domain.BlockHeader{Number: m.indexed}, nil
+}
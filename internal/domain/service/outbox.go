@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// 每次加载的未发布消息数量
+	outboxRelayBatchSize = 100
+	// 检查未发布消息的间隔, 发布失败后也按该间隔重试
+	outboxRelayInterval = time.Second
+	// 已发布消息的保留时间
+	outboxRetention = time.Hour * 24
+	// 清理已发布消息的间隔
+	outboxPurgeInterval = time.Hour
+)
+
+// 发件箱中继. 按写入顺序将发件箱中的消息发布到所有的发布方, 全部成功后标记为已发布.
+// 消息与区块数据在同一个事务中写入, 提交后进程崩溃也不会丢失, 重启后继续发布(至少一次, 接收方以消息ID去重).
+// 每一轮发布都持有数据库中的中继锁, 多个实例同时运行时只有一个在发布, 保证消息顺序.
+// 只在开启区块处理并且配置了发布方的实例中运行, 没有发布方时区块处理也不会写入发件箱
+type OutboxRelay struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	eg     *errgroup.Group
+
+	logger     *log.Helper
+	repo       outbox.OutboxRepository
+	publishers []outbox.Publisher
+	enabled    bool
+}
+
+func NewOutboxRelay(c *conf.Config, logger log.Logger, repo outbox.OutboxRepository, publishers []outbox.Publisher) *OutboxRelay {
+	ctx, cancel := context.WithCancel(context.Background())
+	eg, gCtx := errgroup.WithContext(ctx)
+
+	return &OutboxRelay{
+		ctx:        gCtx,
+		cancel:     cancel,
+		eg:         eg,
+		logger:     log.NewHelper(log.With(logger, "module", "OutboxRelay")),
+		repo:       repo,
+		publishers: publishers,
+		enabled:    c.Runtime.EnableHandle,
+	}
+}
+
+func (r *OutboxRelay) Start(_ context.Context) error {
+	if !r.enabled {
+		return nil
+	}
+
+	if len(r.publishers) == 0 {
+		r.logger.Info("no outbox publishers, outbox relay disabled")
+		return nil
+	}
+
+	r.logger.Infof("start outbox relay. publishers: %d", len(r.publishers))
+	defer r.logger.Info("quit outbox relay...")
+
+	r.eg.Go(r.relayLoop)
+	r.eg.Go(r.purgeLoop)
+	return r.eg.Wait()
+}
+
+func (r *OutboxRelay) Stop(_ context.Context) error {
+	r.cancel()
+	return r.eg.Wait()
+}
+
+func (r *OutboxRelay) relayLoop() error {
+	ticker := time.NewTicker(outboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := r.relay(r.ctx); err != nil && r.ctx.Err() == nil {
+			r.logger.Errorf("relay outbox messages failed. error: %s", err)
+		}
+	}
+}
+
+// 持有中继锁发布消息. 其他实例正在发布时跳过本轮
+func (r *OutboxRelay) relay(ctx context.Context) error {
+	err := r.repo.WithRelayLock(ctx, r.relayPending)
+	if errors.Is(err, outbox.ErrRelayLocked) {
+		return nil
+	}
+	return err
+}
+
+// 发布所有未发布的消息. 任意发布方失败时停止, 下一轮从失败的批次重试, 保证消息顺序
+func (r *OutboxRelay) relayPending(ctx context.Context) error {
+	for {
+		messages, err := r.repo.FetchPending(ctx, outboxRelayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		for _, publisher := range r.publishers {
+			if err := publisher.Publish(ctx, messages); err != nil {
+				r.logger.Warnf("publish outbox messages failed. publisher: %s, first: %d, error: %s", publisher.Name(), messages[0].ID, err)
+				return err
+			}
+		}
+
+		var ids = make([]int64, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+
+		if err := r.repo.MarkPublished(ctx, ids, time.Now()); err != nil {
+			return err
+		}
+
+		if len(messages) < outboxRelayBatchSize {
+			return nil
+		}
+	}
+}
+
+func (r *OutboxRelay) purgeLoop() error {
+	ticker := time.NewTicker(outboxPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		purged, err := r.repo.Purge(r.ctx, time.Now().Add(-outboxRetention))
+		if err != nil {
+			if r.ctx.Err() == nil {
+				r.logger.Errorf("purge outbox messages failed. error: %s", err)
+			}
+			continue
+		}
+
+		if purged != 0 {
+			r.logger.Infof("purge outbox messages. count: %d", purged)
+		}
+	}
+}
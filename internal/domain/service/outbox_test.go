@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestOutboxRelay(t *testing.T) {
+	suite.Run(t, new(TestOutboxRelaySuite))
+}
+
+type TestOutboxRelaySuite struct {
+	suite.Suite
+	repo      *mockOutboxRepo
+	publisher *mockOutboxPublisher
+	relay     *OutboxRelay
+}
+
+func (s *TestOutboxRelaySuite) SetupTest() {
+	s.repo = &mockOutboxRepo{}
+	s.publisher = &mockOutboxPublisher{}
+	s.relay = NewOutboxRelay(
+		&conf.Config{Bootstrap: &conf.Bootstrap{Runtime: &conf.Runtime{}}},
+		log.DefaultLogger,
+		s.repo,
+		[]outbox.Publisher{s.publisher},
+	)
+}
+
+func (s *TestOutboxRelaySuite) save(numbers ...uint64) {
+	for _, number := range numbers {
+		s.NoError(s.repo.Save(context.Background(), &outbox.Message{Topic: outbox.TopicEvents, BlockNumber: number}))
+	}
+}
+
+func (s *TestOutboxRelaySuite) published() []uint64 {
+	var numbers []uint64
+	for _, message := range s.publisher.Messages() {
+		numbers = append(numbers, message.BlockNumber)
+	}
+	return numbers
+}
+
+func (s *TestOutboxRelaySuite) TestRelay() {
+	ctx := context.Background()
+
+	for number := uint64(1); number <= outboxRelayBatchSize+10; number++ {
+		s.save(number)
+	}
+
+	s.NoError(s.relay.relay(ctx))
+	s.Len(s.published(), outboxRelayBatchSize+10)
+	s.Equal(uint64(1), s.published()[0])
+
+	pending, err := s.repo.FetchPending(ctx, outboxRelayBatchSize)
+	s.NoError(err)
+	s.Empty(pending)
+
+	// 重复写入的区块忽略
+	s.save(1)
+	s.NoError(s.relay.relay(ctx))
+	s.Len(s.published(), outboxRelayBatchSize+10)
+}
+
+func (s *TestOutboxRelaySuite) TestRetryAfterFailure() {
+	ctx := context.Background()
+	s.save(1, 2)
+
+	// 发布失败时不标记, 下一轮从失败的消息开始重试
+	s.publisher.SetError(errors.New("broker unavailable"))
+	s.Error(s.relay.relay(ctx))
+	s.Empty(s.published())
+
+	s.save(3)
+	s.publisher.SetError(nil)
+	s.NoError(s.relay.relay(ctx))
+	s.Equal([]uint64{1, 2, 3}, s.published())
+}
+
+func (s *TestOutboxRelaySuite) TestPurge() {
+	ctx := context.Background()
+	s.save(1, 2)
+	s.NoError(s.relay.relay(ctx))
+	s.save(3)
+
+	purged, err := s.repo.Purge(ctx, time.Now().Add(time.Second))
+	s.NoError(err)
+	s.EqualValues(2, purged)
+
+	// 未发布的消息不清理
+	s.NoError(s.relay.relay(ctx))
+	s.Equal([]uint64{1, 2, 3}, s.published())
+}
+
+func (s *TestOutboxRelaySuite) TestRelayLocked() {
+	ctx := context.Background()
+	s.save(1, 2)
+
+	// 其他实例持有中继锁时跳过本轮
+	s.repo.locked = true
+	s.NoError(s.relay.relay(ctx))
+	s.Empty(s.published())
+
+	s.repo.locked = false
+	s.NoError(s.relay.relay(ctx))
+	s.Equal([]uint64{1, 2}, s.published())
+}
+
+func (s *TestOutboxRelaySuite) TestNoPublishers() {
+	relay := NewOutboxRelay(
+		&conf.Config{Bootstrap: &conf.Bootstrap{Runtime: &conf.Runtime{EnableHandle: true}}},
+		log.DefaultLogger,
+		s.repo,
+		nil,
+	)
+
+	// 没有发布方时不启动
+	s.NoError(relay.Start(context.Background()))
+	s.NoError(relay.Stop(context.Background()))
+}
+
+func (s *TestOutboxRelaySuite) TestMarshalEventsByBlock() {
+	payload, err := marshalEventsByBlock(&domain.EventsByBlock{
+		BlockNumber: 101,
+		Events:      []domain.Event{&domain.IERC20TransferredEvent{BlockNumber: 101, PrevBlockNumber: 99}},
+	})
+	s.NoError(err)
+
+	var data struct {
+		BlockNumber uint64 `json:"block_number"`
+		PrevBlock   uint64 `json:"prev_block"`
+		Events      []struct {
+			Name string `json:"name"`
+		} `json:"events"`
+	}
+	s.NoError(json.Unmarshal(payload, &data))
+	s.Equal(uint64(101), data.BlockNumber)
+	s.Equal(uint64(99), data.PrevBlock)
+	s.Len(data.Events, 1)
+	s.Equal("IERC20Transferred", data.Events[0].Name)
+}
+
+// 内存中的发件箱, 按写入顺序分配ID
+type mockOutboxRepo struct {
+	messages []*outbox.Message
+	lastID   int64
+	locked   bool // 中继锁被其他实例持有
+}
+
+func (m *mockOutboxRepo) Save(_ context.Context, messages ...*outbox.Message) error {
+	for _, message := range messages {
+		if slices.ContainsFunc(m.messages, func(item *outbox.Message) bool {
+			return item.Topic == message.Topic && item.BlockNumber == message.BlockNumber
+		}) {
+			continue
+		}
+
+		m.lastID++
+		message.ID = m.lastID
+		m.messages = append(m.messages, message)
+	}
+	return nil
+}
+
+func (m *mockOutboxRepo) FetchPending(_ context.Context, limit int) ([]*outbox.Message, error) {
+	var result []*outbox.Message
+	for _, message := range m.messages {
+		if message.PublishedAt.IsZero() && len(result) < limit {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockOutboxRepo) MarkPublished(_ context.Context, ids []int64, now time.Time) error {
+	for _, message := range m.messages {
+		if slices.Contains(ids, message.ID) {
+			message.PublishedAt = now
+		}
+	}
+	return nil
+}
+
+func (m *mockOutboxRepo) Purge(_ context.Context, before time.Time) (int64, error) {
+	var (
+		remain = make([]*outbox.Message, 0, len(m.messages))
+		purged int64
+	)
+	for _, message := range m.messages {
+		if !message.PublishedAt.IsZero() && message.PublishedAt.Before(before) {
+			purged++
+			continue
+		}
+		remain = append(remain, message)
+	}
+
+	m.messages = remain
+	return purged, nil
+}
+
+func (m *mockOutboxRepo) WithRelayLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.locked {
+		return outbox.ErrRelayLocked
+	}
+	return fn(ctx)
+}
+
+// 内存发布方, 保存发布的所有消息
+type mockOutboxPublisher struct {
+	mutex    sync.Mutex
+	messages []*outbox.Message
+	err      error
+}
+
+func (p *mockOutboxPublisher) Name() string {
+	return "mock"
+}
+
+func (p *mockOutboxPublisher) Publish(_ context.Context, messages []*outbox.Message) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+
+	p.messages = append(p.messages, messages...)
+	return nil
+}
+
+// 设置发布时返回的错误, 为 nil 时恢复正常
+func (p *mockOutboxPublisher) SetError(err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.err = err
+}
+
+func (p *mockOutboxPublisher) Messages() []*outbox.Message {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return append([]*outbox.Message(nil), p.messages...)
+}
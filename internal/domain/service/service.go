@@ -2,9 +2,14 @@ package service
 
 import (
 	"github.com/google/wire"
+	"github.com/kevin88886/eth_indexer/internal/domain/module"
 )
 
 var ProviderSet = wire.NewSet(
 	NewIndexApplication,
 	NewBlockService,
+	NewInvalidTxService,
+	NewWebhookService,
+	NewOutboxRelay,
+	module.NewStateFactories,
 )
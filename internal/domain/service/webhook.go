@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/webhook"
+	"github.com/kevin88886/eth_indexer/pkg/utils"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// 每次加载的到期推送记录数量
+	webhookDeliveryBatchSize = 100
+	// 同时推送的请求数量
+	webhookDeliveryConcurrency = 8
+	// 检查到期推送记录的间隔
+	webhookDeliveryInterval = time.Second
+	// 重新加载回调地址的间隔. 其他实例通过管理接口修改回调地址后, 最迟在这个间隔后生效
+	webhookReloadInterval = time.Second * 30
+)
+
+// 回调推送服务. 事务提交后的区块通过事件订阅进入推送队列, 入队与进度保存在同一个事务中,
+// 重启后从保存的进度继续, 不会遗漏区块. 推送失败按重试策略重试, 多次失败后转入死信, 可以通过管理接口重放.
+// 推送依赖进程内的事件订阅, 只在开启区块处理的实例中运行
+type WebhookService struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	eg     *errgroup.Group
+
+	logger    *log.Helper
+	repo      webhook.WebhookRepository
+	eventRepo domain.EventRepository
+	blockRepo domain.BlockRepository
+	sender    webhook.Sender
+	policy    webhook.RetryPolicy
+	enabled   bool
+
+	mu       sync.RWMutex
+	webhooks map[int64]*webhook.Webhook // 所有回调地址, 包含未启用的
+	notify   chan struct{}              // 有新的推送记录时通知推送协程
+}
+
+func NewWebhookService(
+	c *conf.Config,
+	logger log.Logger,
+	repo webhook.WebhookRepository,
+	eventRepo domain.EventRepository,
+	blockRepo domain.BlockRepository,
+	sender webhook.Sender,
+) *WebhookService {
+	ctx, cancel := context.WithCancel(context.Background())
+	eg, gCtx := errgroup.WithContext(ctx)
+
+	return &WebhookService{
+		ctx:       gCtx,
+		cancel:    cancel,
+		eg:        eg,
+		logger:    log.NewHelper(log.With(logger, "module", "WebhookService")),
+		repo:      repo,
+		eventRepo: eventRepo,
+		blockRepo: blockRepo,
+		sender:    sender,
+		policy:    webhook.DefaultRetryPolicy,
+		enabled:   c.Runtime.EnableHandle,
+		webhooks:  make(map[int64]*webhook.Webhook),
+		notify:    make(chan struct{}, 1),
+	}
+}
+
+func (srv *WebhookService) Start(_ context.Context) error {
+	if !srv.enabled {
+		return nil
+	}
+
+	srv.logger.Info("start webhook service")
+	defer srv.logger.Info("quit webhook service...")
+
+	if err := srv.reload(srv.ctx); err != nil {
+		return err
+	}
+
+	srv.eg.Go(utils.WithRetryCount(5, time.Second*15, time.Minute*3, srv.enqueueLoop))
+	srv.eg.Go(srv.deliverLoop)
+	srv.eg.Go(srv.reloadLoop)
+	return ignoreCanceled(srv.eg.Wait())
+}
+
+func (srv *WebhookService) Stop(_ context.Context) error {
+	srv.cancel()
+	return ignoreCanceled(srv.eg.Wait())
+}
+
+// 正常关闭时协程返回 context.Canceled, 不作为错误返回
+func ignoreCanceled(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// 注册回调地址. 未指定密钥时随机生成, 只推送注册之后处理的区块
+func (srv *WebhookService) Register(ctx context.Context, entity *webhook.Webhook) error {
+	if err := webhook.ValidateURL(ctx, entity.URL); err != nil {
+		return err
+	}
+
+	if entity.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+		entity.Secret = hex.EncodeToString(secret)
+	}
+
+	lastBlock, err := srv.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if lastBlock != nil {
+		entity.StartBlock = lastBlock.Number
+	}
+
+	entity.Addresses = canonicalAddresses(entity.Addresses)
+	entity.Enabled = true
+	if err := srv.repo.Create(ctx, entity); err != nil {
+		return err
+	}
+
+	return srv.reload(ctx)
+}
+
+// 更新回调地址. fn 中修改地址、密钥、过滤条件和启用状态
+func (srv *WebhookService) Update(ctx context.Context, id int64, fn func(entity *webhook.Webhook)) (*webhook.Webhook, error) {
+	entity, err := srv.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fn(entity)
+	if err := webhook.ValidateURL(ctx, entity.URL); err != nil {
+		return nil, err
+	}
+
+	entity.Addresses = canonicalAddresses(entity.Addresses)
+	if err := srv.repo.Update(ctx, entity); err != nil {
+		return nil, err
+	}
+
+	return entity, srv.reload(ctx)
+}
+
+func (srv *WebhookService) Remove(ctx context.Context, id int64) error {
+	if err := srv.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return srv.reload(ctx)
+}
+
+func (srv *WebhookService) List(ctx context.Context) ([]*webhook.Webhook, error) {
+	return srv.repo.List(ctx)
+}
+
+func (srv *WebhookService) Deliveries(ctx context.Context, query *webhook.DeliveryQuery) ([]*webhook.Delivery, error) {
+	return srv.repo.QueryDeliveries(ctx, query)
+}
+
+// 重放推送记录, 立即重新推送
+func (srv *WebhookService) Replay(ctx context.Context, query *webhook.ReplayQuery) (int64, error) {
+	replayed, err := srv.repo.Replay(ctx, query, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	srv.wakeup()
+	return replayed, nil
+}
+
+func (srv *WebhookService) reload(ctx context.Context) error {
+	entities, err := srv.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var webhooks = make(map[int64]*webhook.Webhook, len(entities))
+	for _, entity := range entities {
+		webhooks[entity.ID] = entity
+	}
+
+	srv.mu.Lock()
+	srv.webhooks = webhooks
+	srv.mu.Unlock()
+	return nil
+}
+
+// 定时重新加载回调地址
+func (srv *WebhookService) reloadLoop() error {
+	ticker := time.NewTicker(webhookReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-srv.ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := srv.reload(srv.ctx); err != nil && srv.ctx.Err() == nil {
+			srv.logger.Errorf("reload webhooks failed. error: %s", err)
+		}
+	}
+}
+
+func (srv *WebhookService) getWebhook(id int64) *webhook.Webhook {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	return srv.webhooks[id]
+}
+
+func (srv *WebhookService) wakeup() {
+	select {
+	case srv.notify <- struct{}{}:
+	default:
+	}
+}
+
+// 订阅事件, 为命中过滤条件的回调地址生成推送记录
+func (srv *WebhookService) enqueueLoop() error {
+	cursor, err := srv.repo.LoadCursor(srv.ctx)
+	if err != nil {
+		return err
+	}
+
+	// 首次启动时从最后处理的区块开始, 不推送历史事件
+	if cursor.Cursor == 0 {
+		lastBlock, err := srv.blockRepo.GetLastHandleBlock(srv.ctx)
+		if err != nil {
+			return err
+		}
+		if lastBlock != nil {
+			cursor.Cursor = lastBlock.Number
+		}
+	}
+
+	ctx, cancel := context.WithCancel(srv.ctx)
+	defer cancel()
+
+	stream, err := srv.eventRepo.SubscribeEvent(ctx, domain.EventStreamPosition{Cursor: cursor.Cursor, LastBlock: cursor.LastBlock}, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-stream.Err():
+			return err
+
+		case data, ok := <-stream.Next():
+			if !ok {
+				return ctx.Err()
+			}
+
+			var deliveries []*webhook.Delivery
+			if data.Block != nil {
+				deliveries, err = srv.buildDeliveries(data.Block)
+				if err != nil {
+					return err
+				}
+			}
+
+			position := webhook.Cursor{Cursor: data.Position.Cursor, LastBlock: data.Position.LastBlock}
+			if err := srv.repo.Enqueue(ctx, position, deliveries...); err != nil {
+				return err
+			}
+
+			if len(deliveries) != 0 {
+				srv.wakeup()
+			}
+		}
+	}
+}
+
+// 推送的请求体
+type webhookPayload struct {
+	WebhookID   int64           `json:"webhook_id"`
+	BlockNumber uint64          `json:"block_number"`
+	Events      []exportedEvent `json:"events"`
+}
+
+func (srv *WebhookService) buildDeliveries(block *domain.EventsByBlock) ([]*webhook.Delivery, error) {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+
+	var (
+		now        = time.Now()
+		deliveries []*webhook.Delivery
+	)
+	for _, entity := range srv.webhooks {
+		if !entity.Enabled || block.BlockNumber <= entity.StartBlock {
+			continue
+		}
+
+		filtered := webhookFilter(entity).Apply(block, 0)
+		if filtered == nil {
+			continue
+		}
+
+		payload := webhookPayload{
+			WebhookID:   entity.ID,
+			BlockNumber: block.BlockNumber,
+			Events:      newExportedEvents(filtered.Events),
+		}
+
+		data, err := json.Marshal(&payload)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, webhook.NewDelivery(entity.ID, block.BlockNumber, data, now))
+	}
+
+	return deliveries, nil
+}
+
+// 推送到期的记录
+func (srv *WebhookService) deliverLoop() error {
+	ticker := time.NewTicker(webhookDeliveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-srv.ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-srv.notify:
+		}
+
+		if err := srv.deliverDue(srv.ctx); err != nil && srv.ctx.Err() == nil {
+			srv.logger.Errorf("deliver webhooks failed. error: %s", err)
+		}
+	}
+}
+
+func (srv *WebhookService) deliverDue(ctx context.Context) error {
+	for {
+		deliveries, err := srv.repo.FetchDue(ctx, time.Now(), webhookDeliveryBatchSize)
+		if err != nil {
+			return err
+		}
+
+		eg, gCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(webhookDeliveryConcurrency)
+		for _, delivery := range deliveries {
+			delivery := delivery
+			eg.Go(func() error {
+				return srv.deliver(gCtx, delivery)
+			})
+		}
+
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+
+		if len(deliveries) < webhookDeliveryBatchSize {
+			return nil
+		}
+	}
+}
+
+// 推送一条记录并保存结果. 回调地址被删除或停用时直接转入死信, 重新启用后可以重放
+func (srv *WebhookService) deliver(ctx context.Context, delivery *webhook.Delivery) error {
+	target := srv.getWebhook(delivery.WebhookID)
+	switch {
+	case target == nil:
+		delivery.Abandon(webhook.ErrWebhookNotFound.Error())
+
+	case !target.Enabled:
+		delivery.Abandon(webhook.ErrWebhookDisabled.Error())
+
+	default:
+		if err := srv.sender.Send(ctx, target, delivery); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			delivery.Fail(err.Error(), time.Now(), srv.policy)
+			srv.logger.Warnf("deliver webhook failed. webhook: %d, block: %d, attempts: %d, error: %s", delivery.WebhookID, delivery.BlockNumber, delivery.Attempts, err)
+		} else {
+			delivery.Succeed(time.Now())
+		}
+	}
+
+	return srv.repo.SaveDelivery(ctx, delivery)
+}
+
+func webhookFilter(entity *webhook.Webhook) *domain.EventFilter {
+	filter := &domain.EventFilter{
+		Ticks:     entity.Ticks,
+		Addresses: entity.Addresses,
+	}
+	for _, operate := range entity.Operates {
+		filter.Operates = append(filter.Operates, protocol.Operate(operate))
+	}
+	return filter
+}
+
+func canonicalAddresses(addresses []string) []string {
+	var result = make([]string, 0, len(addresses))
+	for _, item := range addresses {
+		if item = address.Canonical(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
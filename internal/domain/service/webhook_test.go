@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/webhook"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestWebhookService(t *testing.T) {
+	suite.Run(t, new(TestWebhookServiceSuite))
+}
+
+type TestWebhookServiceSuite struct {
+	suite.Suite
+	repo   *mockWebhookRepo
+	sender *mockWebhookSender
+	srv    *WebhookService
+}
+
+func (s *TestWebhookServiceSuite) SetupTest() {
+	s.repo = &mockWebhookRepo{}
+	s.sender = &mockWebhookSender{}
+	s.srv = NewWebhookService(
+		&conf.Config{Bootstrap: &conf.Bootstrap{Runtime: &conf.Runtime{}}},
+		log.DefaultLogger,
+		s.repo,
+		nil,
+		&mockBlockRepo{handled: 100},
+		s.sender,
+	)
+}
+
+func (s *TestWebhookServiceSuite) newBlock(number uint64, ticks ...string) *domain.EventsByBlock {
+	block := &domain.EventsByBlock{BlockNumber: number}
+	for _, tick := range ticks {
+		block.Events = append(block.Events, &domain.IERC20TransferredEvent{
+			BlockNumber: number,
+			From:        "0xaa",
+			Data: &domain.IERC20Transferred{
+				Protocol: protocol.ProtocolIERC20,
+				Operate:  protocol.OpTransfer,
+				Tick:     tick,
+				From:     "0xaa",
+				To:       "0xbb",
+			},
+		})
+	}
+	return block
+}
+
+func (s *TestWebhookServiceSuite) TestRegister() {
+	ctx := context.Background()
+
+	err := s.srv.Register(ctx, &webhook.Webhook{URL: "ftp://93.184.216.34"})
+	s.ErrorIs(err, webhook.ErrInvalidURL)
+
+	// 不能推送到内网地址
+	err = s.srv.Register(ctx, &webhook.Webhook{URL: "http://127.0.0.1/hook"})
+	s.ErrorIs(err, webhook.ErrInvalidURL)
+
+	entity := &webhook.Webhook{URL: "http://93.184.216.34/hook", Addresses: []string{"0xAA", " "}}
+	s.NoError(s.srv.Register(ctx, entity))
+	s.NotEmpty(entity.Secret)
+	s.True(entity.Enabled)
+	s.Equal(uint64(100), entity.StartBlock)
+	s.Equal([]string{"0xaa"}, entity.Addresses)
+	s.NotNil(s.srv.getWebhook(entity.ID))
+}
+
+func (s *TestWebhookServiceSuite) TestBuildDeliveries() {
+	ctx := context.Background()
+
+	all := &webhook.Webhook{URL: "http://93.184.216.34/all"}
+	s.NoError(s.srv.Register(ctx, all))
+	tick := &webhook.Webhook{URL: "http://93.184.216.34/tick", Ticks: []string{"ethi"}}
+	s.NoError(s.srv.Register(ctx, tick))
+	disabled := &webhook.Webhook{URL: "http://93.184.216.34/disabled"}
+	s.NoError(s.srv.Register(ctx, disabled))
+	_, err := s.srv.Update(ctx, disabled.ID, func(entity *webhook.Webhook) { entity.Enabled = false })
+	s.NoError(err)
+
+	// 注册之前的区块不推送
+	deliveries, err := s.srv.buildDeliveries(s.newBlock(100, "ethi"))
+	s.NoError(err)
+	s.Empty(deliveries)
+
+	deliveries, err = s.srv.buildDeliveries(s.newBlock(101, "ethi", "other"))
+	s.NoError(err)
+	s.Len(deliveries, 2)
+
+	var payloads = make(map[int64]webhookPayload)
+	for _, delivery := range deliveries {
+		var payload webhookPayload
+		s.NoError(json.Unmarshal(delivery.Payload, &struct {
+			WebhookID   *int64  `json:"webhook_id"`
+			BlockNumber *uint64 `json:"block_number"`
+		}{&payload.WebhookID, &payload.BlockNumber}))
+		s.Equal(delivery.WebhookID, payload.WebhookID)
+		s.Equal(uint64(101), payload.BlockNumber)
+		payloads[delivery.WebhookID] = payload
+	}
+	s.Contains(payloads, all.ID)
+	s.Contains(payloads, tick.ID)
+
+	// 没有命中过滤条件的区块不推送
+	deliveries, err = s.srv.buildDeliveries(s.newBlock(102, "other"))
+	s.NoError(err)
+	s.Len(deliveries, 1)
+	s.Equal(all.ID, deliveries[0].WebhookID)
+}
+
+func (s *TestWebhookServiceSuite) TestDeliver() {
+	ctx := context.Background()
+
+	entity := &webhook.Webhook{URL: "http://93.184.216.34/hook"}
+	s.NoError(s.srv.Register(ctx, entity))
+	s.srv.policy = webhook.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	delivery := webhook.NewDelivery(entity.ID, 101, []byte(`{}`), time.Now())
+	s.sender.err = errors.New("connection refused")
+	s.NoError(s.srv.deliver(ctx, delivery))
+	s.Equal(webhook.DeliveryPending, delivery.Status)
+	s.NoError(s.srv.deliver(ctx, delivery))
+	s.Equal(webhook.DeliveryDead, delivery.Status)
+	s.Equal("connection refused", delivery.LastError)
+
+	delivery.Replay(time.Now())
+	s.sender.err = nil
+	s.NoError(s.srv.deliver(ctx, delivery))
+	s.Equal(webhook.DeliverySucceeded, delivery.Status)
+	s.Equal(3, s.sender.sent)
+
+	// 回调地址删除后直接转入死信, 不再发送
+	orphan := webhook.NewDelivery(entity.ID+1, 101, []byte(`{}`), time.Now())
+	s.NoError(s.srv.deliver(ctx, orphan))
+	s.Equal(webhook.DeliveryDead, orphan.Status)
+	s.Equal(3, s.sender.sent)
+	s.Equal(4, s.repo.saved)
+}
+
+type mockWebhookRepo struct {
+	webhook.WebhookRepository
+	webhooks []*webhook.Webhook
+	saved    int
+}
+
+func (m *mockWebhookRepo) Create(_ context.Context, entity *webhook.Webhook) error {
+	entity.ID = int64(len(m.webhooks) + 1)
+	m.webhooks = append(m.webhooks, entity)
+	return nil
+}
+
+func (m *mockWebhookRepo) Update(_ context.Context, _ *webhook.Webhook) error {
+	return nil
+}
+
+func (m *mockWebhookRepo) Get(_ context.Context, id int64) (*webhook.Webhook, error) {
+	for _, entity := range m.webhooks {
+		if entity.ID == id {
+			return entity, nil
+		}
+	}
+	return nil, webhook.ErrWebhookNotFound
+}
+
+func (m *mockWebhookRepo) List(_ context.Context) ([]*webhook.Webhook, error) {
+	return m.webhooks, nil
+}
+
+func (m *mockWebhookRepo) SaveDelivery(_ context.Context, _ *webhook.Delivery) error {
+	m.saved++
+	return nil
+}
+
+type mockWebhookSender struct {
+	err  error
+	sent int
+}
+
+func (m *mockWebhookSender) Send(_ context.Context, _ *webhook.Webhook, _ *webhook.Delivery) error {
+	m.sent++
+	return m.err
+}
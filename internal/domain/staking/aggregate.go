@@ -3,6 +3,7 @@ package staking
 import (
 	"sort"
 
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/shopspring/decimal"
 )
@@ -16,15 +17,15 @@ type PoolAggregate struct {
 
 func NewPoolAggregate(pool string, owner string) *PoolAggregate {
 	return &PoolAggregate{
-		PoolAddress: pool,
-		Owner:       owner,
+		PoolAddress: address.Canonical(pool),
+		Owner:       address.Canonical(owner),
 		pools:       make(map[uint64]*StakingPool),
 		//positions:   make(map[string]map[uint64]*StakingPosition),
 	}
 }
 
 func (p *PoolAggregate) InitPool(pool *StakingPool) {
-	if pool.Pool != p.PoolAddress {
+	if !address.Equal(pool.Pool, p.PoolAddress) {
 		return
 	}
 
@@ -40,8 +41,8 @@ func (p *PoolAggregate) InitPosition(position *StakingPosition) {
 	pool.setPosition(position)
 }
 
-func (p *PoolAggregate) IsAdmin(poolSubID uint64, address string) bool {
-	if address == p.Owner {
+func (p *PoolAggregate) IsAdmin(poolSubID uint64, addr string) bool {
+	if address.Equal(addr, p.Owner) {
 		return true
 	}
 
@@ -50,7 +51,7 @@ func (p *PoolAggregate) IsAdmin(poolSubID uint64, address string) bool {
 		return false
 	}
 
-	return pool.IsAmin(address)
+	return pool.IsAmin(addr)
 }
 
 // 获取质押池
@@ -86,6 +87,64 @@ func (p *PoolAggregate) GetStakingPositions() []*StakingPosition {
 	return positions
 }
 
+// 获取子池
+func (p *PoolAggregate) GetStakingPool(poolSubID uint64) (*StakingPool, bool) {
+	pool, existed := p.pools[poolSubID]
+	return pool, existed
+}
+
+// 区块处理过程中产生的奖励记录, 按子池ID排序. 保存成功之前不清空, 保存失败时不会丢失
+func (p *PoolAggregate) RewardsRecords() []*RewardsRecord {
+	var records []*RewardsRecord
+	for _, pool := range p.GetStakingPools() {
+		records = append(records, pool.rewardsRecords()...)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].PoolSubID < records[j].PoolSubID
+	})
+
+	return records
+}
+
+// 清空奖励记录. 奖励记录保存成功后调用
+func (p *PoolAggregate) ClearRewardsRecords() {
+	for _, pool := range p.pools {
+		pool.clearRewardsRecords()
+	}
+}
+
+// 生成只读快照, 供查询接口使用.
+// 区块处理时会直接修改聚合, 所以查询时不能直接读取. prev 是上一次生成的快照,
+// 未在 blockNumber 更新的仓位直接复用 prev 中的副本, 避免每个区块都复制所有仓位
+func (p *PoolAggregate) Snapshot(blockNumber uint64, prev *PoolAggregate) *PoolAggregate {
+	var snapshot = NewPoolAggregate(p.PoolAddress, p.Owner)
+	for subID, pool := range p.pools {
+		var (
+			copied   = pool.copyWithoutPositions()
+			prevPool *StakingPool
+		)
+		if prev != nil {
+			prevPool = prev.pools[subID]
+		}
+
+		for staker, position := range pool.positions {
+			if prevPool != nil && position.LastUpdatedBlock < blockNumber {
+				if prevPosition := prevPool.getPosition(staker); prevPosition != nil {
+					copied.positions[staker] = prevPosition
+					continue
+				}
+			}
+
+			copied.positions[staker] = position.Copy()
+		}
+
+		snapshot.pools[subID] = copied
+	}
+
+	return snapshot
+}
+
 // 更新池子配置
 func (p *PoolAggregate) UpdatePool(command *protocol.ConfigStakeCommand) error {
 
@@ -0,0 +1,58 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPoolAggregate(t *testing.T) {
+	suite.Run(t, new(TestPoolAggregateSuite))
+}
+
+type TestPoolAggregateSuite struct {
+	suite.Suite
+}
+
+func (s *TestPoolAggregateSuite) newAggregate() *PoolAggregate {
+	root := NewPoolAggregate("0x01", "0x01")
+	root.InitPool(NewStakingPool(&protocol.ConfigStakeCommand{
+		IERCTransactionBase: protocol.IERCTransactionBase{BlockNumber: 100},
+		Pool:                "0x01",
+		PoolSubID:           1,
+		Owner:               "0x01",
+		Name:                "test",
+		Details: []*protocol.TickConfigDetail{
+			{Tick: "ethi", RewardsRatioPerBlock: decimal.NewFromInt(1), MaxAmount: decimal.NewFromInt(1000)},
+		},
+	}))
+
+	return root
+}
+
+func (s *TestPoolAggregateSuite) TestSnapshot() {
+	root := s.newAggregate()
+	s.NoError(root.Staking(100, 1, "0x02", "ethi", decimal.NewFromInt(10)))
+	s.NoError(root.Staking(100, 1, "0x03", "ethi", decimal.NewFromInt(20)))
+
+	snapshot := root.Snapshot(100, nil)
+	pool, existed := snapshot.GetStakingPool(1)
+	s.True(existed)
+	s.Equal(2, pool.StakerCount())
+	s.Equal("0x02", pool.GetPositions()[0].Staker)
+
+	// 修改聚合不影响快照
+	s.NoError(root.Staking(110, 1, "0x02", "ethi", decimal.NewFromInt(10)))
+	s.True(pool.GetPosition("0x02").TickDetails["ethi"].Amount.Equal(decimal.NewFromInt(10)))
+	s.True(pool.Detail.TickDetails["ethi"].Amount.Equal(decimal.NewFromInt(30)))
+
+	// 未更新的仓位复用上一次的快照
+	next := root.Snapshot(110, snapshot)
+	nextPool, _ := next.GetStakingPool(1)
+	s.Same(pool.GetPosition("0x03"), nextPool.GetPosition("0x03"))
+	s.NotSame(pool.GetPosition("0x02"), nextPool.GetPosition("0x02"))
+	s.True(nextPool.GetPosition("0x02").TickDetails["ethi"].Amount.Equal(decimal.NewFromInt(20)))
+	s.True(nextPool.Detail.TickDetails["ethi"].Amount.Equal(decimal.NewFromInt(40)))
+}
@@ -7,4 +7,14 @@ import (
 type StakingRepository interface {
 	LoadAllPools(ctx context.Context) (map[string]*PoolAggregate, error)
 	Save(ctx context.Context, blockNumber uint64, pool ...*PoolAggregate) error
+	// 查询质押池, stakers 不为空时可以只加载指定质押人的仓位. 返回的是只读副本, 不影响正在处理的区块
+	LoadPool(ctx context.Context, pool string, stakers ...string) (*PoolAggregate, error)
+	// 查询质押池只读副本, 按池子地址排序. pools 为空时返回所有池子
+	QueryPools(ctx context.Context, pools ...string) ([]*PoolAggregate, error)
+}
+
+type RewardsRecordRepository interface {
+	Save(ctx context.Context, records ...*RewardsRecord) error
+	// 按区块倒序查询
+	Query(ctx context.Context, query *RewardsRecordQuery) ([]*RewardsRecord, error)
 }
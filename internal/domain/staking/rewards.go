@@ -0,0 +1,171 @@
+package staking
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 按 12 秒一个区块估算的每年区块数, 用于计算年化奖励
+const BlocksPerYear uint64 = 365 * 24 * 60 * 60 / 12
+
+type RewardsKind string
+
+const (
+	RewardsKindSettle RewardsKind = "settle" // 结算奖励
+	RewardsKindUse    RewardsKind = "use"    // 使用奖励. PoS mint 时消耗
+)
+
+// 奖励变更记录. 每次结算、使用奖励都会记录一条, 用于查询奖励的历史
+type RewardsRecord struct {
+	ID              int64
+	Pool            string          // 池子地址
+	PoolSubID       uint64          // 子池ID
+	Staker          string          // 质押人
+	Kind            RewardsKind     // 变更类型
+	Amount          decimal.Decimal // 变更数量
+	AccReward       decimal.Decimal // 变更后的累积奖励
+	Debt            decimal.Decimal // 变更后已使用的奖励
+	RewardsPerBlock decimal.Decimal // 变更时每个块的奖励
+	BlockNumber     uint64          // 变更的区块
+	CreatedAt       time.Time
+}
+
+func newRewardsRecord(blockNumber uint64, kind RewardsKind, amount decimal.Decimal, position *StakingPosition) *RewardsRecord {
+	return &RewardsRecord{
+		Pool:            position.PoolAddress,
+		PoolSubID:       position.PoolSubID,
+		Staker:          position.Staker,
+		Kind:            kind,
+		Amount:          amount,
+		AccReward:       position.AccReward,
+		Debt:            position.Debt,
+		RewardsPerBlock: position.RewardsPerBlock,
+		BlockNumber:     blockNumber,
+		CreatedAt:       time.Now(),
+	}
+}
+
+// 奖励记录查询条件. 为空的条件不参与过滤
+type RewardsRecordQuery struct {
+	Pool       string
+	PoolSubIDs []uint64
+	Staker     string
+	StartBlock uint64 // 包含
+	EndBlock   uint64 // 包含, 0 表示不限制
+	Cursor     string // 上一页返回的游标, 为空时从第一页开始
+	Limit      int
+}
+
+var ErrInvalidRewardsCursor = errors.New("invalid rewards cursor")
+
+// 奖励记录的位置. 按 (区块号, 记录ID) 倒序翻页, 同一个区块的记录不会重复或遗漏
+type RewardsRecordCursor struct {
+	BlockNumber uint64
+	ID          int64
+}
+
+// Encode 生成不透明的游标字符串, 客户端不需要也不应该解析
+func (c RewardsRecordCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d_%d", c.BlockNumber, c.ID)))
+}
+
+func ParseRewardsRecordCursor(s string) (RewardsRecordCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return RewardsRecordCursor{}, ErrInvalidRewardsCursor
+	}
+
+	var cursor RewardsRecordCursor
+	if n, err := fmt.Sscanf(string(data), "%d_%d", &cursor.BlockNumber, &cursor.ID); err != nil || n != 2 || cursor.ID <= 0 {
+		return RewardsRecordCursor{}, ErrInvalidRewardsCursor
+	}
+
+	return cursor, nil
+}
+
+// tick 的奖励统计
+type TickRewards struct {
+	Tick   string
+	Ratio  decimal.Decimal // 每个区块每个质押代币的奖励
+	Amount decimal.Decimal // 当前质押总量
+	// 质押一个代币未来一年内可以获得的奖励. 限期池子只计算到停止区块.
+	// 奖励是积分, 与质押的代币不是同一种资产, 所以不是收益率
+	YearlyRewards decimal.Decimal
+}
+
+// 质押人在子池中的奖励统计
+type StakerRewards struct {
+	Staker           string
+	RewardsPerBlock  decimal.Decimal // 当前每个区块的奖励
+	AvailableRewards decimal.Decimal // 当前区块的可用奖励
+	ProjectedRewards decimal.Decimal // 预计在指定区块的可用奖励
+}
+
+// 计算每个 tick 的奖励统计
+func (p *StakingPool) CalcTickRewards(blockNumber uint64) []*TickRewards {
+
+	// 剩余的奖励区块数
+	var remaining = BlocksPerYear
+	if p.IsTimeLimited() {
+		if blockNumber >= p.Detail.StopBlock {
+			remaining = 0
+		} else {
+			remaining = min(remaining, p.Detail.StopBlock-blockNumber)
+		}
+	}
+
+	var result = make([]*TickRewards, 0, len(p.Detail.TickDetails))
+	for _, detail := range p.Detail.TickDetails {
+		result = append(result, &TickRewards{
+			Tick:          detail.Tick,
+			Ratio:         detail.Ratio,
+			Amount:        detail.Amount,
+			YearlyRewards: detail.Ratio.Mul(decimal.NewFromInt(int64(remaining))),
+		})
+	}
+
+	// 按配置顺序返回
+	sort.Slice(result, func(i, j int) bool {
+		return p.Detail.TickDetails[result[i].Tick].Index < p.Detail.TickDetails[result[j].Tick].Index
+	})
+
+	return result
+}
+
+// 计算质押人的奖励统计, 质押人没有仓位时返回 nil
+func (p *StakingPool) CalcStakerRewards(blockNumber, projectedBlock uint64, staker string) *StakerRewards {
+	position := p.getPosition(staker)
+	if position == nil {
+		return nil
+	}
+
+	return &StakerRewards{
+		Staker:           staker,
+		RewardsPerBlock:  position.RewardsPerBlock,
+		AvailableRewards: p.CalcAvailableRewards(blockNumber, staker),
+		ProjectedRewards: p.CalcAvailableRewards(max(blockNumber, projectedBlock), staker),
+	}
+}
+
+// 区块处理过程中产生的奖励记录
+func (p *StakingPool) rewardsRecords() []*RewardsRecord {
+	return p.records
+}
+
+// 清空奖励记录. 奖励记录保存成功后调用
+func (p *StakingPool) clearRewardsRecords() {
+	p.records = nil
+}
+
+func (p *StakingPool) addRewardsRecord(blockNumber uint64, kind RewardsKind, amount decimal.Decimal, position *StakingPosition) {
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return
+	}
+
+	p.records = append(p.records, newRewardsRecord(blockNumber, kind, amount, position))
+}
@@ -0,0 +1,129 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRewards(t *testing.T) {
+	suite.Run(t, new(TestRewardsSuite))
+}
+
+type TestRewardsSuite struct {
+	suite.Suite
+}
+
+func (s *TestRewardsSuite) newPool(stopBlock uint64) *StakingPool {
+	return NewStakingPool(&protocol.ConfigStakeCommand{
+		IERCTransactionBase: protocol.IERCTransactionBase{BlockNumber: 100},
+		Pool:                "0x01",
+		PoolSubID:           1,
+		Owner:               "0x01",
+		Name:                "test",
+		StopBlock:           stopBlock,
+		Details: []*protocol.TickConfigDetail{
+			{Tick: "ethi", RewardsRatioPerBlock: decimal.NewFromInt(2), MaxAmount: decimal.NewFromInt(1000)},
+			{Tick: "ierc", RewardsRatioPerBlock: decimal.NewFromInt(1), MaxAmount: decimal.NewFromInt(1000)},
+		},
+	})
+}
+
+func (s *TestRewardsSuite) TestRewardsRecords() {
+	pool := s.newPool(0)
+
+	s.NoError(pool.Staking(100, "0x02", "ethi", decimal.NewFromInt(10)))
+	s.Empty(pool.rewardsRecords()) // 首次质押没有可结算的奖励
+
+	// 10 个区块后使用奖励: 先结算 200, 再使用 50
+	s.True(pool.UseRewards(110, "0x02", decimal.NewFromInt(50)).Equal(decimal.NewFromInt(50)))
+
+	// 清空前多次读取结果不变
+	s.Len(pool.rewardsRecords(), 2)
+	records := pool.rewardsRecords()
+	s.Len(records, 2)
+	s.Equal(RewardsKindSettle, records[0].Kind)
+	s.True(records[0].Amount.Equal(decimal.NewFromInt(200)))
+	s.Equal(uint64(110), records[0].BlockNumber)
+	s.Equal(RewardsKindUse, records[1].Kind)
+	s.True(records[1].Amount.Equal(decimal.NewFromInt(50)))
+	s.True(records[1].Debt.Equal(decimal.NewFromInt(50)))
+
+	pool.clearRewardsRecords()
+	s.Empty(pool.rewardsRecords())
+}
+
+func (s *TestRewardsSuite) TestCalcTickRewards() {
+	// 不限期池子按一年计算
+	ticks := s.newPool(0).CalcTickRewards(100)
+	s.Len(ticks, 2)
+	s.Equal("ethi", ticks[0].Tick)
+	s.Equal("ierc", ticks[1].Tick)
+	s.True(ticks[0].YearlyRewards.Equal(decimal.NewFromInt(int64(2 * BlocksPerYear))))
+
+	// 限期池子只计算到停止区块
+	pool := s.newPool(200)
+	s.True(pool.CalcTickRewards(150)[0].YearlyRewards.Equal(decimal.NewFromInt(100)))
+	s.True(pool.CalcTickRewards(200)[0].YearlyRewards.IsZero())
+}
+
+func (s *TestRewardsSuite) TestCalcStakerRewards() {
+	pool := s.newPool(200)
+	s.Nil(pool.CalcStakerRewards(100, 100, "0x02"))
+
+	s.NoError(pool.Staking(100, "0x02", "ierc", decimal.NewFromInt(10)))
+
+	rewards := pool.CalcStakerRewards(150, 300, "0x02")
+	s.True(rewards.RewardsPerBlock.Equal(decimal.NewFromInt(10)))
+	s.True(rewards.AvailableRewards.Equal(decimal.NewFromInt(500)))
+	s.True(rewards.ProjectedRewards.Equal(decimal.NewFromInt(1000))) // 停止区块后不再产生奖励
+}
+
+func (s *TestRewardsSuite) TestMixedCaseStaker() {
+	pool := s.newPool(0)
+
+	// 同一个质押人使用不同大小写的地址质押, 只会有一个仓位
+	s.NoError(pool.Staking(100, "0xAbCd", "ethi", decimal.NewFromInt(10)))
+	s.NoError(pool.Staking(100, "0xabcd", "ethi", decimal.NewFromInt(10)))
+	s.Equal(1, pool.StakerCount())
+	s.Equal("0xabcd", pool.GetPosition("0xABCD").Staker)
+	s.True(pool.CalcAvailableRewards(110, " 0xABCD ").Equal(decimal.NewFromInt(400)))
+}
+
+func (s *TestRewardsSuite) TestMergePosition() {
+	a := NewStakingPosition(100, "0x01", 1, "0xabcd")
+	s.NoError(a.Staking(100, "ethi", decimal.NewFromInt(2), decimal.NewFromInt(10)))
+	b := NewStakingPosition(110, "0x01", 1, "0xABCD")
+	b.Staker = "0xABCD"
+	s.NoError(b.Staking(110, "ethi", decimal.NewFromInt(2), decimal.NewFromInt(5)))
+	s.NoError(b.Staking(110, "ierc", decimal.NewFromInt(1), decimal.NewFromInt(5)))
+
+	// 合并前后, 任意区块的可用奖励之和不变
+	expected := a.CalcAvailableRewards(120).Add(b.CalcAvailableRewards(120))
+	a.Merge(b)
+
+	s.Equal("0xabcd", a.Staker)
+	s.Equal(uint64(110), a.LastRewardBlock)
+	s.True(a.AccReward.Equal(decimal.NewFromInt(200)))
+	s.True(a.RewardsPerBlock.Equal(decimal.NewFromInt(35)))
+	s.True(a.TickDetails["ethi"].Amount.Equal(decimal.NewFromInt(15)))
+	s.True(a.TickDetails["ierc"].Amount.Equal(decimal.NewFromInt(5)))
+	s.True(a.CalcAvailableRewards(120).Equal(expected))
+
+	// 被合并的仓位不受影响
+	s.True(b.AccReward.IsZero())
+}
+
+func (s *TestRewardsSuite) TestRewardsRecordCursor() {
+	cursor := RewardsRecordCursor{BlockNumber: 100, ID: 12}
+	parsed, err := ParseRewardsRecordCursor(cursor.Encode())
+	s.NoError(err)
+	s.Equal(cursor, parsed)
+
+	for _, raw := range []string{"abc", RewardsRecordCursor{BlockNumber: 100}.Encode()} {
+		_, err = ParseRewardsRecordCursor(raw)
+		s.ErrorIs(err, ErrInvalidRewardsCursor)
+	}
+}
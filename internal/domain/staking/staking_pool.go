@@ -1,6 +1,9 @@
 package staking
 
 import (
+	"sort"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/shopspring/decimal"
 )
@@ -41,6 +44,7 @@ type StakingPool struct {
 	LastUpdatedBlock uint64            `json:"lastUpdatedBlock,omitempty"` // 最后更新时的区块号
 
 	positions map[string]*StakingPosition // 仓位信息. map(staker => positionsByPoolID)
+	records   []*RewardsRecord            // 当前区块产生的奖励记录, 保存后清空
 }
 
 func NewStakingPool(command *protocol.ConfigStakeCommand) *StakingPool {
@@ -56,13 +60,18 @@ func NewStakingPool(command *protocol.ConfigStakeCommand) *StakingPool {
 		}
 	}
 
+	var admins = make([]string, 0, len(command.Admins))
+	for _, admin := range command.Admins {
+		admins = append(admins, address.Canonical(admin))
+	}
+
 	return &StakingPool{
-		Pool:      command.Pool,
+		Pool:      address.Canonical(command.Pool),
 		PoolSubID: command.PoolSubID,
 		Detail: StakingPoolDetail{
 			Name:        command.Name,
-			Owner:       command.Owner,
-			Admins:      command.Admins,
+			Owner:       address.Canonical(command.Owner),
+			Admins:      admins,
 			StartBlock:  command.BlockNumber,
 			StopBlock:   command.StopBlock,
 			TickDetails: details,
@@ -78,7 +87,7 @@ func (p *StakingPool) getPosition(staker string) *StakingPosition {
 	}
 
 	// 查询质押人仓位
-	position, existed := p.positions[staker]
+	position, existed := p.positions[address.Canonical(staker)]
 	if !existed {
 		return nil
 	}
@@ -86,21 +95,68 @@ func (p *StakingPool) getPosition(staker string) *StakingPosition {
 	return position
 }
 
+// 查询质押人仓位, 不存在时返回 nil
+func (p *StakingPool) GetPosition(staker string) *StakingPosition {
+	return p.getPosition(staker)
+}
+
+// 获取所有仓位, 按质押人地址排序
+func (p *StakingPool) GetPositions() []*StakingPosition {
+	var result = make([]*StakingPosition, 0, len(p.positions))
+	for _, position := range p.positions {
+		result = append(result, position)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Staker < result[j].Staker
+	})
+
+	return result
+}
+
+// 质押人数量
+func (p *StakingPool) StakerCount() int {
+	return len(p.positions)
+}
+
+// 复制池子配置, 不包含仓位
+func (p *StakingPool) copyWithoutPositions() *StakingPool {
+	var details = make(map[string]*PoolTickDetail, len(p.Detail.TickDetails))
+	for tick, detail := range p.Detail.TickDetails {
+		details[tick] = detail.Copy()
+	}
+
+	return &StakingPool{
+		Pool:      p.Pool,
+		PoolSubID: p.PoolSubID,
+		Detail: StakingPoolDetail{
+			Name:        p.Detail.Name,
+			Owner:       p.Detail.Owner,
+			Admins:      append([]string(nil), p.Detail.Admins...),
+			StartBlock:  p.Detail.StartBlock,
+			StopBlock:   p.Detail.StopBlock,
+			TickDetails: details,
+		},
+		LastUpdatedBlock: p.LastUpdatedBlock,
+		positions:        make(map[string]*StakingPosition, len(p.positions)),
+	}
+}
+
 func (p *StakingPool) setPosition(position *StakingPosition) {
 	if p.positions == nil {
 		p.positions = make(map[string]*StakingPosition)
 	}
 
-	p.positions[position.Staker] = position
+	p.positions[address.Canonical(position.Staker)] = position
 }
 
-func (p *StakingPool) IsAmin(address string) bool {
-	if address == p.Detail.Owner {
+func (p *StakingPool) IsAmin(addr string) bool {
+	if address.Equal(addr, p.Detail.Owner) {
 		return true
 	}
 
 	for _, admin := range p.Detail.Admins {
-		if admin == address {
+		if address.Equal(admin, addr) {
 			return true
 		}
 	}
@@ -368,7 +424,9 @@ func (p *StakingPool) UseRewards(blockNumber uint64, staker string, amount decim
 
 	p.settleRewards(blockNumber, position)
 
-	return position.UseRewards(blockNumber, amount)
+	realUseAmount := position.UseRewards(blockNumber, amount)
+	p.addRewardsRecord(blockNumber, RewardsKindUse, realUseAmount, position)
+	return realUseAmount
 }
 
 func (p *StakingPool) settleRewards(blockNumber uint64, position *StakingPosition) {
@@ -377,5 +435,6 @@ func (p *StakingPool) settleRewards(blockNumber uint64, position *StakingPositio
 		blockNumber = min(blockNumber, p.Detail.StopBlock)
 	}
 
-	position.SettleRewards(blockNumber)
+	settled := position.SettleRewards(blockNumber)
+	p.addRewardsRecord(blockNumber, RewardsKindSettle, settled, position)
 }
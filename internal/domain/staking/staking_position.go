@@ -3,6 +3,7 @@ package staking
 import (
 	"time"
 
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/shopspring/decimal"
 )
@@ -29,9 +30,9 @@ type StakingPosition struct {
 
 func NewStakingPosition(blockNumber uint64, pool string, poolID uint64, staker string) *StakingPosition {
 	return &StakingPosition{
-		PoolAddress:      pool,
+		PoolAddress:      address.Canonical(pool),
 		PoolSubID:        poolID,
-		Staker:           staker,
+		Staker:           address.Canonical(staker),
 		TickDetails:      make(map[string]*PositionTickDetail),
 		RewardsPerBlock:  decimal.Zero,
 		Debt:             decimal.Zero,
@@ -43,6 +44,59 @@ func NewStakingPosition(blockNumber uint64, pool string, poolID uint64, staker s
 	}
 }
 
+func (s *StakingPosition) Copy() *StakingPosition {
+	var details = make(map[string]*PositionTickDetail, len(s.TickDetails))
+	for tick, detail := range s.TickDetails {
+		details[tick] = &PositionTickDetail{
+			Tick:   detail.Tick,
+			Ratio:  detail.Ratio.Copy(),
+			Amount: detail.Amount.Copy(),
+		}
+	}
+
+	return &StakingPosition{
+		PoolAddress:      s.PoolAddress,
+		PoolSubID:        s.PoolSubID,
+		Staker:           s.Staker,
+		TickDetails:      details,
+		RewardsPerBlock:  s.RewardsPerBlock.Copy(),
+		Debt:             s.Debt.Copy(),
+		AccReward:        s.AccReward.Copy(),
+		LastRewardBlock:  s.LastRewardBlock,
+		LastUpdatedBlock: s.LastUpdatedBlock,
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+	}
+}
+
+// 合并同一个质押人(地址大小写不同)的另一个仓位.
+// 两个仓位先结算到相同的奖励区块, 再累加奖励与质押数量, 保证合并前后的可用奖励一致
+func (s *StakingPosition) Merge(other *StakingPosition) {
+	rewardBlock := max(s.LastRewardBlock, other.LastRewardBlock)
+	other = other.Copy()
+	s.SettleRewards(rewardBlock)
+	other.SettleRewards(rewardBlock)
+
+	for tick, detail := range other.TickDetails {
+		existed, ok := s.TickDetails[tick]
+		if !ok {
+			s.TickDetails[tick] = detail
+			continue
+		}
+
+		existed.Amount = existed.Amount.Add(detail.Amount)
+	}
+
+	s.Staker = address.Canonical(s.Staker)
+	s.RewardsPerBlock = s.RewardsPerBlock.Add(other.RewardsPerBlock)
+	s.Debt = s.Debt.Add(other.Debt)
+	s.AccReward = s.AccReward.Add(other.AccReward)
+	s.LastUpdatedBlock = max(s.LastUpdatedBlock, other.LastUpdatedBlock)
+	if !other.CreatedAt.IsZero() && (s.CreatedAt.IsZero() || other.CreatedAt.Before(s.CreatedAt)) {
+		s.CreatedAt = other.CreatedAt
+	}
+}
+
 // 计算剩余的可用奖励
 func (s *StakingPosition) calculateRemainingAvailableRewards() decimal.Decimal {
 	return s.AccReward.Sub(s.Debt)
@@ -12,16 +12,18 @@ import (
 
 type IERC20Tick struct {
 	ID                 int64             `json:"id,omitempty"`
-	Protocol           protocol.Protocol `json:"protocol,omitempty"` // tick 所属协议
-	Tick               string            `json:"tick,omitempty"`     // tick 名称
-	MaxSupply          decimal.Decimal   `json:"max_supply"`         // 最大发行量
-	Supply             decimal.Decimal   `json:"supply"`             // 已发行数量
-	Decimals           int64             `json:"decimals,omitempty"` // tick 精度
-	Limit              decimal.Decimal   `json:"limit"`              // 一笔交易最大mint数量
-	WalletLimit        decimal.Decimal   `json:"wallet_limit"`       // 一个地址最多mint数量
-	WorkC              string            `json:"work_c,omitempty"`   // mint 难度. 0x0000
-	Creator            string            `json:"creator,omitempty"`  // tick 创建者
-	LastUpdatedAtBlock uint64            `json:"updated_at_block"`   // 最后更新于哪个区块
+	Protocol           protocol.Protocol `json:"protocol,omitempty"`     // tick 所属协议
+	Tick               string            `json:"tick,omitempty"`         // tick 名称
+	MaxSupply          decimal.Decimal   `json:"max_supply"`             // 最大发行量
+	Supply             decimal.Decimal   `json:"supply"`                 // 已发行数量
+	BurnedAmount       decimal.Decimal   `json:"burned_amount"`          // 已销毁数量. 销毁不会减少已发行数量, 避免被重新 mint
+	Decimals           int64             `json:"decimals,omitempty"`     // tick 精度
+	Limit              decimal.Decimal   `json:"limit"`                  // 一笔交易最大mint数量
+	WalletLimit        decimal.Decimal   `json:"wallet_limit"`           // 一个地址最多mint数量
+	WorkC              string            `json:"work_c,omitempty"`       // mint 难度. 0x0000
+	Creator            string            `json:"creator,omitempty"`      // tick 创建者
+	DeployBlock        uint64            `json:"deploy_block,omitempty"` // 部署区块
+	LastUpdatedAtBlock uint64            `json:"updated_at_block"`       // 最后更新于哪个区块
 	CreatedAt          time.Time         `json:"created_at"`
 	UpdatedAt          time.Time         `json:"updated_at"`
 }
@@ -42,11 +44,13 @@ func NewTickFromDeployCommand(command *protocol.DeployCommand) *IERC20Tick {
 		Tick:               command.Tick,
 		MaxSupply:          maxSupply,
 		Supply:             decimal.Zero,
+		BurnedAmount:       decimal.Zero,
 		Decimals:           command.Decimals,
 		Limit:              command.MintLimitOfSingleTx,
 		WalletLimit:        command.MintLimitOfWallet,
 		WorkC:              command.Workc,
 		Creator:            command.From,
+		DeployBlock:        command.BlockNumber,
 		LastUpdatedAtBlock: command.BlockNumber,
 		CreatedAt:          command.EventAt,
 		UpdatedAt:          command.EventAt,
@@ -56,6 +60,7 @@ func NewTickFromDeployCommand(command *protocol.DeployCommand) *IERC20Tick {
 func (t *IERC20Tick) GetID() int64                   { return t.ID }
 func (t *IERC20Tick) GetProtocol() protocol.Protocol { return t.Protocol }
 func (t *IERC20Tick) GetName() string                { return t.Tick }
+func (t *IERC20Tick) GetDecimals() int64             { return t.Decimals }
 func (t *IERC20Tick) LastUpdatedBlock() uint64       { return t.LastUpdatedAtBlock }
 
 // 验证hash
@@ -96,6 +101,27 @@ func (t *IERC20Tick) Mint(blockNumber uint64, amount decimal.Decimal) {
 	t.LastUpdatedAtBlock = blockNumber
 }
 
+// 销毁
+func (t *IERC20Tick) Burn(blockNumber uint64, amount decimal.Decimal) {
+	t.BurnedAmount = t.BurnedAmount.Add(amount)
+	t.LastUpdatedAtBlock = blockNumber
+}
+
+// 流通量 = 已发行数量 - 已销毁数量
+func (t *IERC20Tick) CirculatingSupply() decimal.Decimal {
+	return t.Supply.Sub(t.BurnedAmount)
+}
+
+// mint 进度, 百分比
+func (t *IERC20Tick) MintProgress() decimal.Decimal {
+	return mintProgress(t.Supply, t.MaxSupply)
+}
+
+// 是否还可以 mint
+func (t *IERC20Tick) IsMintable() bool {
+	return t.Supply.LessThan(t.MaxSupply)
+}
+
 func (t *IERC20Tick) Marshal() ([]byte, error) {
 	return json.Marshal(t)
 }
@@ -23,6 +23,14 @@ var (
 
 var MinDecimal = decimal.NewFromInt(1).Shift(-18)
 
+// pow mint 指定的区块与交易打包区块之间允许的最大差值
+const PoWMintBlockWindow = 5
+
+// 判断 pow mint 指定的区块是否在有效区块内
+func IsInPoWMintWindow(effectiveBlock, currentBlock uint64) bool {
+	return max(effectiveBlock, currentBlock)-min(effectiveBlock, currentBlock) <= PoWMintBlockWindow
+}
+
 type IERCPoWTick struct {
 	ID            int64                       `json:"id,omitempty"`
 	Tick          string                      `json:"tick,omitempty"`
@@ -43,6 +51,7 @@ type IERCPoWTick struct {
 
 	LastUpdateBlock uint64    `json:"last_update_block"` // 最后空投区块
 	Creator         string    `json:"creator"`
+	DeployBlock     uint64    `json:"deploy_block,omitempty"` // 部署区块
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 
@@ -73,6 +82,7 @@ func NewIERCPoWTickFromDeployCommand(command *protocol.DeployPoWCommand) *IERCPo
 		PoSLastBlock:  command.BlockNumber,
 		PoSBurnAmount: decimal.Zero,
 		Creator:       command.From,
+		DeployBlock:   command.BlockNumber,
 		CreatedAt:     command.EventAt,
 		UpdatedAt:     command.EventAt,
 
@@ -86,6 +96,7 @@ func NewIERCPoWTickFromDeployCommand(command *protocol.DeployPoWCommand) *IERCPo
 func (entity *IERCPoWTick) GetID() int64                   { return entity.ID }
 func (entity *IERCPoWTick) GetName() string                { return entity.Tick }
 func (entity *IERCPoWTick) GetProtocol() protocol.Protocol { return entity.Protocol }
+func (entity *IERCPoWTick) GetDecimals() int64             { return entity.Decimals }
 func (entity *IERCPoWTick) LastUpdatedBlock() uint64 {
 	return max(entity.PoWLastBlock, entity.PoSLastBlock, entity.LastUpdateBlock)
 }
@@ -238,8 +249,7 @@ func (entity *IERCPoWTick) CanMint(params *PoWMintParams) error {
 		}
 
 		// 判断是否在有效区块内
-		var diff = max(params.EffectiveBlock, params.CurrentBlock) - min(params.EffectiveBlock, params.CurrentBlock)
-		if diff > 5 {
+		if !IsInPoWMintWindow(params.EffectiveBlock, params.CurrentBlock) {
 			return protocol.NewProtocolError(protocol.MintBlockExpires, "block expires")
 		}
 
@@ -250,8 +260,7 @@ func (entity *IERCPoWTick) CanMint(params *PoWMintParams) error {
 		}
 
 		// 判断是否在有效区块内
-		var diff = max(params.EffectiveBlock, params.CurrentBlock) - min(params.EffectiveBlock, params.CurrentBlock)
-		if diff > 5 {
+		if !IsInPoWMintWindow(params.EffectiveBlock, params.CurrentBlock) {
 			return protocol.NewProtocolError(protocol.MintBlockExpires, "block expires")
 		}
 
@@ -464,6 +473,108 @@ func (entity *IERCPoWTick) Burn() (decimal.Decimal, decimal.Decimal) {
 	return powBurn, posBurn
 }
 
+// pow mint 预估参数
+type PoWMintEstimateParams struct {
+	CurrentBlock      uint64            // 预计交易被打包的区块
+	EffectiveBlock    uint64            // mint 指定的区块
+	Hash              string            // 交易hash
+	CompetitionShares []decimal.Decimal // 近期区块的 pow 总份额, 用于估算奖励范围. 为空时不估算
+}
+
+// pow mint 预估结果
+type PoWMintEstimate struct {
+	Share          decimal.Decimal // hash 对应的份额
+	Difficulty     int             // hash 的难度(前导零个数)
+	MinDifficulty  int             // 最小难度
+	WindowValid    bool            // 是否在有效区块内
+	RewardBlockNum uint64          // 最多累积的奖励区块数
+	OutputOfBlock  decimal.Decimal // 打包区块的 pow 产出数量
+	CanMintAmount  decimal.Decimal // 打包区块可以 mint 的 pow 总数量
+	BurnAmount     decimal.Decimal // 打包区块需要销毁的 pow 数量
+	MinReward      decimal.Decimal // 按近期最大竞争份额计算的奖励
+	MaxReward      decimal.Decimal // 按近期最小竞争份额计算的奖励
+}
+
+// 预估 pow mint 的份额和奖励. 不修改 tick 状态
+func (entity *IERCPoWTick) EstimatePoWMint(params *PoWMintEstimateParams) *PoWMintEstimate {
+
+	var estimate = &PoWMintEstimate{
+		Share:          entity.CalculateMintShareBasedOnHash(params.CurrentBlock, params.Hash),
+		Difficulty:     countLeadingZeros(params.Hash),
+		MinDifficulty:  countLeadingZeros(entity.Rule.MinWorkC),
+		WindowValid:    IsInPoWMintWindow(params.EffectiveBlock, params.CurrentBlock),
+		RewardBlockNum: entity.getRewardBlockNum(params.CurrentBlock, true),
+		OutputOfBlock:  entity.calcOutputAmountOfBlock(params.CurrentBlock).Mul(entity.Rule.PoWPercentage()),
+		CanMintAmount:  decimal.Zero,
+		BurnAmount:     decimal.Zero,
+		MinReward:      decimal.Zero,
+		MaxReward:      decimal.Zero,
+	}
+
+	// 打包区块已经处理过 pow mint, 无法再计算可 mint 数量
+	if params.CurrentBlock > entity.PoWLastBlock {
+		estimate.CanMintAmount, estimate.BurnAmount = entity.calcCanMintAndBurnAmount(
+			entity.PoWLastBlock,
+			params.CurrentBlock,
+			entity.Rule.PoWPercentage(),
+			entity.PoWRemainSupply(),
+			estimate.RewardBlockNum,
+		)
+	}
+
+	if estimate.Share.IsZero() || !estimate.WindowValid || estimate.CanMintAmount.IsZero() {
+		return estimate
+	}
+
+	// 没有统计样本时无法估算竞争情况, 奖励范围为 0
+	if len(params.CompetitionShares) == 0 {
+		return estimate
+	}
+
+	// 没有竞争时独占当前区块的产出
+	var minCompetition, maxCompetition = decimal.Zero, decimal.Zero
+	for idx, share := range params.CompetitionShares {
+		if idx == 0 {
+			minCompetition, maxCompetition = share, share
+			continue
+		}
+
+		minCompetition = decimal.Min(minCompetition, share)
+		maxCompetition = decimal.Max(maxCompetition, share)
+	}
+
+	// canMint * minerShare / (minerShare + competitionShare)
+	calcReward := func(competition decimal.Decimal) decimal.Decimal {
+		return estimate.CanMintAmount.Mul(estimate.Share).Div(estimate.Share.Add(competition)).RoundFloor(18)
+	}
+	estimate.MinReward = calcReward(maxCompetition)
+	estimate.MaxReward = calcReward(minCompetition)
+
+	return estimate
+}
+
+// mint 进度, 百分比. 已发行量包含 pow、pos 和空投
+func (entity *IERCPoWTick) MintProgress() decimal.Decimal {
+	return mintProgress(entity.Supply(), entity.MaxSupply)
+}
+
+// 是否还可以 mint
+func (entity *IERCPoWTick) IsMintable() bool {
+	return entity.remainSupply().GreaterThan(MinDecimal)
+}
+
+// 指定区块的 pow 和 pos 产出数量. 不超过各自的剩余发行量
+func (entity *IERCPoWTick) EmissionOfBlock(blockNumber uint64) (decimal.Decimal, decimal.Decimal) {
+	if !entity.IsMintable() || entity.Rule.TotalRatio().IsZero() {
+		return decimal.Zero, decimal.Zero
+	}
+
+	output := entity.calcOutputAmountOfBlock(blockNumber)
+	pow := decimal.Min(output.Mul(entity.Rule.PoWPercentage()), entity.PoWRemainSupply())
+	pos := decimal.Min(output.Mul(entity.Rule.PoSPercentage()), entity.PoSRemainSupply())
+	return pow, pos
+}
+
 func countLeadingZeros(hash string) int {
 	// 删除0x前缀（如果存在）
 	hash = strings.TrimPrefix(hash, "0x")
@@ -2,9 +2,24 @@ package tick
 
 import (
 	"context"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 )
 
+// tick 查询条件. 为空的条件不参与过滤
+type TickQuery struct {
+	Protocol protocol.Protocol
+	Creator  string
+	Mintable bool  // 只返回还可以 mint 的 tick
+	Cursor   int64 // 上一页最后一条记录的ID, 0 表示第一页
+	Limit    int
+}
+
 type TickRepository interface {
 	Load(ctx context.Context, name string) (Tick, error)
+	// 批量加载, 不存在的 tick 不在结果中
+	LoadMany(ctx context.Context, names ...string) ([]Tick, error)
 	Save(ctx context.Context, entities ...Tick) error
+	// 按部署顺序查询
+	Query(ctx context.Context, query *TickQuery) ([]Tick, error)
 }
@@ -2,13 +2,17 @@ package tick
 
 import (
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/shopspring/decimal"
 )
 
 type Tick interface {
 	GetID() int64                   // tick ID
 	GetName() string                // tick 名称
 	GetProtocol() protocol.Protocol // tick 所属协议
+	GetDecimals() int64             // tick 精度
 	LastUpdatedBlock() uint64
+	MintProgress() decimal.Decimal // mint 进度, 百分比
+	IsMintable() bool              // 是否还可以 mint
 	Marshal() ([]byte, error)
 	Unmarshal([]byte) error
 }
@@ -17,3 +21,14 @@ var (
 	_ Tick = (*IERC20Tick)(nil)
 	_ Tick = (*IERCPoWTick)(nil)
 )
+
+var hundred = decimal.NewFromInt(100)
+
+// 计算 mint 进度百分比, 保留 4 位小数
+func mintProgress(supply, maxSupply decimal.Decimal) decimal.Decimal {
+	if maxSupply.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero
+	}
+
+	return decimal.Min(supply.Mul(hundred).Div(maxSupply).RoundFloor(4), hundred)
+}
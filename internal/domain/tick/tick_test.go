@@ -41,19 +41,21 @@ func (s *TestTickSuite) TestPoWMint() {
 			PosRatio:        decimal.NewFromInt(50),
 			PosPool:         "0x0000000000000000000000000000000000000000",
 		},
-		Supply:             decimal.Zero,
-		PoWAmount:          decimal.Zero,
-		PoSAmount:          decimal.Zero,
-		LastUpdatedAtBlock: 5053048,
-		Creator:            "",
-		CreatedAt:          time.Time{},
-		UpdatedAt:          time.Time{},
+		PoWSupply:       decimal.Zero,
+		PoSSupply:       decimal.Zero,
+		PoWLastBlock:    5053048,
+		PoSLastBlock:    5053048,
+		LastUpdateBlock: 5053048,
+		Creator:         "",
+		CreatedAt:       time.Time{},
+		UpdatedAt:       time.Time{},
 	}
 
-	s.Equal("0", entity.Supply.String(), "supply error")
+	s.Equal("0", entity.Supply().String(), "supply error")
 
 	pow, pos := entity.Mint(&PoWMintParams{
-		BlockNumber:   5053086,
+		CurrentBlock:  5053086,
+		IsDPoS:        true,
 		TotalPoWShare: decimal.Zero,
 		MinerPoWShare: decimal.Zero,
 		TotalPoSShare: decimal.NewFromInt(500),
@@ -61,11 +63,12 @@ func (s *TestTickSuite) TestPoWMint() {
 	})
 	s.Equal("0", pow.String())
 	s.Equal("19000", pos.String())
-	s.Equal("19000", entity.Supply.String())
+	s.Equal("19000", entity.Supply().String())
 	s.Equal(uint64(5053086), entity.LastUpdatedBlock())
 
 	pow, pos = entity.Mint(&PoWMintParams{
-		BlockNumber:   5053095,
+		CurrentBlock:  5053095,
+		IsDPoS:        true,
 		TotalPoWShare: decimal.Zero,
 		MinerPoWShare: decimal.Zero,
 		TotalPoSShare: decimal.NewFromInt(500),
@@ -73,11 +76,12 @@ func (s *TestTickSuite) TestPoWMint() {
 	})
 	s.Equal("0", pow.String())
 	s.Equal("4500", pos.String())
-	s.Equal("23500", entity.Supply.String())
+	s.Equal("23500", entity.Supply().String())
 	s.Equal(uint64(5053095), entity.LastUpdatedBlock())
 
 	pow, pos = entity.Mint(&PoWMintParams{
-		BlockNumber:   5053166,
+		CurrentBlock:  5053166,
+		IsDPoS:        true,
 		TotalPoWShare: decimal.Zero,
 		MinerPoWShare: decimal.Zero,
 		TotalPoSShare: decimal.NewFromInt(500),
@@ -85,7 +89,102 @@ func (s *TestTickSuite) TestPoWMint() {
 	})
 	s.Equal("0", pow.String())
 	s.Equal("35500", pos.String())
-	s.Equal("59000", entity.Supply.String())
+	s.Equal("59000", entity.Supply().String())
 	s.Equal(uint64(5053166), entity.LastUpdatedBlock())
 
 }
+
+func (s *TestTickSuite) TestMintProgress() {
+	cases := []struct {
+		name      string
+		supply    int64
+		maxSupply int64
+		progress  string
+		mintable  bool
+	}{
+		{name: "not started", supply: 0, maxSupply: 1000, progress: "0", mintable: true},
+		{name: "in progress", supply: 333, maxSupply: 1000, progress: "33.3", mintable: true},
+		{name: "round floor", supply: 1, maxSupply: 3, progress: "33.3333", mintable: true},
+		{name: "mint done", supply: 1000, maxSupply: 1000, progress: "100", mintable: false},
+		{name: "over max supply", supply: 1200, maxSupply: 1000, progress: "100", mintable: false},
+		{name: "zero max supply", supply: 0, maxSupply: 0, progress: "0", mintable: false},
+	}
+
+	for _, c := range cases {
+		entity := &IERC20Tick{Supply: decimal.NewFromInt(c.supply), MaxSupply: decimal.NewFromInt(c.maxSupply)}
+		s.Equal(c.progress, entity.MintProgress().String(), c.name)
+		s.Equal(c.mintable, entity.IsMintable(), c.name)
+	}
+}
+
+func (s *TestTickSuite) TestPoWMintProgress() {
+	cases := []struct {
+		name      string
+		airdrop   string
+		powSupply string
+		posSupply string
+		progress  string
+		mintable  bool
+	}{
+		{name: "not started", airdrop: "0", powSupply: "0", posSupply: "0", progress: "0", mintable: true},
+		{name: "airdrop included", airdrop: "100", powSupply: "450", posSupply: "0", progress: "55", mintable: true},
+		{name: "mint done", airdrop: "100", powSupply: "450", posSupply: "450", progress: "100", mintable: false},
+		{name: "dust remain", airdrop: "100", powSupply: "450", posSupply: "449.999999999999999999", progress: "100", mintable: false},
+	}
+
+	for _, c := range cases {
+		entity := &IERCPoWTick{
+			MaxSupply:     decimal.NewFromInt(1000),
+			AirdropAmount: decimal.RequireFromString(c.airdrop),
+			PoWSupply:     decimal.RequireFromString(c.powSupply),
+			PoSSupply:     decimal.RequireFromString(c.posSupply),
+		}
+		s.Equal(c.progress, entity.MintProgress().String(), c.name)
+		s.Equal(c.mintable, entity.IsMintable(), c.name)
+	}
+}
+
+func (s *TestTickSuite) TestEmissionOfBlock() {
+	newEntity := func(powRatio, posRatio int64, powSupply string) *IERCPoWTick {
+		return &IERCPoWTick{
+			MaxSupply: decimal.NewFromInt(1000),
+			Tokenomics: []protocol.TokenomicsDetail{
+				{BlockNumber: 100, Amount: decimal.NewFromInt(10)},
+				{BlockNumber: 200, Amount: decimal.NewFromInt(4)},
+			},
+			Rule: protocol.DistributionRule{
+				PowRatio: decimal.NewFromInt(powRatio),
+				PosRatio: decimal.NewFromInt(posRatio),
+			},
+			PoWSupply: decimal.RequireFromString(powSupply),
+			PoSSupply: decimal.Zero,
+		}
+	}
+
+	cases := []struct {
+		name        string
+		entity      *IERCPoWTick
+		blockNumber uint64
+		pow         string
+		pos         string
+	}{
+		{name: "before tokenomics", entity: newEntity(60, 40, "0"), blockNumber: 50, pow: "0", pos: "0"},
+		{name: "first stage", entity: newEntity(60, 40, "0"), blockNumber: 150, pow: "6", pos: "4"},
+		{name: "second stage", entity: newEntity(60, 40, "0"), blockNumber: 250, pow: "2.4", pos: "1.6"},
+		{name: "limited by pow remain supply", entity: newEntity(60, 40, "599"), blockNumber: 150, pow: "1", pos: "4"},
+		{name: "zero ratio", entity: newEntity(0, 0, "0"), blockNumber: 150, pow: "0", pos: "0"},
+	}
+
+	for _, c := range cases {
+		pow, pos := c.entity.EmissionOfBlock(c.blockNumber)
+		s.Equal(c.pow, pow.String(), c.name)
+		s.Equal(c.pos, pos.String(), c.name)
+	}
+
+	// 发行完成后没有产出
+	done := newEntity(60, 40, "600")
+	done.PoSSupply = decimal.NewFromInt(400)
+	pow, pos := done.EmissionOfBlock(150)
+	s.True(pow.IsZero())
+	s.True(pos.IsZero())
+}
@@ -0,0 +1,172 @@
+package trade
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrInvalidInterval = errors.New("invalid interval")
+
+// K线周期
+type Interval string
+
+const (
+	Interval1m  Interval = "1m"
+	Interval5m  Interval = "5m"
+	Interval15m Interval = "15m"
+	Interval1h  Interval = "1h"
+	Interval4h  Interval = "4h"
+	Interval1d  Interval = "1d"
+)
+
+// 需要维护的所有K线周期
+var Intervals = []Interval{Interval1m, Interval5m, Interval15m, Interval1h, Interval4h, Interval1d}
+
+func ParseInterval(s string) (Interval, error) {
+	interval := Interval(s)
+	if interval.Duration() == 0 {
+		return "", ErrInvalidInterval
+	}
+
+	return interval, nil
+}
+
+func (i Interval) Duration() time.Duration {
+	switch i {
+	case Interval1m:
+		return time.Minute
+	case Interval5m:
+		return 5 * time.Minute
+	case Interval15m:
+		return 15 * time.Minute
+	case Interval1h:
+		return time.Hour
+	case Interval4h:
+		return 4 * time.Hour
+	case Interval1d:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// 时间所在K线的开盘时间. 按 UTC 对齐
+func (i Interval) OpenTime(t time.Time) time.Time {
+	return t.UTC().Truncate(i.Duration())
+}
+
+type CandleKey struct {
+	Tick     string
+	Interval Interval
+	OpenTime int64 // 开盘时间, unix 秒
+}
+
+// K线
+type Candle struct {
+	Tick             string
+	Interval         Interval
+	OpenTime         time.Time       // 开盘时间
+	Open             decimal.Decimal // 开盘价
+	High             decimal.Decimal // 最高价
+	Low              decimal.Decimal // 最低价
+	Close            decimal.Decimal // 收盘价
+	Volume           decimal.Decimal // 成交数量
+	QuoteVolume      decimal.Decimal // 成交额, 单位 ETH
+	Trades           int64           // 成交笔数
+	LastUpdatedBlock uint64          //
+}
+
+func NewCandle(tick string, interval Interval, openTime time.Time) *Candle {
+	return &Candle{
+		Tick:        tick,
+		Interval:    interval,
+		OpenTime:    openTime,
+		Open:        decimal.Zero,
+		High:        decimal.Zero,
+		Low:         decimal.Zero,
+		Close:       decimal.Zero,
+		Volume:      decimal.Zero,
+		QuoteVolume: decimal.Zero,
+	}
+}
+
+func (c *Candle) Key() CandleKey {
+	return CandleKey{Tick: c.Tick, Interval: c.Interval, OpenTime: c.OpenTime.Unix()}
+}
+
+// 累加一笔成交. 成交需要按时间顺序累加
+func (c *Candle) Add(trade *Trade) {
+	if c.Trades == 0 {
+		c.Open = trade.Price
+		c.High = trade.Price
+		c.Low = trade.Price
+	}
+
+	c.High = decimal.Max(c.High, trade.Price)
+	c.Low = decimal.Min(c.Low, trade.Price)
+	c.Close = trade.Price
+	c.Volume = c.Volume.Add(trade.Amount)
+	c.QuoteVolume = c.QuoteVolume.Add(trade.Value)
+	c.Trades++
+	c.LastUpdatedBlock = trade.BlockNumber
+}
+
+// 成交涉及的所有K线
+func CandleKeys(trades []*Trade) []CandleKey {
+	var (
+		keys = make([]CandleKey, 0)
+		seen = make(map[CandleKey]struct{})
+	)
+	for _, trade := range trades {
+		for _, interval := range Intervals {
+			key := CandleKey{Tick: trade.Tick, Interval: interval, OpenTime: interval.OpenTime(trade.TradedAt).Unix()}
+			if _, existed := seen[key]; existed {
+				continue
+			}
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// 将一个区块的成交合并到K线中, 返回需要更新的K线.
+// 已经包含该区块成交的K线不会重复累加, 区块重复处理时结果不变
+func MergeCandles(blockNumber uint64, existing []*Candle, trades []*Trade) []*Candle {
+	var (
+		candles = make(map[CandleKey]*Candle, len(existing))
+		result  = make([]*Candle, 0)
+	)
+	for _, candle := range existing {
+		candles[candle.Key()] = candle
+	}
+
+	var updated = make(map[CandleKey]struct{})
+	for _, trade := range trades {
+		for _, interval := range Intervals {
+			openTime := interval.OpenTime(trade.TradedAt)
+			key := CandleKey{Tick: trade.Tick, Interval: interval, OpenTime: openTime.Unix()}
+
+			candle, existed := candles[key]
+			if !existed {
+				candle = NewCandle(trade.Tick, interval, openTime)
+				candles[key] = candle
+			}
+
+			if _, existed := updated[key]; !existed {
+				if candle.LastUpdatedBlock >= blockNumber {
+					continue
+				}
+				updated[key] = struct{}{}
+				result = append(result, candle)
+			}
+
+			candle.Add(trade)
+		}
+	}
+
+	return result
+}
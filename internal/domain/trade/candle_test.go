@@ -0,0 +1,78 @@
+package trade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCandle(t *testing.T) {
+	suite.Run(t, new(TestCandleSuite))
+}
+
+type TestCandleSuite struct {
+	suite.Suite
+}
+
+func (s *TestCandleSuite) newTrade(position int, amount, value int64, blockNumber uint64, tradedAt time.Time) *Trade {
+	return NewTrade("0xaa", position, "", "ethi", "0xseller", "0xbuyer",
+		decimal.NewFromInt(amount), decimal.NewFromInt(value), blockNumber, tradedAt)
+}
+
+func (s *TestCandleSuite) TestInterval() {
+	t := time.Date(2024, 1, 2, 15, 47, 31, 0, time.UTC)
+
+	s.Equal(time.Date(2024, 1, 2, 15, 47, 0, 0, time.UTC), Interval1m.OpenTime(t))
+	s.Equal(time.Date(2024, 1, 2, 15, 45, 0, 0, time.UTC), Interval15m.OpenTime(t))
+	s.Equal(time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC), Interval4h.OpenTime(t))
+	s.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Interval1d.OpenTime(t))
+
+	_, err := ParseInterval("2m")
+	s.ErrorIs(err, ErrInvalidInterval)
+}
+
+func (s *TestCandleSuite) TestMergeCandles() {
+	t := time.Date(2024, 1, 2, 15, 47, 31, 0, time.UTC)
+	trades := []*Trade{
+		s.newTrade(0, 10, 20, 100, t), // 2
+		s.newTrade(1, 10, 50, 100, t), // 5
+		s.newTrade(2, 20, 20, 100, t), // 1
+	}
+
+	candles := MergeCandles(100, nil, trades)
+	s.Len(candles, len(Intervals))
+	s.Len(CandleKeys(trades), len(Intervals))
+
+	for _, candle := range candles {
+		s.True(candle.Open.Equal(decimal.NewFromInt(2)))
+		s.True(candle.High.Equal(decimal.NewFromInt(5)))
+		s.True(candle.Low.Equal(decimal.NewFromInt(1)))
+		s.True(candle.Close.Equal(decimal.NewFromInt(1)))
+		s.True(candle.Volume.Equal(decimal.NewFromInt(40)))
+		s.True(candle.QuoteVolume.Equal(decimal.NewFromInt(90)))
+		s.Equal(int64(3), candle.Trades)
+		s.Equal(uint64(100), candle.LastUpdatedBlock)
+	}
+
+	// 下一个区块, 1m 换了新的K线
+	next := []*Trade{s.newTrade(0, 1, 10, 105, t.Add(time.Minute))}
+	merged := MergeCandles(105, candles, next)
+	s.Len(merged, len(Intervals))
+	for _, candle := range merged {
+		if candle.Interval == Interval1m {
+			s.Equal(int64(1), candle.Trades)
+			s.True(candle.Open.Equal(decimal.NewFromInt(10)))
+			continue
+		}
+
+		s.Equal(int64(4), candle.Trades)
+		s.True(candle.Open.Equal(decimal.NewFromInt(2)))
+		s.True(candle.High.Equal(decimal.NewFromInt(10)))
+		s.True(candle.Close.Equal(decimal.NewFromInt(10)))
+	}
+
+	// 重复处理同一个区块不会重复累加
+	s.Empty(MergeCandles(105, merged, next))
+}
@@ -0,0 +1,38 @@
+package trade
+
+import (
+	"context"
+	"time"
+)
+
+// 成交查询条件. 为空的条件不参与过滤
+type TradeQuery struct {
+	Tick    string
+	Address string // 买家或卖家
+	Cursor  int64  // 上一页最后一条记录的ID, 0 表示第一页
+	Limit   int
+}
+
+// K线查询条件
+type CandleQuery struct {
+	Tick      string
+	Interval  Interval
+	StartTime time.Time // 开盘时间 >= StartTime, 零值不限制
+	EndTime   time.Time // 开盘时间 <= EndTime, 零值不限制
+	Limit     int       // 返回离 EndTime 最近的 Limit 根K线
+}
+
+type TradeRepository interface {
+	Save(ctx context.Context, trades ...*Trade) error
+	// 按成交倒序查询
+	Query(ctx context.Context, query *TradeQuery) ([]*Trade, error)
+	// 统计 since 之后的行情. 没有任何成交时返回 nil
+	Ticker(ctx context.Context, tick string, since time.Time) (*Ticker, error)
+}
+
+type CandleRepository interface {
+	Save(ctx context.Context, candles ...*Candle) error
+	Load(ctx context.Context, keys ...CandleKey) ([]*Candle, error)
+	// 按开盘时间正序返回
+	Query(ctx context.Context, query *CandleQuery) ([]*Candle, error)
+}
@@ -0,0 +1,65 @@
+package trade
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 价格的精度
+const pricePrecision = 18
+
+// 成交记录. 每个成功的 proxy_transfer 对应一条成交记录
+type Trade struct {
+	ID          int64
+	TradeID     string          // 成交ID. 由成交交易hash和交易中的位置组成
+	OrderID     string          // 对应的订单ID
+	Tick        string          // tick
+	Seller      string          // 卖家
+	Buyer       string          // 买家
+	Amount      decimal.Decimal // 成交数量
+	Value       decimal.Decimal // 成交总价, 单位 ETH
+	Price       decimal.Decimal // 单价. value / amount
+	TxHash      string          // 成交交易
+	BlockNumber uint64          // 成交区块
+	TradedAt    time.Time       // 成交时间. 区块时间
+}
+
+func NewTradeID(txHash string, position int) string {
+	return fmt.Sprintf("%s-%d", txHash, position)
+}
+
+func NewTrade(txHash string, position int, orderID, tick, seller, buyer string, amount, value decimal.Decimal, blockNumber uint64, tradedAt time.Time) *Trade {
+	price := decimal.Zero
+	if amount.GreaterThan(decimal.Zero) {
+		price = value.DivRound(amount, pricePrecision)
+	}
+
+	return &Trade{
+		TradeID:     NewTradeID(txHash, position),
+		OrderID:     orderID,
+		Tick:        tick,
+		Seller:      seller,
+		Buyer:       buyer,
+		Amount:      amount,
+		Value:       value,
+		Price:       price,
+		TxHash:      txHash,
+		BlockNumber: blockNumber,
+		TradedAt:    tradedAt,
+	}
+}
+
+// 行情统计. 统计时间窗口内的成交情况
+type Ticker struct {
+	Tick         string
+	LastPrice    decimal.Decimal // 最新成交价
+	LastTradedAt time.Time       // 最新成交时间
+	OpenPrice    decimal.Decimal // 窗口内第一笔成交价
+	High         decimal.Decimal // 窗口内最高价
+	Low          decimal.Decimal // 窗口内最低价
+	Volume       decimal.Decimal // 窗口内成交数量
+	QuoteVolume  decimal.Decimal // 窗口内成交额, 单位 ETH
+	Trades       int64           // 窗口内成交笔数
+}
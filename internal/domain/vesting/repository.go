@@ -0,0 +1,13 @@
+package vesting
+
+import (
+	"context"
+)
+
+type VestingRepository interface {
+	Save(ctx context.Context, entities ...*Vesting) error
+	// 加载接收者在指定 tick 下还未领取完的锁仓
+	Load(ctx context.Context, key VestingKey) ([]*Vesting, error)
+	// 查询锁仓. from、recipient、tick 为空时不作为过滤条件
+	Query(ctx context.Context, from, recipient, tick string) ([]*Vesting, error)
+}
@@ -0,0 +1,112 @@
+package vesting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type VestingKey struct {
+	Recipient string
+	Tick      string
+}
+
+func NewVestingKey(recipient, tick string) VestingKey {
+	return VestingKey{
+		Recipient: recipient,
+		Tick:      tick,
+	}
+}
+
+func (key *VestingKey) String() string {
+	return fmt.Sprintf("%s-%s", key.Recipient, key.Tick)
+}
+
+// 生成锁仓ID. 由交易hash和交易中的位置组成
+func NewVestID(txHash string, position int) string {
+	return fmt.Sprintf("%s-%d", txHash, position)
+}
+
+// 锁仓. 锁定的资产在 [StartBlock, EndBlock] 之间线性释放, CliffBlock 之前不释放.
+// 已释放数量按区块惰性计算, 接收者领取时才会划入可用余额
+type Vesting struct {
+	ID               int64
+	VestID           string          // 锁仓ID
+	Tick             string          // tick
+	From             string          // 锁仓发起人
+	Recipient        string          // 接收者
+	Total            decimal.Decimal // 锁仓总量
+	Released         decimal.Decimal // 已领取数量
+	StartBlock       uint64          // 开始释放区块
+	CliffBlock       uint64          // 锁定期结束区块
+	EndBlock         uint64          // 全部释放区块
+	Decimals         int32           // 释放数量的精度, 与 tick 的精度一致
+	LastUpdatedBlock uint64          //
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func NewVesting(vestID, tick, from, recipient string, total decimal.Decimal, start, cliff, end uint64, decimals int32) *Vesting {
+	return &Vesting{
+		ID:               0,
+		VestID:           vestID,
+		Tick:             tick,
+		From:             from,
+		Recipient:        recipient,
+		Total:            total,
+		Released:         decimal.Zero,
+		StartBlock:       start,
+		CliffBlock:       cliff,
+		EndBlock:         end,
+		Decimals:         decimals,
+		LastUpdatedBlock: 0,
+		CreatedAt:        time.Time{},
+		UpdatedAt:        time.Time{},
+	}
+}
+
+func (entity *Vesting) Key() VestingKey {
+	return NewVestingKey(entity.Recipient, entity.Tick)
+}
+
+// 截止到指定区块已经解锁的总量(包含已领取的部分)
+func (entity *Vesting) CalcVested(blockNumber uint64) decimal.Decimal {
+	if blockNumber < entity.CliffBlock || blockNumber < entity.StartBlock {
+		return decimal.Zero
+	}
+
+	if blockNumber >= entity.EndBlock {
+		return entity.Total
+	}
+
+	// 线性释放, 截断到 tick 的精度, 保证释放数量单调递增且不超过总量
+	var (
+		elapsed  = decimal.NewFromInt(int64(blockNumber - entity.StartBlock))
+		duration = decimal.NewFromInt(int64(entity.EndBlock - entity.StartBlock))
+	)
+	vested, _ := entity.Total.Mul(elapsed).QuoRem(duration, entity.Decimals)
+	return vested
+}
+
+// 指定区块可以领取的数量
+func (entity *Vesting) CalcReleasable(blockNumber uint64) decimal.Decimal {
+	return entity.CalcVested(blockNumber).Sub(entity.Released)
+}
+
+// 领取已释放的数量, 返回本次领取的数量
+func (entity *Vesting) Release(blockNumber uint64) decimal.Decimal {
+	releasable := entity.CalcReleasable(blockNumber)
+	if releasable.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero
+	}
+
+	entity.Released = entity.Released.Add(releasable)
+	entity.LastUpdatedBlock = blockNumber
+	return releasable
+}
+
+// 是否已经全部领取
+func (entity *Vesting) IsCompleted() bool {
+	return entity.Released.GreaterThanOrEqual(entity.Total)
+}
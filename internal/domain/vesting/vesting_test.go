@@ -0,0 +1,65 @@
+package vesting
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestVesting(t *testing.T) {
+	suite.Run(t, new(TestVestingSuite))
+}
+
+type TestVestingSuite struct {
+	suite.Suite
+}
+
+func (s *TestVestingSuite) TestLinear() {
+	entity := NewVesting("0x01-0", "ethi", "0x01", "0x02", decimal.NewFromInt(100), 100, 120, 200, 18)
+
+	// 锁定期内不释放
+	s.True(entity.CalcVested(99).IsZero())
+	s.True(entity.CalcVested(119).IsZero())
+
+	// 锁定期结束后按开始区块线性计算
+	s.True(entity.CalcVested(120).Equal(decimal.NewFromInt(20)))
+	s.True(entity.Release(150).Equal(decimal.NewFromInt(50)))
+	s.True(entity.CalcReleasable(150).IsZero())
+	s.True(entity.Release(150).IsZero())
+
+	// 结束后全部释放
+	s.True(entity.Release(300).Equal(decimal.NewFromInt(50)))
+	s.True(entity.IsCompleted())
+}
+
+func (s *TestVestingSuite) TestCliff() {
+	entity := NewVesting("0x01-0", "ethi", "0x01", "0x02", decimal.NewFromInt(3), 100, 100, 100, 18)
+
+	s.True(entity.CalcVested(99).IsZero())
+	s.True(entity.CalcVested(100).Equal(decimal.NewFromInt(3)))
+}
+
+func (s *TestVestingSuite) TestPrecision() {
+	entity := NewVesting("0x01-0", "ethi", "0x01", "0x02", decimal.NewFromInt(1), 0, 0, 3, 18)
+
+	// 截断不进位, 最后一个区块补齐
+	s.Equal("0.333333333333333333", entity.Release(1).String())
+	s.Equal("0.333333333333333333", entity.Release(2).String())
+	s.Equal("0.333333333333333334", entity.Release(3).String())
+	s.True(entity.IsCompleted())
+}
+
+func (s *TestVestingSuite) TestDecimals() {
+	entity := NewVesting("0x01-0", "ethi", "0x01", "0x02", decimal.NewFromInt(1), 0, 0, 3, 2)
+	// 按 tick 的精度截断
+	s.Equal("0.33", entity.Release(1).String())
+	s.Equal("0.33", entity.Release(2).String())
+	s.Equal("0.34", entity.Release(3).String())
+	s.True(entity.IsCompleted())
+
+	entity = NewVesting("0x01-0", "ethi", "0x01", "0x02", decimal.NewFromInt(10), 0, 0, 3, 0)
+	s.Equal("3", entity.Release(1).String())
+	s.Equal("3", entity.Release(2).String())
+	s.Equal("4", entity.Release(3).String())
+}
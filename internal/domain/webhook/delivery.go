@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"time"
+)
+
+type DeliveryStatus uint8
+
+const (
+	DeliveryPending   DeliveryStatus = iota + 1 // 待推送, 包含等待重试的记录
+	DeliverySucceeded                           // 推送成功
+	DeliveryDead                                // 多次失败后放弃, 可以通过重放接口重新推送
+)
+
+// 重试策略. 第 n 次失败后等待 BaseDelay * 2^(n-1), 最长 MaxDelay
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 10,
+	BaseDelay:   time.Second * 5,
+	MaxDelay:    time.Hour,
+}
+
+// 第 attempts 次失败后的等待时间
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// 推送记录. 一个区块对一个回调地址只生成一条记录
+type Delivery struct {
+	ID            int64
+	WebhookID     int64
+	BlockNumber   uint64
+	Payload       []byte // 推送的请求体, 生成后不再变化, 重试和重放时保持一致
+	Status        DeliveryStatus
+	Attempts      int       // 已经尝试的次数
+	NextAttemptAt time.Time // 下一次推送的时间
+	LastError     string
+	DeliveredAt   time.Time // 推送成功的时间
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewDelivery(webhookID int64, blockNumber uint64, payload []byte, now time.Time) *Delivery {
+	return &Delivery{
+		WebhookID:     webhookID,
+		BlockNumber:   blockNumber,
+		Payload:       payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: now,
+	}
+}
+
+func (d *Delivery) Succeed(now time.Time) {
+	d.Attempts++
+	d.Status = DeliverySucceeded
+	d.LastError = ""
+	d.DeliveredAt = now
+}
+
+// Fail 记录一次失败. 达到最大次数后转入死信
+func (d *Delivery) Fail(reason string, now time.Time, policy RetryPolicy) {
+	d.Attempts++
+	d.LastError = reason
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = DeliveryDead
+		return
+	}
+
+	d.NextAttemptAt = now.Add(policy.Backoff(d.Attempts))
+}
+
+// Abandon 直接转入死信, 用于回调地址被删除或停用的情况
+func (d *Delivery) Abandon(reason string) {
+	d.Status = DeliveryDead
+	d.LastError = reason
+}
+
+// Replay 重新推送. 重置尝试次数, 立即推送
+func (d *Delivery) Replay(now time.Time) {
+	d.Status = DeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+}
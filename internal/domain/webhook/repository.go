@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"time"
+)
+
+// 推送记录查询条件. 零值不过滤
+type DeliveryQuery struct {
+	WebhookID int64
+	Status    DeliveryStatus
+	Cursor    int64 // 上一页最后一条记录的ID, 按ID倒序翻页
+	Limit     int
+}
+
+// 推送记录重放条件. IDs 为空时重放回调地址下所有的死信
+type ReplayQuery struct {
+	WebhookID int64
+	IDs       []int64
+}
+
+// 入队进度. 与订阅事件流时的位置一致, 重启后从这里继续生成推送记录
+type Cursor struct {
+	Cursor    uint64
+	LastBlock uint64
+}
+
+type WebhookRepository interface {
+	Create(ctx context.Context, entity *Webhook) error
+	// 更新回调地址的地址、密钥、过滤条件和启用状态
+	Update(ctx context.Context, entity *Webhook) error
+	Delete(ctx context.Context, id int64) error
+	// 不存在时返回 ErrWebhookNotFound
+	Get(ctx context.Context, id int64) (*Webhook, error)
+	List(ctx context.Context) ([]*Webhook, error)
+
+	LoadCursor(ctx context.Context) (Cursor, error)
+	// 在同一个事务中保存推送记录并更新入队进度
+	Enqueue(ctx context.Context, cursor Cursor, deliveries ...*Delivery) error
+	// 加载到期需要推送的记录, 按下一次推送时间升序
+	FetchDue(ctx context.Context, now time.Time, limit int) ([]*Delivery, error)
+	SaveDelivery(ctx context.Context, entity *Delivery) error
+	QueryDeliveries(ctx context.Context, query *DeliveryQuery) ([]*Delivery, error)
+	// 将推送失败(死信)或已经成功的记录重新放回队列, 返回重放的数量
+	Replay(ctx context.Context, query *ReplayQuery, now time.Time) (int64, error)
+}
+
+// 推送请求的发送方. 返回错误时推送记录按重试策略重试
+type Sender interface {
+	Send(ctx context.Context, target *Webhook, delivery *Delivery) error
+}
@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidURL      = errors.New("invalid webhook url")
+	ErrWebhookNotFound = errors.New("webhook not found")
+	ErrWebhookDisabled = errors.New("webhook disabled")
+)
+
+const (
+	// 签名请求头. 格式: t=<unix 秒>,v1=<hex(hmac_sha256(secret, "<t>.<body>"))>
+	SignatureHeader = "X-Indexer-Signature"
+	// 推送记录ID, 重试时不变, 接收方可以用于去重
+	DeliveryHeader = "X-Indexer-Delivery"
+	// 签名时间与当前时间允许的最大误差, 超过后视为重放的请求
+	SignatureTolerance = time.Minute * 5
+)
+
+// 回调地址. 订阅方注册后, 每个命中过滤条件的区块都会推送一次
+type Webhook struct {
+	ID         int64
+	URL        string
+	Secret     string   // 签名密钥
+	Ticks      []string // 为空时不过滤
+	Addresses  []string // 规范格式的地址, 为空时不过滤
+	Operates   []string // 为空时不过滤
+	StartBlock uint64   // 只推送大于该区块的事件. 注册时为最后处理的区块, 避免推送注册之前的历史事件
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 解析域名, 测试时替换
+var lookupIPAddr = net.DefaultResolver.LookupIPAddr
+
+// 校验回调地址, 只支持 http 和 https. 域名解析出的所有地址都必须是公网地址,
+// 避免通过回调访问内网服务. 推送时还会在建立连接时再次检查, 防止解析结果被替换
+func ValidateURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("%w: non-public address %s", ErrInvalidURL, ip)
+		}
+		return nil
+	}
+
+	addrs, err := lookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("%w: resolve %s failed", ErrInvalidURL, host)
+	}
+
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return fmt.Errorf("%w: %s resolves to non-public address %s", ErrInvalidURL, host, addr.IP)
+		}
+	}
+
+	return nil
+}
+
+// 是否为公网地址. 回环、私有、链路本地、组播和未指定地址都不允许推送
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified())
+}
+
+// Sign 计算推送请求的签名头
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%d.", timestamp)
+	_, _ = mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// Verify 校验签名头, 供接收方参考实现及测试使用. 签名时间与 now 相差超过 SignatureTolerance 时校验失败
+func Verify(secret, header string, body []byte, now time.Time) bool {
+	var timestamp int64
+	for _, part := range strings.Split(header, ",") {
+		if value, ok := strings.CutPrefix(part, "t="); ok {
+			if _, err := fmt.Sscanf(value, "%d", &timestamp); err != nil {
+				return false
+			}
+		}
+	}
+
+	if diff := now.Sub(time.Unix(timestamp, 0)); diff > SignatureTolerance || diff < -SignatureTolerance {
+		return false
+	}
+
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(header))
+}
+
+// 隐藏密钥, 只保留末尾4位
+func MaskSecret(secret string) string {
+	if len(secret) <= 4 {
+		return "****"
+	}
+	return "****" + secret[len(secret)-4:]
+}
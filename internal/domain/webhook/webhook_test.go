@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func TestWebhook(t *testing.T) {
+	suite.Run(t, new(TestWebhookSuite))
+}
+
+type TestWebhookSuite struct {
+	suite.Suite
+}
+
+func (s *TestWebhookSuite) TestSignature() {
+	var (
+		body   = []byte(`{"webhook_id":1,"block_number":100}`)
+		now    = time.Unix(1700000000, 0)
+		header = Sign("secret", now.Unix(), body)
+	)
+
+	s.Equal("t=1700000000,v1=", header[:len("t=1700000000,v1=")])
+	s.True(Verify("secret", header, body, now))
+	s.False(Verify("other", header, body, now))
+	s.False(Verify("secret", header, []byte(`{}`), now))
+	s.False(Verify("secret", "t=1700000001"+header[len("t=1700000000"):], body, now))
+	s.False(Verify("secret", "", body, now))
+
+	// 签名时间超出误差范围
+	s.True(Verify("secret", header, body, now.Add(SignatureTolerance)))
+	s.False(Verify("secret", header, body, now.Add(SignatureTolerance+time.Second)))
+	s.False(Verify("secret", header, body, now.Add(-SignatureTolerance-time.Second)))
+}
+
+func (s *TestWebhookSuite) TestValidateURL() {
+	ctx := context.Background()
+	defer func(fn func(ctx context.Context, host string) ([]net.IPAddr, error)) { lookupIPAddr = fn }(lookupIPAddr)
+	lookupIPAddr = func(_ context.Context, host string) ([]net.IPAddr, error) {
+		switch host {
+		case "example.com":
+			return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+		case "internal.example.com":
+			return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("10.0.0.1")}}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	s.NoError(ValidateURL(ctx, "https://example.com/hook"))
+	s.NoError(ValidateURL(ctx, "http://93.184.216.34:8080/hook"))
+	s.ErrorIs(ValidateURL(ctx, "ftp://example.com/hook"), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, "https://"), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, ""), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, "https://unknown.example.com/hook"), ErrInvalidURL)
+
+	// 非公网地址
+	s.ErrorIs(ValidateURL(ctx, "https://internal.example.com/hook"), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, "http://127.0.0.1:8080/hook"), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, "http://192.168.1.1/hook"), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, "http://169.254.169.254/latest/meta-data"), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, "http://[::1]/hook"), ErrInvalidURL)
+	s.ErrorIs(ValidateURL(ctx, "http://0.0.0.0/hook"), ErrInvalidURL)
+}
+
+func (s *TestWebhookSuite) TestMaskSecret() {
+	s.Equal("****", MaskSecret(""))
+	s.Equal("****", MaskSecret("abcd"))
+	s.Equal("****6789", MaskSecret("0123456789"))
+}
+
+func (s *TestWebhookSuite) TestBackoff() {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Second * 5}
+
+	s.Equal(time.Second, policy.Backoff(1))
+	s.Equal(time.Second*2, policy.Backoff(2))
+	s.Equal(time.Second*4, policy.Backoff(3))
+	s.Equal(time.Second*5, policy.Backoff(4))
+	s.Equal(time.Second*5, policy.Backoff(100))
+}
+
+func (s *TestWebhookSuite) TestDeliveryLifecycle() {
+	var (
+		now      = time.Unix(1700000000, 0)
+		policy   = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+		delivery = NewDelivery(1, 100, []byte(`{}`), now)
+	)
+	s.Equal(DeliveryPending, delivery.Status)
+	s.Equal(now, delivery.NextAttemptAt)
+
+	delivery.Fail("timeout", now, policy)
+	s.Equal(DeliveryPending, delivery.Status)
+	s.Equal(now.Add(time.Second), delivery.NextAttemptAt)
+
+	delivery.Fail("timeout", now, policy)
+	s.Equal(now.Add(time.Second*2), delivery.NextAttemptAt)
+
+	// 达到最大次数后转入死信
+	delivery.Fail("timeout", now, policy)
+	s.Equal(DeliveryDead, delivery.Status)
+	s.Equal(3, delivery.Attempts)
+	s.Equal("timeout", delivery.LastError)
+
+	delivery.Replay(now)
+	s.Equal(DeliveryPending, delivery.Status)
+	s.Equal(0, delivery.Attempts)
+
+	delivery.Succeed(now)
+	s.Equal(DeliverySucceeded, delivery.Status)
+	s.Equal(now, delivery.DeliveredAt)
+	s.Empty(delivery.LastError)
+}
@@ -1,6 +1,8 @@
 package facade
 
 import (
+	"fmt"
+	"net"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/encoding/json"
@@ -12,6 +14,7 @@ import (
 	"github.com/google/wire"
 	pb "github.com/kevin88886/eth_indexer/api/indexer"
 	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/facade/graphql"
 	"github.com/kevin88886/eth_indexer/internal/facade/handler"
 	"github.com/kevin88886/eth_indexer/pkg/middleware"
 	"github.com/kevin88886/eth_indexer/pkg/middleware/logging"
@@ -23,12 +26,18 @@ import (
 // ProviderSet is server providers.
 var ProviderSet = wire.NewSet(
 	handler.NewIndexHandler,
+	handler.NewAdminHandler,
+	handler.NewStakingHandler,
+	handler.NewMiningHandler,
+	handler.NewMarketHandler,
+	graphql.NewHandler,
 	NewGRPCServer,
 	NewHTTPServer,
+	NewAdminServer,
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(conf *conf.Config, h *handler.IndexHandler, logger log.Logger) *grpc.Server {
+func NewGRPCServer(conf *conf.Config, h *handler.IndexHandler, sh *handler.StakingHandler, mh *handler.MiningHandler, mkh *handler.MarketHandler, logger log.Logger) *grpc.Server {
 	c := conf.Bootstrap.Server
 
 	var opts = []grpc.ServerOption{
@@ -56,6 +65,9 @@ func NewGRPCServer(conf *conf.Config, h *handler.IndexHandler, logger log.Logger
 
 	srv := grpc.NewServer(opts...)
 	pb.RegisterIndexerServer(srv, h)
+	pb.RegisterStakingServer(srv, sh)
+	pb.RegisterMiningServer(srv, mh)
+	pb.RegisterMarketServer(srv, mkh)
 	return srv
 }
 
@@ -73,7 +85,7 @@ func init() {
 }
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(config *conf.Config, h *handler.IndexHandler, logger log.Logger) *http.Server {
+func NewHTTPServer(config *conf.Config, h *handler.IndexHandler, sh *handler.StakingHandler, mh *handler.MiningHandler, mkh *handler.MarketHandler, gh *graphql.Handler, logger log.Logger) *http.Server {
 	c := config.Server
 
 	var opts = []http.ServerOption{
@@ -84,6 +96,8 @@ func NewHTTPServer(config *conf.Config, h *handler.IndexHandler, logger log.Logg
 			validate.Validator(),
 		),
 		http.Timeout(time.Second * 30),
+		// 流式接口在路由之前处理, 不受超时时间限制
+		http.Filter(newStreamGateway(h, c.Http.GetStreamAllowedOrigins(), logger).Filter),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
@@ -97,5 +111,64 @@ func NewHTTPServer(config *conf.Config, h *handler.IndexHandler, logger log.Logg
 
 	srv := http.NewServer(opts...)
 	pb.RegisterIndexerHTTPServer(srv, h)
+	pb.RegisterStakingHTTPServer(srv, sh)
+	pb.RegisterMiningHTTPServer(srv, mh)
+	pb.RegisterMarketHTTPServer(srv, mkh)
+	srv.Handle(graphql.Path, gh)
 	return srv
 }
+
+const defaultAdminAddr = "127.0.0.1:12302"
+
+// AdminServer 管理接口服务. 单独监听, 不和公开接口共用端口
+type AdminServer struct {
+	*http.Server
+}
+
+// NewAdminServer new an admin HTTP server.
+func NewAdminServer(config *conf.Config, ah *handler.AdminHandler, logger log.Logger) (*AdminServer, error) {
+	c := config.Server.GetAdmin()
+
+	addr := defaultAdminAddr
+	if c.GetAddr() != "" {
+		addr = c.GetAddr()
+	}
+	// 没有配置访问令牌时只允许监听本机地址
+	if c.GetToken() == "" && !isLoopbackAddr(addr) {
+		return nil, fmt.Errorf("admin server listening on %s requires a token", addr)
+	}
+
+	var opts = []http.ServerOption{
+		http.Middleware(
+			recovery.Recovery(),
+			logging.Server(logger),
+			middleware.BearerToken(c.GetToken()),
+			validate.Validator(),
+		),
+		http.Address(addr),
+		http.Timeout(time.Second * 30),
+	}
+	if c.GetNetwork() != "" {
+		opts = append(opts, http.Network(c.GetNetwork()))
+	}
+	if c.GetTimeout() != nil {
+		opts = append(opts, http.Timeout(c.GetTimeout().AsDuration()))
+	}
+
+	srv := http.NewServer(opts...)
+	pb.RegisterAdminHTTPServer(srv, ah)
+	return &AdminServer{Server: srv}, nil
+}
+
+func isLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
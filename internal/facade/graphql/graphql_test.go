@@ -0,0 +1,453 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	alice = "0x00000000000000000000000000000000000a11ce"
+	bob   = "0x0000000000000000000000000000000000000b0b"
+)
+
+func TestHandler(t *testing.T) {
+	suite.Run(t, new(TestHandlerSuite))
+}
+
+type TestHandlerSuite struct {
+	suite.Suite
+	ticks    *mockTickRepo
+	holders  *mockHolderRepo
+	balances *mockBalanceRepo
+	events   *mockEventRepo
+	handler  *Handler
+	server   *httptest.Server
+}
+
+func (s *TestHandlerSuite) SetupSuite() {
+	domain.RegisterEventCodec(domain.NewEventCodec(func(e *domain.IERC20TransferredEvent) domain.EventIndex {
+		return domain.EventIndex{ETHFrom: e.From, IERCFrom: e.Data.From, IERCTo: e.Data.To, Tick: e.Data.Tick, Amount: e.Data.Amount}
+	}))
+}
+
+func (s *TestHandlerSuite) SetupTest() {
+	s.ticks = &mockTickRepo{ticks: map[string]tick.Tick{
+		"ethi": &tick.IERC20Tick{ID: 1, Tick: "ethi", Protocol: protocol.ProtocolIERC20, Decimals: 18, MaxSupply: decimal.NewFromInt(1000), Supply: decimal.NewFromInt(300), Creator: alice},
+		"ierc": &tick.IERC20Tick{ID: 2, Tick: "ierc", Protocol: protocol.ProtocolIERC20, Decimals: 18, MaxSupply: decimal.NewFromInt(1000), Supply: decimal.NewFromInt(1000), Creator: bob},
+	}}
+	s.holders = &mockHolderRepo{stats: map[string]*holder.Stats{
+		"ethi": {Tick: "ethi", Holders: 2},
+		"ierc": {Tick: "ierc", Holders: 1},
+	}}
+	s.balances = &mockBalanceRepo{holders: map[string][]*balance.Balance{
+		"ethi": {
+			{Address: alice, Tick: "ethi", Available: decimal.NewFromInt(200), Freeze: decimal.Zero},
+			{Address: bob, Tick: "ethi", Available: decimal.NewFromInt(90), Freeze: decimal.NewFromInt(10)},
+		},
+	}}
+	s.events = &mockEventRepo{events: []domain.Event{
+		newTransferred(12, "ethi", alice, bob, 10),
+		newTransferred(11, "ierc", bob, alice, 5),
+		newTransferred(10, "ethi", alice, bob, 20),
+	}}
+
+	s.handler = NewHandler(s.events, &mockBlockRepo{}, s.ticks, s.balances, s.holders, nil, log.DefaultLogger)
+	s.server = httptest.NewServer(s.handler)
+}
+
+func (s *TestHandlerSuite) TearDownTest() {
+	s.server.Close()
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (s *TestHandlerSuite) post(query string, variables map[string]interface{}) response {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	s.Require().NoError(err)
+
+	resp, err := http.Post(s.server.URL, "application/json", bytes.NewReader(body))
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	var result response
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&result))
+	return result
+}
+
+// tick → 持仓地址 → 地址最近的事件 → 事件的 tick
+func (s *TestHandlerSuite) TestNestedQuery() {
+	result := s.post(`query($name: String!) {
+		tick(name: $name) {
+			name
+			supply
+			holderCount
+			creator { address }
+			holders(first: 2) {
+				nodes {
+					total
+					account {
+						address
+						events(first: 2) {
+							nodes { name blockNumber amount iercTo { address } tick { name holderCount } }
+						}
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}
+	}`, map[string]interface{}{"name": "ethi"})
+	s.Require().Empty(result.Errors)
+
+	type eventData struct {
+		Name        string
+		BlockNumber int
+		Amount      string
+		IercTo      struct{ Address string }
+		Tick        struct {
+			Name        string
+			HolderCount int
+		}
+	}
+	var data struct {
+		Tick struct {
+			Name        string
+			Supply      string
+			HolderCount int
+			Creator     struct{ Address string }
+			Holders     struct {
+				Nodes []struct {
+					Total   string
+					Account struct {
+						Address string
+						Events  struct{ Nodes []eventData }
+					}
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+	s.Require().NoError(json.Unmarshal(result.Data, &data))
+
+	s.Equal("ethi", data.Tick.Name)
+	s.Equal("300", data.Tick.Supply)
+	s.Equal(2, data.Tick.HolderCount)
+	s.Equal(alice, data.Tick.Creator.Address)
+
+	s.Require().Len(data.Tick.Holders.Nodes, 2)
+	s.True(data.Tick.Holders.PageInfo.HasNextPage)
+	s.Equal("100_"+bob, data.Tick.Holders.PageInfo.EndCursor)
+
+	first := data.Tick.Holders.Nodes[0]
+	s.Equal("200", first.Total)
+	s.Equal(alice, first.Account.Address)
+	s.Require().Len(first.Account.Events.Nodes, 2)
+	s.Equal("IERC20Transferred", first.Account.Events.Nodes[0].Name)
+	s.Equal(12, first.Account.Events.Nodes[0].BlockNumber)
+	s.Equal("10", first.Account.Events.Nodes[0].Amount)
+	s.Equal(bob, first.Account.Events.Nodes[0].IercTo.Address)
+	s.Equal("ethi", first.Account.Events.Nodes[0].Tick.Name)
+	s.Equal(2, first.Account.Events.Nodes[0].Tick.HolderCount)
+	s.Equal("ierc", first.Account.Events.Nodes[1].Tick.Name)
+	s.Equal(1, first.Account.Events.Nodes[1].Tick.HolderCount)
+
+	// 关联的 tick 和持仓统计在请求内去重并合并查询
+	s.Equal([]string{"ethi", "ierc"}, s.ticks.loaded())
+	s.LessOrEqual(len(s.holders.batches), 2)
+	s.ElementsMatch([]string{"ethi", "ierc"}, s.holders.loaded())
+}
+
+// 列表元素的关联查询合并为一批
+func (s *TestHandlerSuite) TestBatchLoad() {
+	result := s.post(`{ ticks(first: 10) { nodes { name holderCount events(first: 1) { nodes { blockNumber } } } pageInfo { hasNextPage } } }`, nil)
+	s.Require().Empty(result.Errors)
+
+	var data struct {
+		Ticks struct {
+			Nodes []struct {
+				Name        string
+				HolderCount int
+				Events      struct {
+					Nodes []struct{ BlockNumber int }
+				}
+			}
+			PageInfo struct{ HasNextPage bool }
+		}
+	}
+	s.Require().NoError(json.Unmarshal(result.Data, &data))
+	s.Require().Len(data.Ticks.Nodes, 2)
+	s.Equal("ethi", data.Ticks.Nodes[0].Name)
+	s.Equal(2, data.Ticks.Nodes[0].HolderCount)
+	s.Equal(1, data.Ticks.Nodes[1].HolderCount)
+	s.False(data.Ticks.PageInfo.HasNextPage)
+	s.Require().Len(data.Ticks.Nodes[0].Events.Nodes, 1)
+	s.Equal(12, data.Ticks.Nodes[0].Events.Nodes[0].BlockNumber)
+	s.Require().Len(data.Ticks.Nodes[1].Events.Nodes, 1)
+	s.Equal(11, data.Ticks.Nodes[1].Events.Nodes[0].BlockNumber)
+
+	s.Require().Len(s.holders.batches, 1)
+	s.ElementsMatch([]string{"ethi", "ierc"}, s.holders.batches[0])
+	s.Equal(1, s.events.batches)
+}
+
+// 超过查询代价上限的列表返回错误
+func (s *TestHandlerSuite) TestQueryCost() {
+	var fields []string
+	for i := 0; i <= maxQueryCost/maxQueryLimit; i++ {
+		fields = append(fields, fmt.Sprintf("e%d: events(first: %d) { nodes { name } }", i, maxQueryLimit))
+	}
+
+	result := s.post("{ "+strings.Join(fields, " ")+" }", nil)
+	s.Require().NotEmpty(result.Errors)
+	s.Equal(errQueryCostExceeded.Error(), result.Errors[0].Message)
+
+	// 未超过上限时正常返回
+	result = s.post(`{ ticks(first: 100) { nodes { holders(first: 100) { nodes { total } } } } }`, nil)
+	s.Empty(result.Errors)
+}
+
+func (s *TestHandlerSuite) TestTimeout() {
+	s.events.block = true
+	s.handler.timeout = time.Millisecond * 50
+
+	result := s.post(`{ events(first: 1) { nodes { name } } }`, nil)
+	s.Require().Len(result.Errors, 1)
+	s.Contains(result.Errors[0].Message, context.DeadlineExceeded.Error())
+}
+
+func (s *TestHandlerSuite) TestGet() {
+	resp, err := http.Get(s.server.URL + "?query=" + url.QueryEscape(`{ lastBlock tick(name: "none") { name } }`))
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("*", resp.Header.Get("Access-Control-Allow-Origin"))
+
+	var result response
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&result))
+	s.Empty(result.Errors)
+	s.JSONEq(`{"lastBlock": 12, "tick": null}`, string(result.Data))
+}
+
+func (s *TestHandlerSuite) TestInvalidRequest() {
+	resp, err := http.Post(s.server.URL, "application/json", bytes.NewReader([]byte(`{}`)))
+	s.Require().NoError(err)
+	resp.Body.Close()
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	result := s.post(`{ account(address: "invalid") { address } }`, nil)
+	s.Require().Len(result.Errors, 1)
+	s.Equal(errInvalidAddress.Error(), result.Errors[0].Message)
+
+	result = s.post(`{ ticks(after: "abc") { nodes { name } } }`, nil)
+	s.Require().Len(result.Errors, 1)
+	s.Equal(errInvalidCursor.Error(), result.Errors[0].Message)
+}
+
+// 批量查询中的 panic 转换为该批所有 key 的错误, 不影响进程
+func (s *TestHandlerSuite) TestLoaderPanic() {
+	l := newLoader(context.Background(), func(context.Context, []string) (map[string]int, error) {
+		panic("fetch failed")
+	})
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			_, err := l.Load(context.Background(), key)
+			s.ErrorContains(err, "fetch failed")
+		}(key)
+	}
+	wg.Wait()
+}
+
+func newTransferred(block uint64, tickName, from, to string, amount int64) domain.Event {
+	return &domain.IERC20TransferredEvent{
+		BlockNumber: block,
+		From:        from,
+		Data: &domain.IERC20Transferred{
+			Protocol: protocol.ProtocolIERC20,
+			Operate:  protocol.OpTransfer,
+			Tick:     tickName,
+			From:     from,
+			To:       to,
+			Amount:   decimal.NewFromInt(amount),
+		},
+	}
+}
+
+// 记录每个 tick 的加载次数
+type mockTickRepo struct {
+	tick.TickRepository
+	mu     sync.Mutex
+	ticks  map[string]tick.Tick
+	counts map[string]int
+}
+
+func (m *mockTickRepo) LoadMany(_ context.Context, names ...string) ([]tick.Tick, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.counts == nil {
+		m.counts = make(map[string]int)
+	}
+
+	var result []tick.Tick
+	for _, name := range names {
+		m.counts[name]++
+		if entity, ok := m.ticks[name]; ok {
+			result = append(result, entity)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTickRepo) Query(_ context.Context, query *tick.TickQuery) ([]tick.Tick, error) {
+	var result []tick.Tick
+	for _, entity := range m.ticks {
+		if entity.GetID() > query.Cursor {
+			result = append(result, entity)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GetID() < result[j].GetID() })
+	return result[:min(len(result), query.Limit)], nil
+}
+
+// 返回加载过的 tick. 每个 tick 只应该加载一次, 加载多次时重复出现
+func (m *mockTickRepo) loaded() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []string
+	for name, count := range m.counts {
+		for i := 0; i < count; i++ {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// 记录每一批查询的 tick
+type mockHolderRepo struct {
+	holder.HolderRepository
+	mu      sync.Mutex
+	stats   map[string]*holder.Stats
+	batches [][]string
+}
+
+func (m *mockHolderRepo) LoadStats(_ context.Context, ticks ...string) ([]*holder.Stats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches = append(m.batches, ticks)
+
+	var result []*holder.Stats
+	for _, name := range ticks {
+		if stats, ok := m.stats[name]; ok {
+			result = append(result, stats)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockHolderRepo) loaded() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []string
+	for _, batch := range m.batches {
+		result = append(result, batch...)
+	}
+	return result
+}
+
+type mockBalanceRepo struct {
+	balance.BalanceRepository
+	holders map[string][]*balance.Balance
+}
+
+func (m *mockBalanceRepo) QueryByTick(_ context.Context, query *balance.BalanceQuery) (*balance.Snapshot, error) {
+	balances := m.holders[query.Tick]
+	return &balance.Snapshot{BlockNumber: 12, Balances: balances[:min(len(balances), query.Limit)]}, nil
+}
+
+// 按区块倒序返回地址相关的事件. block 为 true 时阻塞到请求超时
+type mockEventRepo struct {
+	domain.EventRepository
+	mu      sync.Mutex
+	events  []domain.Event
+	batches int
+	block   bool
+}
+
+func (m *mockEventRepo) QueryEventsBatch(ctx context.Context, queries []*domain.EventQuery) ([]*domain.EventPage, error) {
+	if m.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	m.mu.Lock()
+	m.batches++
+	m.mu.Unlock()
+
+	var pages []*domain.EventPage
+	for _, query := range queries {
+		page, err := m.QueryEvents(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, page)
+	}
+	return pages, nil
+}
+
+func (m *mockEventRepo) QueryEvents(_ context.Context, query *domain.EventQuery) (*domain.EventPage, error) {
+	var page = &domain.EventPage{}
+	for _, item := range m.events {
+		index := domain.IndexEvent(item)
+		if query.Address != "" && index.IERCFrom != query.Address && index.IERCTo != query.Address && index.ETHFrom != query.Address {
+			continue
+		}
+		if query.Tick != "" && index.Tick != query.Tick {
+			continue
+		}
+		if len(page.Events) == query.Limit {
+			page.NextCursor = domain.EventCursor{BlockNumber: item.GetCurrentBlock(), ID: 1}.Encode()
+			break
+		}
+		page.Events = append(page.Events, item)
+	}
+	return page, nil
+}
+
+type mockBlockRepo struct {
+	domain.BlockRepository
+}
+
+func (m *mockBlockRepo) GetLastHandleBlock(context.Context) (*domain.BlockHeader, error) {
+	return &domain.BlockHeader{Number: 12}, nil
+}
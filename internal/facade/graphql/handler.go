@@ -0,0 +1,163 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/domain/staking"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+)
+
+const (
+	// 接口路径
+	Path = "/api/v2/graphql"
+
+	// 查询的最大嵌套层级
+	maxQueryDepth = 12
+	// 同时解析的字段数量. 与单页最大数量一致, 使列表元素的关联查询可以合并为一批
+	maxParallelism = maxQueryLimit
+	// 请求体的最大长度
+	maxRequestBodySize = 1 << 20
+	// 单个请求的最长执行时间, 超时后未完成的查询返回错误
+	queryTimeout = time.Second * 10
+)
+
+//go:embed schema.graphql
+var schema string
+
+// GraphQL 查询接口. 将 tick、余额、事件、交易和质押池以关联图的方式提供查询,
+// 例如 tick → 持仓地址 → 地址最近的事件. 关联对象通过请求内的加载器批量查询
+type Handler struct {
+	schema   *gql.Schema
+	resolver *resolver
+	timeout  time.Duration
+	logger   *log.Helper
+}
+
+func NewHandler(
+	eventRepo domain.EventRepository,
+	blockRepo domain.BlockRepository,
+	tickRepo tick.TickRepository,
+	balanceRepo balance.BalanceRepository,
+	holderRepo holder.HolderRepository,
+	stakingRepo staking.StakingRepository,
+	logger log.Logger,
+) *Handler {
+	helper := log.NewHelper(log.With(logger, "module", "graphql"))
+	r := &resolver{
+		eventRepo:   eventRepo,
+		blockRepo:   blockRepo,
+		tickRepo:    tickRepo,
+		balanceRepo: balanceRepo,
+		holderRepo:  holderRepo,
+		stakingRepo: stakingRepo,
+	}
+
+	return &Handler{
+		schema: gql.MustParseSchema(schema, r,
+			gql.UseStringDescriptions(),
+			gql.MaxDepth(maxQueryDepth),
+			gql.MaxParallelism(maxParallelism),
+			gql.Logger(panicLogger{logger: helper}),
+		),
+		resolver: r,
+		timeout:  queryTimeout,
+		logger:   helper,
+	}
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// 支持 GET 和 POST. GET 通过 query、operationName、variables 参数传递, POST 的请求体为 JSON
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// 与 HTTP 接口的跨域配置一致
+	header := w.Header()
+	header.Set("Access-Control-Allow-Origin", "*")
+	header.Set("Access-Control-Allow-Methods", "GET,POST,OPTIONS")
+	header.Set("Access-Control-Allow-Headers", "Content-Type,X-Requested-With,User-Agent,Content-Length,Authorization")
+
+	var req request
+	switch r.Method {
+	case http.MethodOptions:
+		w.WriteHeader(http.StatusNoContent)
+		return
+
+	case http.MethodGet:
+		values := r.URL.Query()
+		req.Query = values.Get("query")
+		req.OperationName = values.Get("operationName")
+		if variables := values.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				http.Error(w, "invalid variables", http.StatusBadRequest)
+				return
+			}
+		}
+
+	case http.MethodPost:
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodySize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if req.Query == "" {
+		http.Error(w, "query is required", http.StatusBadRequest)
+		return
+	}
+
+	// 字段解析的 panic 由 graphql 恢复, 这里兜底处理执行过程中的其他 panic
+	defer func() {
+		if v := recover(); v != nil {
+			h.logger.WithContext(r.Context()).Errorf("graphql handler panic: %v\n%s", v, debug.Stack())
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	ctx = withLoaders(ctx, h.resolver)
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	header.Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// 解析字段时的 panic 记录到日志, 查询返回错误
+type panicLogger struct {
+	logger *log.Helper
+}
+
+func (l panicLogger) LogPanic(ctx context.Context, value interface{}) {
+	l.logger.WithContext(ctx).Errorf("graphql resolver panic: %s", fmt.Sprint(value))
+}
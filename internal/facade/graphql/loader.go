@@ -0,0 +1,162 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/domain/staking"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+)
+
+const (
+	// 收集同一批查询的等待时间. 同一层级的字段和列表元素并发解析, 在等待时间内发起的查询合并为一次
+	loaderWait = time.Millisecond * 2
+	// 单批最多的查询数量, 达到后立即查询
+	loaderMaxBatch = 100
+)
+
+// 批量加载器. 在等待时间内收集查询的 key, 去重后一次性查询, 结果在请求内缓存.
+// fetch 返回的结果中不存在的 key 对应零值
+type loader[K comparable, V any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu    sync.Mutex
+	cache map[K]*loaderResult[V]
+	batch *loaderBatch[K, V]
+}
+
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	results []*loaderResult[V]
+	timer   *time.Timer
+	once    sync.Once
+}
+
+func newLoader[K comparable, V any](ctx context.Context, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		ctx:   ctx,
+		fetch: fetch,
+		cache: make(map[K]*loaderResult[V]),
+	}
+}
+
+func (l *loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	result, existed := l.cache[key]
+	if !existed {
+		result = &loaderResult[V]{done: make(chan struct{})}
+		l.cache[key] = result
+		l.enqueue(key, result)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// 调用时需要持有锁
+func (l *loader[K, V]) enqueue(key K, result *loaderResult[V]) {
+	if l.batch == nil {
+		b := &loaderBatch[K, V]{}
+		b.timer = time.AfterFunc(loaderWait, func() { l.dispatch(b) })
+		l.batch = b
+	}
+
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, result)
+
+	if len(b.keys) >= loaderMaxBatch {
+		l.batch = nil
+		b.timer.Stop()
+		go l.dispatch(b)
+	}
+}
+
+func (l *loader[K, V]) dispatch(b *loaderBatch[K, V]) {
+	b.once.Do(func() {
+		l.mu.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mu.Unlock()
+
+		values, err := l.safeFetch(b.keys)
+		for i, key := range b.keys {
+			b.results[i].value, b.results[i].err = values[key], err
+			close(b.results[i].done)
+		}
+	})
+}
+
+// 在单独的 goroutine 中执行, 不在 graphql 的 panic 恢复范围内, 将 panic 转换为整批查询的错误
+func (l *loader[K, V]) safeFetch(keys []K) (values map[K]V, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			values, err = nil, fmt.Errorf("loader panic: %v", v)
+		}
+	}()
+
+	return l.fetch(l.ctx, keys)
+}
+
+// 请求内的加载器. 每个请求单独创建, 缓存不会跨请求
+type loaders struct {
+	ticks        *loader[string, tick.Tick]
+	holderStats  *loader[string, *holder.Stats]
+	balances     *loader[balance.BalanceKey, *balance.Balance]
+	pools        *loader[string, *staking.PoolAggregate]
+	transactions *loader[string, *domain.Transaction]
+	txEvents     *loader[string, []domain.Event]
+	events       *loader[string, *domain.EventPage]
+
+	// 请求已经计入的查询代价, 见 queryLimit
+	cost atomic.Int64
+
+	lastBlock func() (uint64, error)
+	allPools  func() ([]*staking.PoolAggregate, error)
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, r *resolver) context.Context {
+	l := &loaders{
+		ticks:        newLoader(ctx, r.fetchTicks),
+		holderStats:  newLoader(ctx, r.fetchHolderStats),
+		balances:     newLoader(ctx, r.fetchBalances),
+		pools:        newLoader(ctx, r.fetchPools),
+		transactions: newLoader(ctx, r.fetchTransactions),
+		txEvents:     newLoader(ctx, r.fetchTxEvents),
+		events:       newLoader(ctx, r.fetchEvents),
+		lastBlock: sync.OnceValues(func() (uint64, error) {
+			return r.fetchLastBlock(ctx)
+		}),
+		allPools: sync.OnceValues(func() ([]*staking.PoolAggregate, error) {
+			return r.stakingRepo.QueryPools(ctx)
+		}),
+	}
+
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
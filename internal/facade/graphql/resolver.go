@@ -0,0 +1,414 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/staking"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+)
+
+const (
+	// 分页查询的默认返回数量
+	defaultQueryLimit = 20
+	// 分页查询的最大返回数量. 嵌套查询的数量会相乘, 比 HTTP 接口的限制更小
+	maxQueryLimit = 100
+	// 单个请求的最大查询代价. 每个列表按请求的数量计入代价, 嵌套的列表每个上层元素各计一次
+	maxQueryCost = 5000
+)
+
+var (
+	errInvalidAddress    = errors.New("invalid address")
+	errInvalidCursor     = errors.New("invalid cursor")
+	errQueryCostExceeded = errors.New("query cost exceeded")
+)
+
+// 根查询. 同时负责加载器的批量查询
+type resolver struct {
+	eventRepo   domain.EventRepository
+	blockRepo   domain.BlockRepository
+	tickRepo    tick.TickRepository
+	balanceRepo balance.BalanceRepository
+	holderRepo  holder.HolderRepository
+	stakingRepo staking.StakingRepository
+}
+
+func (r *resolver) LastBlock(ctx context.Context) (int32, error) {
+	blockNumber, err := loadersFromContext(ctx).lastBlock()
+	return int32(blockNumber), err
+}
+
+func (r *resolver) Tick(ctx context.Context, args struct{ Name string }) (*tickResolver, error) {
+	return r.loadTick(ctx, strings.TrimSpace(args.Name))
+}
+
+func (r *resolver) Ticks(ctx context.Context, args struct {
+	Protocol *string
+	Creator  *string
+	Mintable *bool
+	First    *int32
+	After    *string
+}) (*tickConnection, error) {
+	limit, err := queryLimit(ctx, args.First)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &tick.TickQuery{
+		Protocol: protocol.Protocol(strings.TrimSpace(stringValue(args.Protocol))),
+		Creator:  address.Canonical(stringValue(args.Creator)),
+		Mintable: args.Mintable != nil && *args.Mintable,
+		Limit:    limit,
+	}
+	if after := stringValue(args.After); after != "" {
+		cursor, err := strconv.ParseInt(after, 10, 64)
+		if err != nil || cursor < 0 {
+			return nil, errInvalidCursor
+		}
+		query.Cursor = cursor
+	}
+
+	entities, err := r.tickRepo.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &tickConnection{nodes: make([]*tickResolver, 0, len(entities))}
+	for _, entity := range entities {
+		conn.nodes = append(conn.nodes, &tickResolver{r: r, entity: entity})
+	}
+
+	if len(entities) == query.Limit {
+		conn.pageInfo.next = strconv.FormatInt(entities[len(entities)-1].GetID(), 10)
+	}
+
+	return conn, nil
+}
+
+func (r *resolver) Account(args struct{ Address string }) (*accountResolver, error) {
+	addr, err := address.Parse(args.Address)
+	if err != nil {
+		return nil, errInvalidAddress
+	}
+
+	return &accountResolver{r: r, address: addr.String()}, nil
+}
+
+func (r *resolver) Events(ctx context.Context, args eventsArgs) (*eventConnection, error) {
+	return r.queryEvents(ctx, args, nil)
+}
+
+func (r *resolver) Transaction(ctx context.Context, args struct{ Hash string }) (*transactionResolver, error) {
+	hash := strings.ToLower(strings.TrimSpace(args.Hash))
+	if hash == "" {
+		return nil, nil
+	}
+
+	tx, err := loadersFromContext(ctx).transactions.Load(ctx, hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+
+	return &transactionResolver{r: r, tx: tx}, nil
+}
+
+func (r *resolver) StakingPools(ctx context.Context, args struct{ Owner *string }) ([]*stakingPoolResolver, error) {
+	roots, err := loadersFromContext(ctx).allPools()
+	if err != nil {
+		return nil, err
+	}
+
+	owner := address.Canonical(stringValue(args.Owner))
+
+	var result = make([]*stakingPoolResolver, 0, len(roots))
+	for _, root := range roots {
+		if owner != "" && root.Owner != owner {
+			continue
+		}
+
+		result = append(result, &stakingPoolResolver{r: r, root: root})
+	}
+
+	return result, nil
+}
+
+func (r *resolver) StakingPool(ctx context.Context, args struct{ Address string }) (*stakingPoolResolver, error) {
+	return r.loadPool(ctx, address.Canonical(args.Address))
+}
+
+// 事件查询参数, 各个层级的事件列表共用
+type eventsArgs struct {
+	Filter *eventFilterInput
+	First  *int32
+	After  *string
+	Before *string
+	Desc   *bool
+}
+
+type eventFilterInput struct {
+	Address    *string
+	IercFrom   *string
+	IercTo     *string
+	EthFrom    *string
+	Tick       *string
+	Operate    *string
+	EventKinds *[]int32
+	TxHash     *string
+	Status     *string
+}
+
+// 查询事件. scope 用于限定上层对象的条件, 覆盖 filter 中的同名条件
+func (r *resolver) queryEvents(ctx context.Context, args eventsArgs, scope func(query *domain.EventQuery)) (*eventConnection, error) {
+	limit, err := queryLimit(ctx, args.First)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &domain.EventQuery{
+		After:  stringValue(args.After),
+		Before: stringValue(args.Before),
+		Desc:   args.Desc != nil && *args.Desc,
+		Limit:  limit,
+	}
+	if query.After != "" && query.Before != "" {
+		return nil, errors.New("after and before are mutually exclusive")
+	}
+
+	// 同一批的查询合并执行, 提前校验游标, 避免一个无效的游标导致整批查询失败
+	for _, cursor := range []string{query.After, query.Before} {
+		if cursor == "" {
+			continue
+		}
+		if _, err := domain.ParseEventCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	if filter := args.Filter; filter != nil {
+		query.Address = address.Canonical(stringValue(filter.Address))
+		query.IERCFrom = address.Canonical(stringValue(filter.IercFrom))
+		query.IERCTo = address.Canonical(stringValue(filter.IercTo))
+		query.ETHFrom = address.Canonical(stringValue(filter.EthFrom))
+		query.Tick = strings.TrimSpace(stringValue(filter.Tick))
+		query.Operate = strings.TrimSpace(stringValue(filter.Operate))
+		query.TxHash = strings.ToLower(strings.TrimSpace(stringValue(filter.TxHash)))
+
+		if filter.EventKinds != nil {
+			for _, kind := range *filter.EventKinds {
+				query.EventKinds = append(query.EventKinds, domain.EventKind(kind))
+			}
+		}
+
+		switch stringValue(filter.Status) {
+		case "SUCCESS":
+			query.Status = domain.EventStatusSuccess
+		case "FAILED":
+			query.Status = domain.EventStatusFailed
+		}
+	}
+
+	if scope != nil {
+		scope(query)
+	}
+
+	key, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := loadersFromContext(ctx).events.Load(ctx, string(key))
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &eventConnection{
+		nodes:    make([]*eventResolver, 0, len(page.Events)),
+		pageInfo: pageInfo{next: page.NextCursor, prev: page.PrevCursor},
+	}
+	for _, item := range page.Events {
+		conn.nodes = append(conn.nodes, newEventResolver(r, item))
+	}
+
+	return conn, nil
+}
+
+func (r *resolver) loadTick(ctx context.Context, name string) (*tickResolver, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	entity, err := loadersFromContext(ctx).ticks.Load(ctx, name)
+	if err != nil || entity == nil {
+		return nil, err
+	}
+
+	return &tickResolver{r: r, entity: entity}, nil
+}
+
+func (r *resolver) loadPool(ctx context.Context, pool string) (*stakingPoolResolver, error) {
+	if pool == "" {
+		return nil, nil
+	}
+
+	root, err := loadersFromContext(ctx).pools.Load(ctx, pool)
+	if err != nil || root == nil {
+		return nil, err
+	}
+
+	return &stakingPoolResolver{r: r, root: root}, nil
+}
+
+func (r *resolver) fetchTicks(ctx context.Context, names []string) (map[string]tick.Tick, error) {
+	entities, err := r.tickRepo.LoadMany(ctx, names...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 数据库按不区分大小写的方式匹配, 结果按查询时的名称返回
+	var found = make(map[string]tick.Tick, len(entities))
+	for _, entity := range entities {
+		found[strings.ToLower(entity.GetName())] = entity
+	}
+
+	var result = make(map[string]tick.Tick, len(names))
+	for _, name := range names {
+		if entity, ok := found[strings.ToLower(name)]; ok {
+			result[name] = entity
+		}
+	}
+
+	return result, nil
+}
+
+func (r *resolver) fetchHolderStats(ctx context.Context, ticks []string) (map[string]*holder.Stats, error) {
+	entities, err := r.holderRepo.LoadStats(ctx, ticks...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make(map[string]*holder.Stats, len(entities))
+	for _, entity := range entities {
+		result[entity.Tick] = entity
+	}
+
+	return result, nil
+}
+
+func (r *resolver) fetchBalances(ctx context.Context, keys []balance.BalanceKey) (map[balance.BalanceKey]*balance.Balance, error) {
+	snapshot, err := r.balanceRepo.GetMany(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 与 fetchTicks 一致, 结果按查询时的 key 返回
+	var found = make(map[balance.BalanceKey]*balance.Balance, len(snapshot.Balances))
+	for _, entity := range snapshot.Balances {
+		found[balance.NewBalanceKey(entity.Address, strings.ToLower(entity.Tick))] = entity
+	}
+
+	var result = make(map[balance.BalanceKey]*balance.Balance, len(keys))
+	for _, key := range keys {
+		if entity, ok := found[balance.NewBalanceKey(key.Address.String(), strings.ToLower(key.Tick))]; ok {
+			result[key] = entity
+		}
+	}
+
+	return result, nil
+}
+
+func (r *resolver) fetchPools(ctx context.Context, pools []string) (map[string]*staking.PoolAggregate, error) {
+	roots, err := r.stakingRepo.QueryPools(ctx, pools...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make(map[string]*staking.PoolAggregate, len(roots))
+	for _, root := range roots {
+		result[root.PoolAddress] = root
+	}
+
+	return result, nil
+}
+
+func (r *resolver) fetchTransactions(ctx context.Context, hashes []string) (map[string]*domain.Transaction, error) {
+	txs, err := r.blockRepo.QueryTransactionsByHashes(ctx, hashes...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make(map[string]*domain.Transaction, len(txs))
+	for _, tx := range txs {
+		result[tx.Hash] = tx
+	}
+
+	return result, nil
+}
+
+func (r *resolver) fetchTxEvents(ctx context.Context, hashes []string) (map[string][]domain.Event, error) {
+	return r.eventRepo.QueryEventsByHashes(ctx, hashes...)
+}
+
+// keys 为 json 编码的事件查询条件
+func (r *resolver) fetchEvents(ctx context.Context, keys []string) (map[string]*domain.EventPage, error) {
+	var queries = make([]*domain.EventQuery, 0, len(keys))
+	for _, key := range keys {
+		query := new(domain.EventQuery)
+		if err := json.Unmarshal([]byte(key), query); err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, query)
+	}
+
+	pages, err := r.eventRepo.QueryEventsBatch(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make(map[string]*domain.EventPage, len(keys))
+	for idx, key := range keys {
+		result[key] = pages[idx]
+	}
+
+	return result, nil
+}
+
+// 最后处理的区块号. 还没有处理任何区块时返回 0
+func (r *resolver) fetchLastBlock(ctx context.Context) (uint64, error) {
+	lastBlock, err := r.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil || lastBlock == nil {
+		return 0, err
+	}
+
+	return lastBlock.Number, nil
+}
+
+// 返回分页查询的数量, 并计入请求的查询代价. 超过代价上限时返回错误
+func queryLimit(ctx context.Context, first *int32) (int, error) {
+	limit := defaultQueryLimit
+	if first != nil && *first > 0 {
+		limit = int(min(*first, maxQueryLimit))
+	}
+
+	if loadersFromContext(ctx).cost.Add(int64(limit)) > maxQueryCost {
+		return 0, errQueryCostExceeded
+	}
+
+	return limit, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,654 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/staking"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/shopspring/decimal"
+)
+
+// 分页信息. 游标为空表示没有对应方向的下一页
+type pageInfo struct {
+	next string
+	prev string
+}
+
+func (p pageInfo) HasNextPage() bool { return p.next != "" }
+
+func (p pageInfo) EndCursor() *string { return optionalString(p.next) }
+
+func (p pageInfo) HasPreviousPage() bool { return p.prev != "" }
+
+func (p pageInfo) StartCursor() *string { return optionalString(p.prev) }
+
+type tickConnection struct {
+	nodes    []*tickResolver
+	pageInfo pageInfo
+}
+
+func (c *tickConnection) Nodes() []*tickResolver { return c.nodes }
+
+func (c *tickConnection) PageInfo() pageInfo { return c.pageInfo }
+
+type balanceConnection struct {
+	blockNumber uint64
+	nodes       []*balanceResolver
+	pageInfo    pageInfo
+}
+
+func (c *balanceConnection) BlockNumber() int32 { return int32(c.blockNumber) }
+
+func (c *balanceConnection) Nodes() []*balanceResolver { return c.nodes }
+
+func (c *balanceConnection) PageInfo() pageInfo { return c.pageInfo }
+
+type eventConnection struct {
+	nodes    []*eventResolver
+	pageInfo pageInfo
+}
+
+func (c *eventConnection) Nodes() []*eventResolver { return c.nodes }
+
+func (c *eventConnection) PageInfo() pageInfo { return c.pageInfo }
+
+type tickResolver struct {
+	r      *resolver
+	entity tick.Tick
+}
+
+func (t *tickResolver) Name() string { return t.entity.GetName() }
+
+func (t *tickResolver) Protocol() string { return string(t.entity.GetProtocol()) }
+
+func (t *tickResolver) Decimals() int32 {
+	switch ee := t.entity.(type) {
+	case *tick.IERC20Tick:
+		return int32(ee.Decimals)
+	case *tick.IERCPoWTick:
+		return int32(ee.Decimals)
+	}
+	return 0
+}
+
+func (t *tickResolver) MaxSupply() string {
+	switch ee := t.entity.(type) {
+	case *tick.IERC20Tick:
+		return ee.MaxSupply.String()
+	case *tick.IERCPoWTick:
+		return ee.MaxSupply.String()
+	}
+	return decimal.Zero.String()
+}
+
+func (t *tickResolver) Supply() string {
+	switch ee := t.entity.(type) {
+	case *tick.IERC20Tick:
+		return ee.Supply.String()
+	case *tick.IERCPoWTick:
+		return ee.Supply().String()
+	}
+	return decimal.Zero.String()
+}
+
+func (t *tickResolver) MintProgress() string { return t.entity.MintProgress().String() }
+
+func (t *tickResolver) Mintable() bool { return t.entity.IsMintable() }
+
+func (t *tickResolver) Creator() *accountResolver {
+	var creator string
+	switch ee := t.entity.(type) {
+	case *tick.IERC20Tick:
+		creator = ee.Creator
+	case *tick.IERCPoWTick:
+		creator = ee.Creator
+	}
+	return &accountResolver{r: t.r, address: address.Canonical(creator)}
+}
+
+func (t *tickResolver) DeployBlock() int32 {
+	switch ee := t.entity.(type) {
+	case *tick.IERC20Tick:
+		return int32(ee.DeployBlock)
+	case *tick.IERCPoWTick:
+		return int32(ee.DeployBlock)
+	}
+	return 0
+}
+
+func (t *tickResolver) LastUpdatedBlock() int32 { return int32(t.entity.LastUpdatedBlock()) }
+
+// 没有任何余额变化的 tick 没有统计, 持仓地址数为 0
+func (t *tickResolver) HolderCount(ctx context.Context) (int32, error) {
+	stats, err := loadersFromContext(ctx).holderStats.Load(ctx, t.entity.GetName())
+	if err != nil || stats == nil {
+		return 0, err
+	}
+
+	return int32(stats.Holders), nil
+}
+
+func (t *tickResolver) Holders(ctx context.Context, args struct {
+	First *int32
+	After *string
+}) (*balanceConnection, error) {
+	limit, err := queryLimit(ctx, args.First)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &balance.BalanceQuery{
+		Tick:   t.entity.GetName(),
+		Cursor: strings.TrimSpace(stringValue(args.After)),
+		Limit:  limit,
+	}
+
+	snapshot, err := t.r.balanceRepo.QueryByTick(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := newBalanceConnection(t.r, snapshot)
+	if len(snapshot.Balances) == query.Limit {
+		conn.pageInfo.next = balance.HolderCursor(snapshot.Balances[len(snapshot.Balances)-1])
+	}
+
+	return conn, nil
+}
+
+func (t *tickResolver) Events(ctx context.Context, args eventsArgs) (*eventConnection, error) {
+	return t.r.queryEvents(ctx, args, func(query *domain.EventQuery) {
+		query.Tick = t.entity.GetName()
+	})
+}
+
+type balanceResolver struct {
+	r      *resolver
+	entity *balance.Balance
+}
+
+func newBalanceConnection(r *resolver, snapshot *balance.Snapshot) *balanceConnection {
+	conn := &balanceConnection{
+		blockNumber: snapshot.BlockNumber,
+		nodes:       make([]*balanceResolver, 0, len(snapshot.Balances)),
+	}
+	for _, entity := range snapshot.Balances {
+		conn.nodes = append(conn.nodes, &balanceResolver{r: r, entity: entity})
+	}
+	return conn
+}
+
+func (b *balanceResolver) Account() *accountResolver {
+	return &accountResolver{r: b.r, address: b.entity.Address}
+}
+
+func (b *balanceResolver) TickName() string { return b.entity.Tick }
+
+func (b *balanceResolver) Tick(ctx context.Context) (*tickResolver, error) {
+	return b.r.loadTick(ctx, b.entity.Tick)
+}
+
+func (b *balanceResolver) Available() string { return b.entity.Available.String() }
+
+func (b *balanceResolver) Freeze() string { return b.entity.Freeze.String() }
+
+func (b *balanceResolver) Total() string { return b.entity.Total().String() }
+
+func (b *balanceResolver) Minted() string { return b.entity.MintedAmount.String() }
+
+func (b *balanceResolver) LastUpdatedBlock() int32 { return int32(b.entity.LastUpdatedBlock) }
+
+type accountResolver struct {
+	r       *resolver
+	address string // 规范格式的地址
+}
+
+func newAccountResolver(r *resolver, addr string) *accountResolver {
+	if addr = address.Canonical(addr); addr == "" {
+		return nil
+	}
+
+	return &accountResolver{r: r, address: addr}
+}
+
+func (a *accountResolver) Address() string { return a.address }
+
+func (a *accountResolver) Balance(ctx context.Context, args struct{ Tick string }) (*balanceResolver, error) {
+	key := balance.NewBalanceKey(a.address, strings.TrimSpace(args.Tick))
+	if key.Tick == "" {
+		return nil, nil
+	}
+
+	entity, err := loadersFromContext(ctx).balances.Load(ctx, key)
+	if err != nil || entity == nil {
+		return nil, err
+	}
+
+	return &balanceResolver{r: a.r, entity: entity}, nil
+}
+
+func (a *accountResolver) Balances(ctx context.Context, args struct {
+	First       *int32
+	After       *string
+	IncludeZero *bool
+}) (*balanceConnection, error) {
+	limit, err := queryLimit(ctx, args.First)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &balance.BalanceQuery{
+		Address:     a.address,
+		Cursor:      strings.TrimSpace(stringValue(args.After)),
+		Limit:       limit,
+		IncludeZero: args.IncludeZero != nil && *args.IncludeZero,
+	}
+
+	snapshot, err := a.r.balanceRepo.QueryByAddress(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := newBalanceConnection(a.r, snapshot)
+	if len(snapshot.Balances) == query.Limit {
+		conn.pageInfo.next = snapshot.Balances[len(snapshot.Balances)-1].Tick
+	}
+
+	return conn, nil
+}
+
+func (a *accountResolver) Events(ctx context.Context, args eventsArgs) (*eventConnection, error) {
+	return a.r.queryEvents(ctx, args, func(query *domain.EventQuery) {
+		query.Address = a.address
+	})
+}
+
+func (a *accountResolver) StakingPositions(ctx context.Context, args struct{ Pool *string }) ([]*stakingPositionResolver, error) {
+	var (
+		l     = loadersFromContext(ctx)
+		roots []*staking.PoolAggregate
+	)
+
+	if pool := address.Canonical(stringValue(args.Pool)); pool != "" {
+		root, err := l.pools.Load(ctx, pool)
+		if err != nil {
+			return nil, err
+		}
+		if root != nil {
+			roots = append(roots, root)
+		}
+	} else {
+		var err error
+		if roots, err = l.allPools(); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []*stakingPositionResolver
+	for _, root := range roots {
+		for _, pool := range sortedSubPools(root) {
+			position := pool.GetPosition(a.address)
+			if position == nil {
+				continue
+			}
+
+			result = append(result, &stakingPositionResolver{r: a.r, root: root, pool: pool, position: position})
+		}
+	}
+
+	return result, nil
+}
+
+type eventResolver struct {
+	r     *resolver
+	event domain.Event
+	index domain.EventIndex
+}
+
+func newEventResolver(r *resolver, event domain.Event) *eventResolver {
+	return &eventResolver{r: r, event: event, index: domain.IndexEvent(event)}
+}
+
+func (e *eventResolver) Kind() int32 { return int32(e.event.GetEventKind()) }
+
+func (e *eventResolver) Name() string { return strings.TrimPrefix(e.event.EventName(), "*domain.") }
+
+func (e *eventResolver) BlockNumber() int32 { return int32(e.event.GetCurrentBlock()) }
+
+func (e *eventResolver) PrevBlockNumber() int32 { return int32(e.event.GetPreviousBlock()) }
+
+func (e *eventResolver) TxHash() string { return e.event.GetTxHash() }
+
+func (e *eventResolver) Position() int32 { return int32(e.event.PosInIERCTxs()) }
+
+func (e *eventResolver) Protocol() string { return string(e.event.GetProtocol()) }
+
+func (e *eventResolver) Operate() string { return string(e.event.GetOperate()) }
+
+func (e *eventResolver) ErrCode() int32 { return e.event.GetErrCode() }
+
+func (e *eventResolver) ErrReason() string { return e.event.GetErrReason() }
+
+func (e *eventResolver) EventAt() int32 {
+	if at := e.event.GetEventAt(); !at.IsZero() {
+		return int32(at.Unix())
+	}
+	return 0
+}
+
+func (e *eventResolver) EthFrom() *accountResolver { return newAccountResolver(e.r, e.index.ETHFrom) }
+
+func (e *eventResolver) EthTo() *accountResolver { return newAccountResolver(e.r, e.index.ETHTo) }
+
+func (e *eventResolver) IercFrom() *accountResolver { return newAccountResolver(e.r, e.index.IERCFrom) }
+
+func (e *eventResolver) IercTo() *accountResolver { return newAccountResolver(e.r, e.index.IERCTo) }
+
+func (e *eventResolver) TickName() string { return e.index.Tick }
+
+func (e *eventResolver) Tick(ctx context.Context) (*tickResolver, error) {
+	return e.r.loadTick(ctx, e.index.Tick)
+}
+
+func (e *eventResolver) Amount() string { return e.index.Amount.String() }
+
+func (e *eventResolver) Transaction(ctx context.Context) (*transactionResolver, error) {
+	return e.r.Transaction(ctx, struct{ Hash string }{Hash: e.event.GetTxHash()})
+}
+
+func (e *eventResolver) Data() (string, error) {
+	data, err := json.Marshal(e.event)
+	return string(data), err
+}
+
+type transactionResolver struct {
+	r  *resolver
+	tx *domain.Transaction
+}
+
+func (t *transactionResolver) Hash() string { return t.tx.Hash }
+
+func (t *transactionResolver) BlockNumber() int32 { return int32(t.tx.BlockNumber) }
+
+func (t *transactionResolver) Position() int32 { return int32(t.tx.PositionInTxs) }
+
+func (t *transactionResolver) From() *accountResolver {
+	return &accountResolver{r: t.r, address: address.Canonical(t.tx.From)}
+}
+
+func (t *transactionResolver) To() *accountResolver { return newAccountResolver(t.r, t.tx.To) }
+
+func (t *transactionResolver) Value() string { return t.tx.TxValue.String() }
+
+func (t *transactionResolver) State() int32 { return int32(t.tx.State()) }
+
+func (t *transactionResolver) Code() int32 { return t.tx.Code }
+
+func (t *transactionResolver) Remark() string { return t.tx.Remark }
+
+func (t *transactionResolver) ErrorName() string { return protocol.ProtocolErrCode(t.tx.Code).Name() }
+
+func (t *transactionResolver) Protocol() string {
+	if t.tx.IERCTransaction == nil {
+		return ""
+	}
+	return string(t.tx.IERCTransaction.GetProtocol())
+}
+
+func (t *transactionResolver) Operate() string {
+	if t.tx.IERCTransaction == nil {
+		return ""
+	}
+	return string(t.tx.IERCTransaction.GetOperate())
+}
+
+func (t *transactionResolver) Command() (string, error) {
+	if t.tx.IERCTransaction == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(t.tx.IERCTransaction)
+	return string(data), err
+}
+
+// 待处理的交易还没有事件
+func (t *transactionResolver) Events(ctx context.Context) ([]*eventResolver, error) {
+	if !t.tx.IsProcessed {
+		return []*eventResolver{}, nil
+	}
+
+	events, err := loadersFromContext(ctx).txEvents.Load(ctx, t.tx.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make([]*eventResolver, 0, len(events))
+	for _, item := range events {
+		result = append(result, newEventResolver(t.r, item))
+	}
+
+	return result, nil
+}
+
+type stakingPoolResolver struct {
+	r    *resolver
+	root *staking.PoolAggregate
+}
+
+func (p *stakingPoolResolver) Address() string { return p.root.PoolAddress }
+
+func (p *stakingPoolResolver) Owner() *accountResolver {
+	return &accountResolver{r: p.r, address: p.root.Owner}
+}
+
+func (p *stakingPoolResolver) SubPools() []*stakingSubPoolResolver {
+	subPools := sortedSubPools(p.root)
+
+	var result = make([]*stakingSubPoolResolver, 0, len(subPools))
+	for _, pool := range subPools {
+		result = append(result, &stakingSubPoolResolver{r: p.r, root: p.root, pool: pool})
+	}
+
+	return result
+}
+
+func (p *stakingPoolResolver) SubPool(args struct{ Id int32 }) *stakingSubPoolResolver {
+	if args.Id < 0 {
+		return nil
+	}
+
+	pool, existed := p.root.GetStakingPool(uint64(args.Id))
+	if !existed {
+		return nil
+	}
+
+	return &stakingSubPoolResolver{r: p.r, root: p.root, pool: pool}
+}
+
+type stakingSubPoolResolver struct {
+	r    *resolver
+	root *staking.PoolAggregate
+	pool *staking.StakingPool
+}
+
+func (p *stakingSubPoolResolver) Pool() *stakingPoolResolver {
+	return &stakingPoolResolver{r: p.r, root: p.root}
+}
+
+func (p *stakingSubPoolResolver) Id() int32 { return int32(p.pool.PoolSubID) }
+
+func (p *stakingSubPoolResolver) Name() string { return p.pool.Detail.Name }
+
+func (p *stakingSubPoolResolver) Owner() *accountResolver {
+	return &accountResolver{r: p.r, address: address.Canonical(p.pool.Detail.Owner)}
+}
+
+func (p *stakingSubPoolResolver) Admins() []*accountResolver {
+	var result = make([]*accountResolver, 0, len(p.pool.Detail.Admins))
+	for _, admin := range p.pool.Detail.Admins {
+		if account := newAccountResolver(p.r, admin); account != nil {
+			result = append(result, account)
+		}
+	}
+	return result
+}
+
+func (p *stakingSubPoolResolver) StartBlock() int32 { return int32(p.pool.Detail.StartBlock) }
+
+func (p *stakingSubPoolResolver) StopBlock() int32 { return int32(p.pool.Detail.StopBlock) }
+
+func (p *stakingSubPoolResolver) StakerCount() int32 { return int32(p.pool.StakerCount()) }
+
+func (p *stakingSubPoolResolver) LastUpdatedBlock() int32 { return int32(p.pool.LastUpdatedBlock) }
+
+func (p *stakingSubPoolResolver) Ticks() []*stakingPoolTickResolver {
+	details := make([]*staking.PoolTickDetail, 0, len(p.pool.Detail.TickDetails))
+	for _, detail := range p.pool.Detail.TickDetails {
+		details = append(details, detail)
+	}
+	sort.Slice(details, func(i, j int) bool {
+		return details[i].Index < details[j].Index
+	})
+
+	var result = make([]*stakingPoolTickResolver, 0, len(details))
+	for _, detail := range details {
+		result = append(result, &stakingPoolTickResolver{r: p.r, detail: detail})
+	}
+	return result
+}
+
+func (p *stakingSubPoolResolver) Positions(ctx context.Context, args struct {
+	First *int32
+	After *string
+}) ([]*stakingPositionResolver, error) {
+	limit, err := queryLimit(ctx, args.First)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		after  = address.Canonical(stringValue(args.After))
+		result []*stakingPositionResolver
+	)
+
+	for _, position := range p.pool.GetPositions() {
+		if after != "" && position.Staker <= after {
+			continue
+		}
+
+		result = append(result, &stakingPositionResolver{r: p.r, root: p.root, pool: p.pool, position: position})
+		if len(result) == limit {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+type stakingPoolTickResolver struct {
+	r      *resolver
+	detail *staking.PoolTickDetail
+}
+
+func (t *stakingPoolTickResolver) TickName() string { return t.detail.Tick }
+
+func (t *stakingPoolTickResolver) Tick(ctx context.Context) (*tickResolver, error) {
+	return t.r.loadTick(ctx, t.detail.Tick)
+}
+
+func (t *stakingPoolTickResolver) Ratio() string { return t.detail.Ratio.String() }
+
+func (t *stakingPoolTickResolver) Amount() string { return t.detail.Amount.String() }
+
+func (t *stakingPoolTickResolver) MaxAmount() string { return t.detail.MaxAmount.String() }
+
+func (t *stakingPoolTickResolver) HistoryAmount() string { return t.detail.HistoryAmount.String() }
+
+type stakingPositionResolver struct {
+	r        *resolver
+	root     *staking.PoolAggregate
+	pool     *staking.StakingPool
+	position *staking.StakingPosition
+}
+
+func (p *stakingPositionResolver) Pool() *stakingPoolResolver {
+	return &stakingPoolResolver{r: p.r, root: p.root}
+}
+
+func (p *stakingPositionResolver) SubPool() *stakingSubPoolResolver {
+	return &stakingSubPoolResolver{r: p.r, root: p.root, pool: p.pool}
+}
+
+func (p *stakingPositionResolver) Staker() *accountResolver {
+	return &accountResolver{r: p.r, address: address.Canonical(p.position.Staker)}
+}
+
+func (p *stakingPositionResolver) Ticks() []*stakingPositionTickResolver {
+	var result = make([]*stakingPositionTickResolver, 0, len(p.position.TickDetails))
+	for _, detail := range p.position.TickDetails {
+		result = append(result, &stakingPositionTickResolver{r: p.r, detail: detail})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].detail.Tick < result[j].detail.Tick
+	})
+	return result
+}
+
+func (p *stakingPositionResolver) RewardsPerBlock() string {
+	return p.position.RewardsPerBlock.String()
+}
+
+func (p *stakingPositionResolver) AccRewards() string { return p.position.AccReward.String() }
+
+func (p *stakingPositionResolver) Debt() string { return p.position.Debt.String() }
+
+func (p *stakingPositionResolver) AvailableRewards(ctx context.Context) (string, error) {
+	blockNumber, err := loadersFromContext(ctx).lastBlock()
+	if err != nil {
+		return "", err
+	}
+
+	return p.pool.CalcAvailableRewards(blockNumber, p.position.Staker).String(), nil
+}
+
+func (p *stakingPositionResolver) LastRewardBlock() int32 { return int32(p.position.LastRewardBlock) }
+
+func (p *stakingPositionResolver) LastUpdatedBlock() int32 { return int32(p.position.LastUpdatedBlock) }
+
+type stakingPositionTickResolver struct {
+	r      *resolver
+	detail *staking.PositionTickDetail
+}
+
+func (t *stakingPositionTickResolver) TickName() string { return t.detail.Tick }
+
+func (t *stakingPositionTickResolver) Tick(ctx context.Context) (*tickResolver, error) {
+	return t.r.loadTick(ctx, t.detail.Tick)
+}
+
+func (t *stakingPositionTickResolver) Ratio() string { return t.detail.Ratio.String() }
+
+func (t *stakingPositionTickResolver) Amount() string { return t.detail.Amount.String() }
+
+// 按子池ID升序返回
+func sortedSubPools(root *staking.PoolAggregate) []*staking.StakingPool {
+	subPools := root.GetStakingPools()
+	sort.Slice(subPools, func(i, j int) bool {
+		return subPools[i].PoolSubID < subPools[j].PoolSubID
+	})
+	return subPools
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -1,37 +1,20 @@
 package handler
 
 import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
 	pb "github.com/kevin88886/eth_indexer/api/indexer"
 	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/allowance"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
 )
 
-func ConvertEventEntityToProtobuf(item domain.Event) *pb.Event {
-
-	switch ee := item.(type) {
-	case *domain.IERC20TickCreatedEvent:
-		return convertTickCreatedToPB(ee)
-
-	case *domain.IERC20MintedEvent:
-		return convertMintedToPB(ee)
-
-	case *domain.IERCPoWTickCreatedEvent:
-		return convertPowTickCreatedToPB(ee)
-
-	case *domain.IERCPoWMintedEvent:
-		return convertPowMintedToPB(ee)
-
-	case *domain.IERC20TransferredEvent:
-		return convertTickTransferredEventToPB(ee)
-
-	case *domain.StakingPoolUpdatedEvent:
-		return convertStakingPoolUpdatedToPB(ee)
-
-	default:
-		panic("invalid event type")
-	}
-}
-
 var operateMap = map[protocol.Operate]pb.Operate{
 	protocol.OpDeploy:          pb.Operate_Deploy,
 	protocol.OpMint:            pb.Operate_Mint,
@@ -45,6 +28,11 @@ var operateMap = map[protocol.Operate]pb.Operate{
 	protocol.OpProxyUnStaking:  pb.Operate_ProxyUnStake,
 	protocol.OpPoWModify:       pb.Operate_Modify,
 	protocol.OpPoWClaimAirdrop: pb.Operate_ClaimAirdrop,
+	protocol.OpBurn:            pb.Operate_Burn,
+	protocol.OpApprove:         pb.Operate_Approve,
+	protocol.OpTransferFrom:    pb.Operate_TransferFrom,
+	protocol.OpVest:            pb.Operate_Vest,
+	protocol.OpClaimVested:     pb.Operate_ClaimVested,
 }
 
 func convertOperate(operate protocol.Operate) pb.Operate {
@@ -55,199 +43,174 @@ func convertOperate(operate protocol.Operate) pb.Operate {
 	return op
 }
 
-func convertTickCreatedToPB(ee *domain.IERC20TickCreatedEvent) *pb.Event {
-	return &pb.Event{
-		BlockNumber:  ee.BlockNumber,
-		TxHash:       ee.TxHash,
-		PosInIercTxs: int32(ee.PositionInIERCTxs),
-		From:         ee.From,
-		To:           ee.To,
-		Value:        ee.Value,
-		EventAt:      ee.EventAt.UnixMilli(),
-		ErrCode:      ee.ErrCode,
-		ErrReason:    ee.ErrReason,
-		Event: &pb.Event_TickCreated{TickCreated: &pb.IERC20TickCreated{
-			Protocol:    string(ee.Data.Protocol),
-			Operate:     convertOperate(ee.Data.Operate),
-			Tick:        ee.Data.Tick,
-			Decimals:    ee.Data.Decimals,
-			MaxSupply:   ee.Data.MaxSupply.String(),
-			Limit:       ee.Data.Limit.String(),
-			WalletLimit: ee.Data.WalletLimit.String(),
-			Workc:       ee.Data.WorkC,
-			Creator:     ee.From,
-			Nonce:       ee.Data.Nonce,
-		}},
+func convertVestingToPB(entity *vesting.Vesting, blockNumber uint64) *pb.ListVestingsReply_Vesting {
+	return &pb.ListVestingsReply_Vesting{
+		VestId:     entity.VestID,
+		Tick:       entity.Tick,
+		From:       entity.From,
+		Recipient:  entity.Recipient,
+		Total:      entity.Total.String(),
+		Released:   entity.Released.String(),
+		Releasable: entity.CalcReleasable(blockNumber).String(),
+		StartBlock: entity.StartBlock,
+		CliffBlock: entity.CliffBlock,
+		EndBlock:   entity.EndBlock,
 	}
 }
 
-func convertMintedToPB(ee *domain.IERC20MintedEvent) *pb.Event {
-	return &pb.Event{
-		BlockNumber:  ee.BlockNumber,
-		TxHash:       ee.TxHash,
-		PosInIercTxs: int32(ee.PositionInIERCTxs),
-		From:         ee.From,
-		To:           ee.To,
-		Value:        ee.Value,
-		EventAt:      ee.EventAt.UnixMilli(),
-		ErrCode:      ee.ErrCode,
-		ErrReason:    ee.ErrReason,
-		Event: &pb.Event_Minted{Minted: &pb.IERC20Minted{
-			Protocol:     string(ee.Data.Protocol),
-			Operate:      convertOperate(ee.Data.Operate),
-			Tick:         ee.Data.Tick,
-			From:         ee.Data.From,
-			To:           ee.Data.To,
-			Nonce:        ee.Data.Nonce,
-			MintedAmount: ee.Data.MintedAmount.String(),
-			Gas:          ee.Data.Gas.String(),
-			GasPrice:     ee.Data.GasPrice.String(),
-		}},
+func convertTickToPB(entity tick.Tick, blockNumber uint64) *pb.Tick {
+	var data = &pb.Tick{
+		Tick:             entity.GetName(),
+		Protocol:         string(entity.GetProtocol()),
+		MintProgress:     entity.MintProgress().String(),
+		Mintable:         entity.IsMintable(),
+		LastUpdatedBlock: entity.LastUpdatedBlock(),
 	}
-}
 
-func convertPowTickCreatedToPB(ee *domain.IERCPoWTickCreatedEvent) *pb.Event {
-	return &pb.Event{
-		BlockNumber:  ee.BlockNumber,
-		TxHash:       ee.TxHash,
-		PosInIercTxs: int32(ee.PositionInIERCTxs),
-		From:         ee.From,
-		To:           ee.To,
-		Value:        ee.Value,
-		EventAt:      ee.EventAt.UnixMilli(),
-		ErrCode:      ee.ErrCode,
-		ErrReason:    ee.ErrReason,
-		Event: &pb.Event_PowTickCreated{PowTickCreated: &pb.IERCPoWTickCreated{
-			Protocol:          string(ee.Data.Protocol),
-			Operate:           convertOperate(ee.Data.Operate),
-			Tick:              ee.Data.Tick,
-			Decimals:          ee.Data.Decimals,
-			MaxSupply:         ee.Data.MaxSupply.String(),
-			TokenomicsDetails: convertTokenomicsDetails(ee.Data.Tokenomics),
-			Rule:              convertDistributionRuleToPB(&ee.Data.Rule),
-			Creator:           ee.From,
-		}},
+	switch ee := entity.(type) {
+	case *tick.IERC20Tick:
+		data.Decimals = ee.Decimals
+		data.MaxSupply = ee.MaxSupply.String()
+		data.Supply = ee.Supply.String()
+		data.Creator = ee.Creator
+		data.DeployBlock = ee.DeployBlock
+		data.DeployedAt = unixOrZero(ee.CreatedAt)
+		data.Limit = ee.Limit.String()
+		data.WalletLimit = ee.WalletLimit.String()
+		data.Workc = ee.WorkC
+		data.BurnedAmount = ee.BurnedAmount.String()
+
+	case *tick.IERCPoWTick:
+		data.Decimals = ee.Decimals
+		data.MaxSupply = ee.MaxSupply.String()
+		data.Supply = ee.Supply().String()
+		data.Creator = ee.Creator
+		data.DeployBlock = ee.DeployBlock
+		data.DeployedAt = unixOrZero(ee.CreatedAt)
+		data.PowSupply = ee.PoWSupply.String()
+		data.PowBurnAmount = ee.PoWBurnAmount.String()
+		data.PosSupply = ee.PoSSupply.String()
+		data.PosBurnAmount = ee.PoSBurnAmount.String()
+		data.AirdropAmount = ee.AirdropAmount.String()
+		data.DistributionRule = &pb.Tick_DistributionRule{
+			PowRatio:        ee.Rule.PowRatio.String(),
+			MinWorkc:        ee.Rule.MinWorkC,
+			DifficultyRatio: ee.Rule.DifficultyRatio.String(),
+			PosRatio:        ee.Rule.PosRatio.String(),
+			PosPool:         ee.Rule.PosPool,
+			MaxRewardBlock:  ee.Rule.MaxRewardBlockNum,
+		}
+
+		data.Tokenomics = make([]*pb.Tick_Tokenomics, 0, len(ee.Tokenomics))
+		for _, item := range ee.Tokenomics {
+			data.Tokenomics = append(data.Tokenomics, &pb.Tick_Tokenomics{
+				BlockNumber: item.BlockNumber,
+				Amount:      item.Amount.String(),
+			})
+		}
+
+		pow, pos := ee.EmissionOfBlock(blockNumber)
+		data.PowEmissionPerBlock = pow.String()
+		data.PosEmissionPerBlock = pos.String()
 	}
+
+	return data
 }
 
-func convertTokenomicsDetails(details []protocol.TokenomicsDetail) []*pb.IERCPoWTickCreated_TokenomicsDetail {
-	var result = make([]*pb.IERCPoWTickCreated_TokenomicsDetail, 0, len(details))
-	for _, detail := range details {
-		result = append(result, &pb.IERCPoWTickCreated_TokenomicsDetail{
-			BlockNumber: detail.BlockNumber,
-			Amount:      detail.Amount.String(),
-		})
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
 	}
-	return result
+
+	return t.Unix()
 }
 
-func convertDistributionRuleToPB(rule *protocol.DistributionRule) *pb.IERCPoWTickCreated_Rule {
-	return &pb.IERCPoWTickCreated_Rule{
-		PowRatio:        rule.PowRatio.String(),
-		MinWorkc:        rule.MinWorkC,
-		DifficultyRatio: rule.DifficultyRatio.String(),
-		PosRatio:        rule.PosRatio.String(),
-		PosPool:         rule.PosPool,
+func convertBalanceToPB(entity *balance.Balance) *pb.Balance {
+	return &pb.Balance{
+		Address:          entity.Address,
+		Tick:             entity.Tick,
+		Available:        entity.Available.String(),
+		Freeze:           entity.Freeze.String(),
+		Minted:           entity.MintedAmount.String(),
+		LastUpdatedBlock: entity.LastUpdatedBlock,
 	}
 }
 
-func convertPowMintedToPB(ee *domain.IERCPoWMintedEvent) *pb.Event {
-	return &pb.Event{
-		BlockNumber:  ee.BlockNumber,
-		TxHash:       ee.TxHash,
-		PosInIercTxs: int32(ee.PositionInIERCTxs),
-		From:         ee.From,
-		To:           ee.To,
-		Value:        ee.Value,
-		EventAt:      ee.EventAt.UnixMilli(),
-		ErrCode:      ee.ErrCode,
-		ErrReason:    ee.ErrReason,
-		Event: &pb.Event_PowMinted{PowMinted: &pb.IERCPoWMinted{
-			Protocol:        string(ee.Data.Protocol),
-			Operate:         convertOperate(ee.Data.Operate),
-			Tick:            ee.Data.Tick,
-			From:            ee.Data.From,
-			To:              ee.Data.To,
-			Nonce:           ee.Data.Nonce,
-			IsPow:           ee.Data.IsPoW,
-			PowTotalShare:   ee.Data.PoWTotalShare.String(),
-			PowMinerShare:   ee.Data.PoWMinerShare.String(),
-			PowMintedAmount: ee.Data.PoWMintedAmount.String(),
-			IsPos:           ee.Data.IsPoS,
-			PosTotalShare:   ee.Data.PoSTotalShare.String(),
-			PosMinerShare:   ee.Data.PoSMinerShare.String(),
-			PosMintedAmount: ee.Data.PoSMintedAmount.String(),
-			Gas:             ee.Data.Gas.String(),
-			GasPrice:        ee.Data.GasPrice.String(),
-			IsAirdrop:       ee.Data.IsAirdrop,
-			AirdropAmount:   ee.Data.AirdropAmount.String(),
-			BurnedAmount:    ee.Data.BurnAmount.String(),
-		}},
+func convertAllowanceToPB(entity *allowance.Allowance) *pb.ListAllowancesReply_Allowance {
+	return &pb.ListAllowancesReply_Allowance{
+		Owner:            entity.Owner,
+		Spender:          entity.Spender,
+		Tick:             entity.Tick,
+		Amount:           entity.Amount.String(),
+		SignNonce:        entity.SignNonce.String(),
+		LastUpdatedBlock: entity.LastUpdatedBlock,
 	}
 }
 
-func convertTickTransferredEventToPB(ee *domain.IERC20TransferredEvent) *pb.Event {
-	return &pb.Event{
-		BlockNumber:  ee.BlockNumber,
-		TxHash:       ee.TxHash,
-		PosInIercTxs: int32(ee.PositionInIERCTxs),
-		From:         ee.From,
-		To:           ee.To,
-		Value:        ee.Value,
-		EventAt:      ee.EventAt.UnixMilli(),
-		ErrCode:      ee.ErrCode,
-		ErrReason:    ee.ErrReason,
-		Event: &pb.Event_TickTransferred{TickTransferred: &pb.TickTransferred{
-			Protocol:    string(ee.Data.Protocol),
-			Operate:     convertOperate(ee.Data.Operate),
-			Tick:        ee.Data.Tick,
-			From:        ee.Data.From,
-			To:          ee.Data.To,
-			Amount:      ee.Data.Amount.String(),
-			EthValue:    ee.Data.EthValue.String(),
-			GasPrice:    ee.Data.GasPrice.String(),
-			SignerNonce: ee.Data.SignerNonce,
-			Sign:        ee.Data.Sign,
-		}},
+// 交易按记录归集事件, 记录按位置升序
+func convertTransactionToPB(tx *domain.Transaction, events []domain.Event) *pb.Transaction {
+	data := &pb.Transaction{
+		Hash:          tx.Hash,
+		BlockNumber:   tx.BlockNumber,
+		PositionInTxs: tx.PositionInTxs,
+		From:          tx.From,
+		To:            tx.To,
+		Value:         tx.TxValue.String(),
+		State:         pb.TransactionState(tx.State()),
+		Code:          tx.Code,
+		Remark:        tx.Remark,
+		ErrorName:     protocol.ProtocolErrCode(tx.Code).Name(),
+	}
+
+	if tx.IERCTransaction != nil {
+		data.Protocol = string(tx.IERCTransaction.GetProtocol())
+		data.Operate = string(tx.IERCTransaction.GetOperate())
+		if command, err := json.Marshal(tx.IERCTransaction); err == nil {
+			data.Command = string(command)
+		}
 	}
-}
 
-func convertStakingPoolUpdatedToPB(ee *domain.StakingPoolUpdatedEvent) *pb.Event {
-	return &pb.Event{
-		BlockNumber:  ee.BlockNumber,
-		TxHash:       ee.TxHash,
-		PosInIercTxs: int32(ee.PositionInIERCTxs),
-		From:         ee.From,
-		To:           ee.To,
-		Value:        ee.Value,
-		EventAt:      ee.EventAt.UnixMilli(),
-		ErrCode:      ee.ErrCode,
-		ErrReason:    ee.ErrReason,
-		Event: &pb.Event_PoolUpdated{PoolUpdated: &pb.StakingPoolUpdated{
-			Protocol:  string(ee.Data.Protocol),
-			Operate:   convertOperate(ee.Data.Operate),
-			From:      ee.From,
-			To:        ee.To,
-			Pool:      ee.Data.Pool,
-			PoolId:    ee.Data.PoolID,
-			Name:      ee.Data.Name,
-			Owner:     ee.Data.Owner,
-			Admins:    ee.Data.Admins,
-			Details:   convertTickConfigDetails(ee.Data.Details),
-			StopBlock: ee.Data.StopBlock,
-		}},
+	var records = make(map[int]*pb.Transaction_Record)
+	for _, item := range events {
+		event, err := ConvertEventEntityToProtobuf(item)
+		if err != nil {
+			log.Warnf("skip event. tx_hash: %s, error: %s", item.GetTxHash(), err)
+			continue
+		}
+
+		pos := item.PosInIERCTxs()
+		record, existed := records[pos]
+		if !existed {
+			record = &pb.Transaction_Record{Position: int32(pos)}
+			records[pos] = record
+			data.Records = append(data.Records, record)
+		}
+
+		record.Events = append(record.Events, event)
 	}
+
+	sort.SliceStable(data.Records, func(i, j int) bool {
+		return data.Records[i].Position < data.Records[j].Position
+	})
+
+	return data
 }
 
-func convertTickConfigDetails(details []*protocol.TickConfigDetail) []*pb.StakingPoolUpdated_TickConfigDetail {
-	var result = make([]*pb.StakingPoolUpdated_TickConfigDetail, 0, len(details))
-	for _, detail := range details {
-		result = append(result, &pb.StakingPoolUpdated_TickConfigDetail{
-			Tick:      detail.Tick,
-			Ratio:     detail.RewardsRatioPerBlock.String(),
-			MaxAmount: detail.MaxAmount.String(),
-		})
+// 追平通知没有事件, block_number 为当前的订阅位置
+func convertEventNotificationToPB(data *domain.EventNotification) *pb.SubscribeReply {
+	reply := &pb.SubscribeReply{
+		BlockNumber: data.Position.Cursor,
+		ResumeToken: data.Position.Token(),
+		CaughtUp:    data.CaughtUp,
 	}
-	return result
 
+	if data.Block == nil {
+		return reply
+	}
+
+	reply.BlockNumber = data.Block.BlockNumber
+	reply.PrevBlockNumber = data.Block.PreviousBlock()
+	reply.Events = convertEventsToPB(data.Block.Events)
+
+	return reply
 }
@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/service"
+	"github.com/kevin88886/eth_indexer/internal/domain/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 管理接口
+type AdminHandler struct {
+	pb.UnimplementedAdminServer
+
+	invalidTxs *service.InvalidTxService
+	webhooks   *service.WebhookService
+
+	logger *log.Helper
+}
+
+func NewAdminHandler(invalidTxs *service.InvalidTxService, webhooks *service.WebhookService, logger log.Logger) *AdminHandler {
+	return &AdminHandler{
+		UnimplementedAdminServer: pb.UnimplementedAdminServer{},
+		invalidTxs:               invalidTxs,
+		webhooks:                 webhooks,
+		logger:                   log.NewHelper(log.With(logger, "module", "admin")),
+	}
+}
+
+func (s *AdminHandler) ListInvalidTxs(_ context.Context, req *pb.ListInvalidTxsRequest) (*pb.ListInvalidTxsReply, error) {
+	entries := s.invalidTxs.List(req.Category)
+
+	var data = make([]*pb.InvalidTx, 0, len(entries))
+	for _, entry := range entries {
+		data = append(data, convertInvalidTxToPB(entry))
+	}
+
+	return &pb.ListInvalidTxsReply{Data: data}, nil
+}
+
+func (s *AdminHandler) AddInvalidTxs(ctx context.Context, req *pb.AddInvalidTxsRequest) (*pb.AddInvalidTxsReply, error) {
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty data")
+	}
+
+	var entries = make([]*domain.InvalidTx, 0, len(req.Data))
+	for _, item := range req.Data {
+		entries = append(entries, &domain.InvalidTx{
+			TxHash:         item.TxHash,
+			Category:       item.Category,
+			Reason:         item.Reason,
+			EffectiveBlock: item.EffectiveBlock,
+		})
+	}
+
+	created, err := s.invalidTxs.Add(ctx, entries...)
+	if err != nil {
+		return nil, convertInvalidTxError(err)
+	}
+
+	return &pb.AddInvalidTxsReply{Created: created}, nil
+}
+
+func (s *AdminHandler) RemoveInvalidTxs(ctx context.Context, req *pb.RemoveInvalidTxsRequest) (*pb.RemoveInvalidTxsReply, error) {
+	if err := s.invalidTxs.Remove(ctx, req.TxHashes...); err != nil {
+		return nil, convertInvalidTxError(err)
+	}
+
+	return &pb.RemoveInvalidTxsReply{}, nil
+}
+
+func (s *AdminHandler) ReloadInvalidTxs(ctx context.Context, _ *pb.ReloadInvalidTxsRequest) (*pb.ReloadInvalidTxsReply, error) {
+	imported, err := s.invalidTxs.Reload(ctx)
+	if err != nil {
+		return nil, convertInvalidTxError(err)
+	}
+
+	return &pb.ReloadInvalidTxsReply{
+		Imported: imported,
+		Total:    int64(len(s.invalidTxs.Snapshot())),
+	}, nil
+}
+
+func (s *AdminHandler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookReply, error) {
+	entity := &webhook.Webhook{
+		URL:       req.Url,
+		Secret:    req.Secret,
+		Ticks:     req.Ticks,
+		Addresses: req.Addresses,
+		Operates:  req.Operates,
+	}
+	if err := s.webhooks.Register(ctx, entity); err != nil {
+		return nil, convertWebhookError(err)
+	}
+
+	return &pb.CreateWebhookReply{Data: convertWebhookToPB(entity, false)}, nil
+}
+
+func (s *AdminHandler) ListWebhooks(ctx context.Context, _ *pb.ListWebhooksRequest) (*pb.ListWebhooksReply, error) {
+	entities, err := s.webhooks.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var data = make([]*pb.Webhook, 0, len(entities))
+	for _, entity := range entities {
+		data = append(data, convertWebhookToPB(entity, true))
+	}
+
+	return &pb.ListWebhooksReply{Data: data}, nil
+}
+
+func (s *AdminHandler) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookReply, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty update mask")
+	}
+
+	// 只更新 update_mask 中列出的字段
+	var updates []func(entity *webhook.Webhook)
+	for _, path := range paths {
+		switch path {
+		case "url":
+			updates = append(updates, func(entity *webhook.Webhook) { entity.URL = req.Url })
+		case "secret":
+			if req.Secret == "" {
+				return nil, status.Error(codes.InvalidArgument, "empty secret")
+			}
+			updates = append(updates, func(entity *webhook.Webhook) { entity.Secret = req.Secret })
+		case "ticks":
+			updates = append(updates, func(entity *webhook.Webhook) { entity.Ticks = req.Ticks })
+		case "addresses":
+			updates = append(updates, func(entity *webhook.Webhook) { entity.Addresses = req.Addresses })
+		case "operates":
+			updates = append(updates, func(entity *webhook.Webhook) { entity.Operates = req.Operates })
+		case "enabled":
+			updates = append(updates, func(entity *webhook.Webhook) { entity.Enabled = req.Enabled })
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path: %s", path)
+		}
+	}
+
+	entity, err := s.webhooks.Update(ctx, req.Id, func(entity *webhook.Webhook) {
+		for _, update := range updates {
+			update(entity)
+		}
+	})
+	if err != nil {
+		return nil, convertWebhookError(err)
+	}
+
+	return &pb.UpdateWebhookReply{Data: convertWebhookToPB(entity, true)}, nil
+}
+
+func (s *AdminHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookReply, error) {
+	if err := s.webhooks.Remove(ctx, req.Id); err != nil {
+		return nil, convertWebhookError(err)
+	}
+
+	return &pb.DeleteWebhookReply{}, nil
+}
+
+func (s *AdminHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesReply, error) {
+	deliveries, err := s.webhooks.Deliveries(ctx, &webhook.DeliveryQuery{
+		WebhookID: req.WebhookId,
+		Status:    webhook.DeliveryStatus(req.Status),
+		Cursor:    req.Cursor,
+		Limit:     pageLimit(req.Limit, defaultPageLimit, maxPageLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var data = make([]*pb.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		data = append(data, convertWebhookDeliveryToPB(delivery, req.WithPayload))
+	}
+
+	return &pb.ListWebhookDeliveriesReply{Data: data}, nil
+}
+
+func (s *AdminHandler) ReplayWebhookDeliveries(ctx context.Context, req *pb.ReplayWebhookDeliveriesRequest) (*pb.ReplayWebhookDeliveriesReply, error) {
+	if req.WebhookId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty webhook id")
+	}
+
+	replayed, err := s.webhooks.Replay(ctx, &webhook.ReplayQuery{WebhookID: req.WebhookId, IDs: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReplayWebhookDeliveriesReply{Replayed: replayed}, nil
+}
+
+func convertInvalidTxToPB(entry *domain.InvalidTx) *pb.InvalidTx {
+	return &pb.InvalidTx{
+		TxHash:         entry.TxHash,
+		Category:       entry.Category,
+		Reason:         entry.Reason,
+		EffectiveBlock: entry.EffectiveBlock,
+		CreatedAt:      entry.CreatedAt.UnixMilli(),
+	}
+}
+
+func convertInvalidTxError(err error) error {
+	if errors.Is(err, service.ErrInvalidTxHash) || errors.Is(err, service.ErrInvalidEffectiveBlock) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrLoadInvalidTxFile) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// 只有创建时返回完整的密钥, 其他接口 maskSecret 为 true
+func convertWebhookToPB(entity *webhook.Webhook, maskSecret bool) *pb.Webhook {
+	secret := entity.Secret
+	if maskSecret {
+		secret = webhook.MaskSecret(secret)
+	}
+
+	return &pb.Webhook{
+		Id:         entity.ID,
+		Url:        entity.URL,
+		Secret:     secret,
+		Ticks:      entity.Ticks,
+		Addresses:  entity.Addresses,
+		Operates:   entity.Operates,
+		StartBlock: entity.StartBlock,
+		Enabled:    entity.Enabled,
+		CreatedAt:  entity.CreatedAt.UnixMilli(),
+		UpdatedAt:  entity.UpdatedAt.UnixMilli(),
+	}
+}
+
+func convertWebhookDeliveryToPB(entity *webhook.Delivery, withPayload bool) *pb.WebhookDelivery {
+	var deliveredAt int64
+	if !entity.DeliveredAt.IsZero() {
+		deliveredAt = entity.DeliveredAt.UnixMilli()
+	}
+
+	var payload string
+	if withPayload {
+		payload = string(entity.Payload)
+	}
+
+	return &pb.WebhookDelivery{
+		Id:            entity.ID,
+		WebhookId:     entity.WebhookID,
+		BlockNumber:   entity.BlockNumber,
+		Status:        pb.WebhookDeliveryStatus(entity.Status),
+		Attempts:      int64(entity.Attempts),
+		NextAttemptAt: entity.NextAttemptAt.UnixMilli(),
+		LastError:     entity.LastError,
+		DeliveredAt:   deliveredAt,
+		CreatedAt:     entity.CreatedAt.UnixMilli(),
+		Payload:       payload,
+	}
+}
+
+func convertWebhookError(err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrInvalidURL):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, webhook.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/module"
+)
+
+var ErrEventConverterNotFound = errors.New("event converter not found")
+
+// 事件转换器, 按事件类型注册. 每个协议模块的转换器放在单独的文件中(event_<模块>.go), 在 init 中注册,
+// 新增协议模块时只需要新增对应的文件, 不需要修改这里的分发逻辑
+var eventConverters = make(map[domain.EventKind]func(domain.Event) *pb.Event)
+
+// 注册事件转换器. 同一种事件只能注册一次
+func RegisterEventConverter[T domain.Event](kind domain.EventKind, convert func(T) *pb.Event) {
+	if _, existed := eventConverters[kind]; existed {
+		panic(fmt.Sprintf("event converter already registered. kind: %d", kind))
+	}
+
+	eventConverters[kind] = func(item domain.Event) *pb.Event {
+		return convert(item.(T))
+	}
+}
+
+// 转换事件, 没有注册转换器的事件返回 ErrEventConverterNotFound
+func ConvertEventEntityToProtobuf(item domain.Event) (*pb.Event, error) {
+	convert, existed := eventConverters[item.GetEventKind()]
+	if !existed {
+		return nil, fmt.Errorf("%w. kind: %d", ErrEventConverterNotFound, item.GetEventKind())
+	}
+
+	return convert(item), nil
+}
+
+// 批量转换事件. 没有注册转换器的事件不返回, 记录日志
+func convertEventsToPB(items []domain.Event) []*pb.Event {
+	var result = make([]*pb.Event, 0, len(items))
+	for _, item := range items {
+		event, err := ConvertEventEntityToProtobuf(item)
+		if err != nil {
+			log.Warnf("skip event. tx_hash: %s, error: %s", item.GetTxHash(), err)
+			continue
+		}
+
+		result = append(result, event)
+	}
+
+	return result
+}
+
+// 检查已注册的协议模块产生的事件是否都有转换器, 返回缺少转换器的事件类型
+func missingEventConverters() []domain.EventKind {
+	var missing []domain.EventKind
+	for _, m := range module.Modules() {
+		for _, codec := range m.EventCodecs() {
+			if _, existed := eventConverters[codec.Kind]; !existed {
+				missing = append(missing, codec.Kind)
+			}
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEventConverter(t *testing.T) {
+	suite.Run(t, new(TestEventConverterSuite))
+}
+
+type TestEventConverterSuite struct {
+	suite.Suite
+}
+
+// 所有协议模块的事件都需要注册转换器
+func (s *TestEventConverterSuite) TestModulesCovered() {
+	s.Empty(missingEventConverters())
+}
+
+func (s *TestEventConverterSuite) TestUnknownKind() {
+	_, err := ConvertEventEntityToProtobuf(unknownEvent{})
+	s.ErrorIs(err, ErrEventConverterNotFound)
+
+	known := &domain.IERC20TransferredEvent{BlockNumber: 10, Data: &domain.IERC20Transferred{Tick: "ethi"}}
+	events := convertEventsToPB([]domain.Event{unknownEvent{}, known})
+	s.Require().Len(events, 1)
+	s.Equal(uint64(10), events[0].BlockNumber)
+}
+
+type unknownEvent struct {
+	domain.Event
+}
+
+func (unknownEvent) GetEventKind() domain.EventKind { return 255 }
+
+func (unknownEvent) GetTxHash() string { return "0x01" }
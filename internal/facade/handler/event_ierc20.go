@@ -0,0 +1,227 @@
+package handler
+
+import (
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+)
+
+// ierc-20 模块的事件转换器. 包含 tick 的部署、mint、划转、销毁、授权、锁仓以及质押池配置
+func init() {
+	RegisterEventConverter(domain.EventKindIERC20TickCreated, convertTickCreatedToPB)
+	RegisterEventConverter(domain.EventKindIERC20Minted, convertMintedToPB)
+	RegisterEventConverter(domain.EventKindIERC20Transferred, convertTickTransferredEventToPB)
+	RegisterEventConverter(domain.EventKindStakingPoolUpdated, convertStakingPoolUpdatedToPB)
+	RegisterEventConverter(domain.EventKindIERC20Burned, convertTickBurnedToPB)
+	RegisterEventConverter(domain.EventKindIERC20Approved, convertTickApprovedToPB)
+	RegisterEventConverter(domain.EventKindIERC20VestingCreated, convertVestingCreatedToPB)
+	RegisterEventConverter(domain.EventKindIERC20VestingClaimed, convertVestingClaimedToPB)
+}
+
+func convertTickCreatedToPB(ee *domain.IERC20TickCreatedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_TickCreated{TickCreated: &pb.IERC20TickCreated{
+			Protocol:    string(ee.Data.Protocol),
+			Operate:     convertOperate(ee.Data.Operate),
+			Tick:        ee.Data.Tick,
+			Decimals:    ee.Data.Decimals,
+			MaxSupply:   ee.Data.MaxSupply.String(),
+			Limit:       ee.Data.Limit.String(),
+			WalletLimit: ee.Data.WalletLimit.String(),
+			Workc:       ee.Data.WorkC,
+			Creator:     ee.From,
+			Nonce:       ee.Data.Nonce,
+		}},
+	}
+}
+
+func convertMintedToPB(ee *domain.IERC20MintedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_Minted{Minted: &pb.IERC20Minted{
+			Protocol:     string(ee.Data.Protocol),
+			Operate:      convertOperate(ee.Data.Operate),
+			Tick:         ee.Data.Tick,
+			From:         ee.Data.From,
+			To:           ee.Data.To,
+			Nonce:        ee.Data.Nonce,
+			MintedAmount: ee.Data.MintedAmount.String(),
+			Gas:          ee.Data.Gas.String(),
+			GasPrice:     ee.Data.GasPrice.String(),
+		}},
+	}
+}
+
+func convertTickTransferredEventToPB(ee *domain.IERC20TransferredEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_TickTransferred{TickTransferred: &pb.TickTransferred{
+			Protocol:    string(ee.Data.Protocol),
+			Operate:     convertOperate(ee.Data.Operate),
+			Tick:        ee.Data.Tick,
+			From:        ee.Data.From,
+			To:          ee.Data.To,
+			Amount:      ee.Data.Amount.String(),
+			EthValue:    ee.Data.EthValue.String(),
+			GasPrice:    ee.Data.GasPrice.String(),
+			SignerNonce: ee.Data.SignerNonce,
+			Sign:        ee.Data.Sign,
+		}},
+	}
+}
+
+func convertTickBurnedToPB(ee *domain.IERC20BurnedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_TickBurned{TickBurned: &pb.IERC20Burned{
+			Protocol: string(ee.Data.Protocol),
+			Operate:  convertOperate(ee.Data.Operate),
+			Tick:     ee.Data.Tick,
+			From:     ee.Data.From,
+			Amount:   ee.Data.Amount.String(),
+		}},
+	}
+}
+
+func convertTickApprovedToPB(ee *domain.IERC20ApprovedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_TickApproved{TickApproved: &pb.IERC20Approved{
+			Protocol:  string(ee.Data.Protocol),
+			Operate:   convertOperate(ee.Data.Operate),
+			Tick:      ee.Data.Tick,
+			Owner:     ee.Data.Owner,
+			Spender:   ee.Data.Spender,
+			Amount:    ee.Data.Amount.String(),
+			SignNonce: ee.Data.SignNonce,
+			Sign:      ee.Data.Sign,
+		}},
+	}
+}
+
+func convertVestingCreatedToPB(ee *domain.IERC20VestingCreatedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_VestingCreated{VestingCreated: &pb.IERC20VestingCreated{
+			Protocol:   string(ee.Data.Protocol),
+			Operate:    convertOperate(ee.Data.Operate),
+			Tick:       ee.Data.Tick,
+			From:       ee.Data.From,
+			To:         ee.Data.To,
+			Amount:     ee.Data.Amount.String(),
+			StartBlock: ee.Data.StartBlock,
+			CliffBlock: ee.Data.CliffBlock,
+			EndBlock:   ee.Data.EndBlock,
+		}},
+	}
+}
+
+func convertVestingClaimedToPB(ee *domain.IERC20VestingClaimedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_VestingClaimed{VestingClaimed: &pb.IERC20VestingClaimed{
+			Protocol: string(ee.Data.Protocol),
+			Operate:  convertOperate(ee.Data.Operate),
+			Tick:     ee.Data.Tick,
+			To:       ee.Data.To,
+			Amount:   ee.Data.Amount.String(),
+		}},
+	}
+}
+
+func convertStakingPoolUpdatedToPB(ee *domain.StakingPoolUpdatedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_PoolUpdated{PoolUpdated: &pb.StakingPoolUpdated{
+			Protocol:  string(ee.Data.Protocol),
+			Operate:   convertOperate(ee.Data.Operate),
+			From:      ee.From,
+			To:        ee.To,
+			Pool:      ee.Data.Pool,
+			PoolId:    ee.Data.PoolID,
+			Name:      ee.Data.Name,
+			Owner:     ee.Data.Owner,
+			Admins:    ee.Data.Admins,
+			Details:   convertTickConfigDetails(ee.Data.Details),
+			StopBlock: ee.Data.StopBlock,
+		}},
+	}
+}
+
+func convertTickConfigDetails(details []*protocol.TickConfigDetail) []*pb.StakingPoolUpdated_TickConfigDetail {
+	var result = make([]*pb.StakingPoolUpdated_TickConfigDetail, 0, len(details))
+	for _, detail := range details {
+		result = append(result, &pb.StakingPoolUpdated_TickConfigDetail{
+			Tick:      detail.Tick,
+			Ratio:     detail.RewardsRatioPerBlock.String(),
+			MaxAmount: detail.MaxAmount.String(),
+		})
+	}
+	return result
+
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
+)
+
+// ierc-pow 模块的事件转换器. pow 的划转复用 ierc-20 的事件
+func init() {
+	RegisterEventConverter(domain.EventKindIERCPoWTickCreated, convertPowTickCreatedToPB)
+	RegisterEventConverter(domain.EventKindIERCPoWMinted, convertPowMintedToPB)
+}
+
+func convertPowTickCreatedToPB(ee *domain.IERCPoWTickCreatedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_PowTickCreated{PowTickCreated: &pb.IERCPoWTickCreated{
+			Protocol:          string(ee.Data.Protocol),
+			Operate:           convertOperate(ee.Data.Operate),
+			Tick:              ee.Data.Tick,
+			Decimals:          ee.Data.Decimals,
+			MaxSupply:         ee.Data.MaxSupply.String(),
+			TokenomicsDetails: convertTokenomicsDetails(ee.Data.Tokenomics),
+			Rule:              convertDistributionRuleToPB(&ee.Data.Rule),
+			Creator:           ee.From,
+		}},
+	}
+}
+
+func convertTokenomicsDetails(details []protocol.TokenomicsDetail) []*pb.IERCPoWTickCreated_TokenomicsDetail {
+	var result = make([]*pb.IERCPoWTickCreated_TokenomicsDetail, 0, len(details))
+	for _, detail := range details {
+		result = append(result, &pb.IERCPoWTickCreated_TokenomicsDetail{
+			BlockNumber: detail.BlockNumber,
+			Amount:      detail.Amount.String(),
+		})
+	}
+	return result
+}
+
+func convertDistributionRuleToPB(rule *protocol.DistributionRule) *pb.IERCPoWTickCreated_Rule {
+	return &pb.IERCPoWTickCreated_Rule{
+		PowRatio:        rule.PowRatio.String(),
+		MinWorkc:        rule.MinWorkC,
+		DifficultyRatio: rule.DifficultyRatio.String(),
+		PosRatio:        rule.PosRatio.String(),
+		PosPool:         rule.PosPool,
+	}
+}
+
+func convertPowMintedToPB(ee *domain.IERCPoWMintedEvent) *pb.Event {
+	return &pb.Event{
+		BlockNumber:  ee.BlockNumber,
+		TxHash:       ee.TxHash,
+		PosInIercTxs: int32(ee.PositionInIERCTxs),
+		From:         ee.From,
+		To:           ee.To,
+		Value:        ee.Value,
+		EventAt:      ee.EventAt.UnixMilli(),
+		ErrCode:      ee.ErrCode,
+		ErrReason:    ee.ErrReason,
+		Event: &pb.Event_PowMinted{PowMinted: &pb.IERCPoWMinted{
+			Protocol:        string(ee.Data.Protocol),
+			Operate:         convertOperate(ee.Data.Operate),
+			Tick:            ee.Data.Tick,
+			From:            ee.Data.From,
+			To:              ee.Data.To,
+			Nonce:           ee.Data.Nonce,
+			IsPow:           ee.Data.IsPoW,
+			PowTotalShare:   ee.Data.PoWTotalShare.String(),
+			PowMinerShare:   ee.Data.PoWMinerShare.String(),
+			PowMintedAmount: ee.Data.PoWMintedAmount.String(),
+			IsPos:           ee.Data.IsPoS,
+			PosTotalShare:   ee.Data.PoSTotalShare.String(),
+			PosMinerShare:   ee.Data.PoSMinerShare.String(),
+			PosMintedAmount: ee.Data.PoSMintedAmount.String(),
+			Gas:             ee.Data.Gas.String(),
+			GasPrice:        ee.Data.GasPrice.String(),
+			IsAirdrop:       ee.Data.IsAirdrop,
+			AirdropAmount:   ee.Data.AirdropAmount.String(),
+			BurnedAmount:    ee.Data.BurnAmount.String(),
+		}},
+	}
+}
@@ -3,13 +3,20 @@ package handler
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	pb "github.com/kevin88886/eth_indexer/api/indexer"
 	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/domain/allowance"
+	"github.com/kevin88886/eth_indexer/internal/domain/balance"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/kevin88886/eth_indexer/internal/domain/service"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,6 +32,12 @@ type IndexHandler struct {
 	fetcher   domain.BlockFetcher
 	blockRepo domain.BlockRepository
 
+	allowanceRepo allowance.AllowanceRepository
+	vestingRepo   vesting.VestingRepository
+	holderRepo    holder.HolderRepository
+	balanceRepo   balance.BalanceRepository
+	tickRepo      tick.TickRepository
+
 	logger *log.Helper
 }
 
@@ -33,9 +46,21 @@ func NewIndexHandler(
 	aggRepo domain.EventRepository,
 	fetcher domain.BlockFetcher,
 	blockRepo domain.BlockRepository,
+	allowanceRepo allowance.AllowanceRepository,
+	vestingRepo vesting.VestingRepository,
+	holderRepo holder.HolderRepository,
+	balanceRepo balance.BalanceRepository,
+	tickRepo tick.TickRepository,
 	logger log.Logger,
 ) *IndexHandler {
 	ctx, cancel := context.WithCancel(context.Background())
+	helper := log.NewHelper(log.With(logger, "module", "handler"))
+
+	// 协议模块产生的事件没有转换器时, 接口中不会返回这些事件
+	if missing := missingEventConverters(); len(missing) != 0 {
+		helper.Warnf("event converters missing. kinds: %v", missing)
+	}
+
 	return &IndexHandler{
 		UnimplementedIndexerServer: pb.UnimplementedIndexerServer{},
 		ctx:                        ctx,
@@ -44,7 +69,12 @@ func NewIndexHandler(
 		aggRepo:                    aggRepo,
 		fetcher:                    fetcher,
 		blockRepo:                  blockRepo,
-		logger:                     log.NewHelper(log.With(logger, "module", "handler")),
+		allowanceRepo:              allowanceRepo,
+		vestingRepo:                vestingRepo,
+		holderRepo:                 holderRepo,
+		balanceRepo:                balanceRepo,
+		tickRepo:                   tickRepo,
+		logger:                     helper,
 	}
 }
 
@@ -61,10 +91,24 @@ func (s *IndexHandler) Stop(_ context.Context) error {
 }
 
 func (s *IndexHandler) SubscribeEvent(req *pb.SubscribeRequest, conn pb.Indexer_SubscribeEventServer) error {
+	return s.StreamEvents(conn.Context(), req, conn.Send)
+}
+
+// StreamEvents 订阅事件并通过 send 推送, 直到连接断开或服务退出. 与传输方式无关, 供 gRPC 和 HTTP 流式接口共用
+func (s *IndexHandler) StreamEvents(ctx context.Context, req *pb.SubscribeRequest, send func(*pb.SubscribeReply) error) error {
 
+	// 续订时从令牌记录的位置继续推送, 忽略 start_block
 	// TODO: z 需要校验起始区块
-	lastBlockNumber := req.StartBlock
-	stream, err := s.aggRepo.SubscribeEvent(conn.Context(), req.StartBlock)
+	position := domain.EventStreamPosition{Cursor: req.StartBlock}
+	if req.ResumeToken != "" {
+		var err error
+		position, err = domain.ParseResumeToken(req.ResumeToken)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	stream, err := s.aggRepo.SubscribeEvent(ctx, position, convertSubscribeFilter(req))
 	if err != nil {
 		return err
 	}
@@ -76,7 +120,7 @@ func (s *IndexHandler) SubscribeEvent(req *pb.SubscribeRequest, conn pb.Indexer_
 			s.logger.Info("Handler stop. SubscribeEvent quit")
 			return s.ctx.Err()
 
-		case <-conn.Context().Done():
+		case <-ctx.Done():
 			s.logger.Error("SubscribeEvent stream closed")
 			return nil
 
@@ -87,33 +131,49 @@ func (s *IndexHandler) SubscribeEvent(req *pb.SubscribeRequest, conn pb.Indexer_
 			if !ok {
 				return nil
 			}
-			if lastBlockNumber > data.BlockNumber {
-				continue
-			}
-
-			lastBlockNumber = data.BlockNumber
-
-			var reply = pb.SubscribeReply{
-				BlockNumber:     data.BlockNumber,
-				PrevBlockNumber: data.PreviousBlock(),
-				Events:          make([]*pb.Event, 0, len(data.Events)),
-			}
 
-			for _, item := range data.Events {
-				event := ConvertEventEntityToProtobuf(item)
-				if event != nil {
-					reply.Events = append(reply.Events, event)
-				}
-			}
-
-			if err := conn.Send(&reply); err != nil {
+			if err := send(convertEventNotificationToPB(data)); err != nil {
 				return err
 			}
 		}
 	}
 }
 
-func (s *IndexHandler) SubscribeSystemStatus(_ *pb.SubscribeSystemStatusRequest, conn pb.Indexer_SubscribeSystemStatusServer) error {
+// 订阅请求中的过滤条件
+func convertSubscribeFilter(req *pb.SubscribeRequest) *domain.EventFilter {
+	filter := &domain.EventFilter{SuccessOnly: req.SuccessOnly}
+	for _, item := range req.Ticks {
+		if item = strings.TrimSpace(item); item != "" {
+			filter.Ticks = append(filter.Ticks, item)
+		}
+	}
+	for _, item := range req.Addresses {
+		if item = address.Canonical(item); item != "" {
+			filter.Addresses = append(filter.Addresses, item)
+		}
+	}
+	for _, item := range req.Operates {
+		if item = strings.TrimSpace(item); item != "" {
+			filter.Operates = append(filter.Operates, protocol.Operate(item))
+		}
+	}
+	for _, kind := range req.EventKinds {
+		filter.EventKinds = append(filter.EventKinds, domain.EventKind(kind))
+	}
+
+	if filter.IsEmpty() {
+		return nil
+	}
+
+	return filter
+}
+
+func (s *IndexHandler) SubscribeSystemStatus(req *pb.SubscribeSystemStatusRequest, conn pb.Indexer_SubscribeSystemStatusServer) error {
+	return s.StreamSystemStatus(conn.Context(), req, conn.Send)
+}
+
+// StreamSystemStatus 定时检查同步状态, 有变化时通过 send 推送. 与传输方式无关, 供 gRPC 和 HTTP 流式接口共用
+func (s *IndexHandler) StreamSystemStatus(ctx context.Context, _ *pb.SubscribeSystemStatusRequest, send func(*pb.SubscribeSystemStatusReply) error) error {
 	var (
 		reply  pb.SubscribeSystemStatusReply
 		ticker = time.NewTicker(time.Second * 5)
@@ -128,7 +188,7 @@ func (s *IndexHandler) SubscribeSystemStatus(_ *pb.SubscribeSystemStatusRequest,
 			return s.ctx.Err()
 
 		// 监控连接断开
-		case <-conn.Context().Done():
+		case <-ctx.Done():
 			s.logger.Error("SubscribeStatus stream closed")
 			return nil
 
@@ -138,12 +198,12 @@ func (s *IndexHandler) SubscribeSystemStatus(_ *pb.SubscribeSystemStatusRequest,
 
 		var needUpdate bool
 
-		latest, err := s.fetcher.GetBlockHeaderByNumber(conn.Context(), 0)
+		latest, err := s.fetcher.GetBlockHeaderByNumber(ctx, 0)
 		if err != nil {
 			return err
 		}
 
-		sync, err := s.blockRepo.QueryLastProcessedBlock(conn.Context(), reply.SyncBlock)
+		sync, err := s.blockRepo.QueryLastProcessedBlock(ctx, reply.SyncBlock)
 		if err != nil {
 			return err
 		}
@@ -163,7 +223,7 @@ func (s *IndexHandler) SubscribeSystemStatus(_ *pb.SubscribeSystemStatusRequest,
 		}
 
 		// 推送数据
-		if err := conn.Send(&reply); err != nil {
+		if err := send(&reply); err != nil {
 			return err
 		}
 	}
@@ -178,19 +238,10 @@ func (s *IndexHandler) QueryEvents(ctx context.Context, req *pb.QueryEventsReque
 
 	var data = make([]*pb.QueryEventsReply_EventsByBlock, 0, len(blocks))
 	for _, block := range blocks {
-
-		var events = make([]*pb.Event, 0, len(block.Events))
-		for _, item := range block.Events {
-			event := ConvertEventEntityToProtobuf(item)
-			if event != nil {
-				events = append(events, event)
-			}
-		}
-
 		data = append(data, &pb.QueryEventsReply_EventsByBlock{
 			BlockNumber:     block.BlockNumber,
 			PrevBlockNumber: block.PreviousBlock(),
-			Events:          events,
+			Events:          convertEventsToPB(block.Events),
 		})
 	}
 
@@ -263,7 +314,7 @@ func (s *IndexHandler) checkTransfer(ctx context.Context, req *pb.CheckTransferR
 		return nil, err
 	}
 
-	if !tx.IsProcessed {
+	if tx == nil || !tx.IsProcessed {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
@@ -276,7 +327,7 @@ func (s *IndexHandler) checkTransfer(ctx context.Context, req *pb.CheckTransferR
 		return nil, errors.New("not a transfer")
 	}
 
-	if req.PositionIndex > int64(len(tt.Records)) {
+	if req.PositionIndex >= int64(len(tt.Records)) {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
@@ -292,3 +343,388 @@ func (s *IndexHandler) checkTransfer(ctx context.Context, req *pb.CheckTransferR
 		},
 	}, nil
 }
+
+func (s *IndexHandler) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionReply, error) {
+
+	hash := strings.ToLower(strings.TrimSpace(req.Hash))
+	if hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tx hash")
+	}
+
+	tx, err := s.blockRepo.QueryTransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	// 待处理的交易还没有事件
+	var events []domain.Event
+	if tx.IsProcessed {
+		events, err = s.aggRepo.QueryEventsByHash(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.GetTransactionReply{Data: convertTransactionToPB(tx, events)}, nil
+}
+
+func (s *IndexHandler) ListAllowances(ctx context.Context, req *pb.ListAllowancesRequest) (*pb.ListAllowancesReply, error) {
+	var (
+		owner   = address.Canonical(req.Owner)
+		spender = address.Canonical(req.Spender)
+		tick    = strings.TrimSpace(req.Tick)
+	)
+
+	// 至少指定一个地址, 避免全表查询
+	if owner == "" && spender == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner or spender is required")
+	}
+
+	entities, err := s.allowanceRepo.Query(ctx, owner, spender, tick)
+	if err != nil {
+		return nil, err
+	}
+
+	var data = make([]*pb.ListAllowancesReply_Allowance, 0, len(entities))
+	for _, entity := range entities {
+		data = append(data, convertAllowanceToPB(entity))
+	}
+
+	return &pb.ListAllowancesReply{Data: data}, nil
+}
+
+func (s *IndexHandler) ListVestings(ctx context.Context, req *pb.ListVestingsRequest) (*pb.ListVestingsReply, error) {
+	var (
+		from      = address.Canonical(req.From)
+		recipient = address.Canonical(req.Recipient)
+		tick      = strings.TrimSpace(req.Tick)
+	)
+
+	// 至少指定一个地址, 避免全表查询
+	if from == "" && recipient == "" {
+		return nil, status.Error(codes.InvalidArgument, "from or recipient is required")
+	}
+
+	entities, err := s.vestingRepo.Query(ctx, from, recipient, tick)
+	if err != nil {
+		return nil, err
+	}
+
+	// 可领取数量按最后处理的区块计算
+	var blockNumber uint64
+	lastBlock, err := s.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if lastBlock != nil {
+		blockNumber = lastBlock.Number
+	}
+
+	var data = make([]*pb.ListVestingsReply_Vesting, 0, len(entities))
+	for _, entity := range entities {
+		data = append(data, convertVestingToPB(entity, blockNumber))
+	}
+
+	return &pb.ListVestingsReply{BlockNumber: blockNumber, Data: data}, nil
+}
+
+func (s *IndexHandler) GetTickStats(ctx context.Context, req *pb.GetTickStatsRequest) (*pb.GetTickStatsReply, error) {
+	stats, err := s.loadHolderStats(ctx, req.Tick)
+	if err != nil {
+		return nil, err
+	}
+
+	var distribution = make([]*pb.GetTickStatsReply_Bucket, 0, len(stats.Buckets))
+	for idx, holders := range stats.Buckets {
+		bucket := &pb.GetTickStatsReply_Bucket{
+			Label:   holder.BucketLabel(idx),
+			Min:     "0",
+			Holders: holders,
+		}
+		if idx > 0 {
+			bucket.Min = holder.BucketBounds[idx-1].String()
+		}
+		if idx < len(holder.BucketBounds) {
+			bucket.Max = holder.BucketBounds[idx].String()
+		}
+		distribution = append(distribution, bucket)
+	}
+
+	reply := &pb.GetTickStatsReply{
+		Tick:               stats.Tick,
+		Holders:            stats.Holders,
+		Distribution:       distribution,
+		FirstActivityBlock: stats.FirstActivityBlock,
+		LastActivityBlock:  stats.LastActivityBlock,
+	}
+	if !stats.FirstActivityAt.IsZero() {
+		reply.FirstActivityAt = stats.FirstActivityAt.Unix()
+	}
+	if !stats.LastActivityAt.IsZero() {
+		reply.LastActivityAt = stats.LastActivityAt.Unix()
+	}
+
+	return reply, nil
+}
+
+func (s *IndexHandler) GetTickHolders(ctx context.Context, req *pb.GetTickHoldersRequest) (*pb.GetTickHoldersReply, error) {
+	stats, err := s.loadHolderStats(ctx, req.Tick)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageLimit(req.Limit, defaultPageLimit, maxPageLimit)
+
+	holders, err := s.holderRepo.TopHolders(ctx, stats.Tick, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var data = make([]*pb.GetTickHoldersReply_Holder, 0, len(holders))
+	for idx, entity := range holders {
+		data = append(data, &pb.GetTickHoldersReply_Holder{
+			Rank:    int64(idx + 1),
+			Address: entity.Address,
+			Amount:  entity.Amount.String(),
+		})
+	}
+
+	return &pb.GetTickHoldersReply{
+		Tick:    stats.Tick,
+		Holders: stats.Holders,
+		Data:    data,
+	}, nil
+}
+
+func (s *IndexHandler) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.GetBalanceReply, error) {
+	key := balance.NewBalanceKey(req.Address, strings.TrimSpace(req.Tick))
+	if !key.Address.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	if key.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick is required")
+	}
+
+	snapshot, err := s.balanceRepo.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	entity := balance.NewBalance(key.Address.String(), key.Tick)
+	if len(snapshot.Balances) != 0 {
+		entity = snapshot.Balances[0]
+	}
+
+	return &pb.GetBalanceReply{BlockNumber: snapshot.BlockNumber, Data: convertBalanceToPB(entity)}, nil
+}
+
+func (s *IndexHandler) ListBalancesByAddress(ctx context.Context, req *pb.ListBalancesByAddressRequest) (*pb.ListBalancesByAddressReply, error) {
+	addr, err := address.Parse(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	query := &balance.BalanceQuery{
+		Address:     addr.String(),
+		Cursor:      strings.TrimSpace(req.Cursor),
+		Limit:       pageLimit(req.Limit, defaultPageLimit, maxPageLimit),
+		IncludeZero: req.IncludeZero,
+	}
+
+	snapshot, err := s.balanceRepo.QueryByAddress(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListBalancesByAddressReply{
+		BlockNumber: snapshot.BlockNumber,
+		Data:        make([]*pb.Balance, 0, len(snapshot.Balances)),
+	}
+	for _, entity := range snapshot.Balances {
+		reply.Data = append(reply.Data, convertBalanceToPB(entity))
+	}
+
+	if len(snapshot.Balances) == query.Limit {
+		reply.NextCursor = snapshot.Balances[len(snapshot.Balances)-1].Tick
+	}
+
+	return reply, nil
+}
+
+func (s *IndexHandler) ListHoldersByTick(ctx context.Context, req *pb.ListHoldersByTickRequest) (*pb.ListHoldersByTickReply, error) {
+	query := &balance.BalanceQuery{
+		Tick:   strings.TrimSpace(req.Tick),
+		Cursor: strings.TrimSpace(req.Cursor),
+		Limit:  pageLimit(req.Limit, defaultPageLimit, maxPageLimit),
+	}
+	if query.Tick == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick is required")
+	}
+
+	snapshot, err := s.balanceRepo.QueryByTick(ctx, query)
+	if err != nil {
+		if errors.Is(err, balance.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	reply := &pb.ListHoldersByTickReply{
+		BlockNumber: snapshot.BlockNumber,
+		Data:        make([]*pb.Balance, 0, len(snapshot.Balances)),
+	}
+	for _, entity := range snapshot.Balances {
+		reply.Data = append(reply.Data, convertBalanceToPB(entity))
+	}
+
+	if len(snapshot.Balances) == query.Limit {
+		reply.NextCursor = balance.HolderCursor(snapshot.Balances[len(snapshot.Balances)-1])
+	}
+
+	return reply, nil
+}
+
+func (s *IndexHandler) GetTick(ctx context.Context, req *pb.GetTickRequest) (*pb.GetTickReply, error) {
+	tickName := strings.TrimSpace(req.Tick)
+	if tickName == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick is required")
+	}
+
+	entity, err := s.tickRepo.Load(ctx, tickName)
+	if err != nil {
+		return nil, err
+	}
+
+	if entity == nil {
+		return nil, status.Error(codes.NotFound, "tick not found")
+	}
+
+	blockNumber, err := s.lastHandleBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTickReply{BlockNumber: blockNumber, Data: convertTickToPB(entity, blockNumber)}, nil
+}
+
+func (s *IndexHandler) ListTicks(ctx context.Context, req *pb.ListTicksRequest) (*pb.ListTicksReply, error) {
+	query := &tick.TickQuery{
+		Protocol: protocol.Protocol(strings.TrimSpace(req.Protocol)),
+		Creator:  address.Canonical(req.Creator),
+		Mintable: req.Mintable,
+		Cursor:   req.Cursor,
+		Limit:    pageLimit(req.Limit, defaultPageLimit, maxPageLimit),
+	}
+
+	entities, err := s.tickRepo.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	blockNumber, err := s.lastHandleBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListTicksReply{
+		BlockNumber: blockNumber,
+		Data:        make([]*pb.Tick, 0, len(entities)),
+	}
+	for _, entity := range entities {
+		reply.Data = append(reply.Data, convertTickToPB(entity, blockNumber))
+	}
+
+	if len(entities) == query.Limit {
+		reply.NextCursor = entities[len(entities)-1].GetID()
+	}
+
+	return reply, nil
+}
+
+func (s *IndexHandler) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsReply, error) {
+
+	if req.After != "" && req.Before != "" {
+		return nil, status.Error(codes.InvalidArgument, "after and before are mutually exclusive")
+	}
+
+	query := &domain.EventQuery{
+		Address:  address.Canonical(req.Address),
+		IERCFrom: address.Canonical(req.IercFrom),
+		IERCTo:   address.Canonical(req.IercTo),
+		ETHFrom:  address.Canonical(req.EthFrom),
+		Tick:     strings.TrimSpace(req.Tick),
+		Operate:  strings.TrimSpace(req.Operate),
+		TxHash:   strings.ToLower(strings.TrimSpace(req.TxHash)),
+		Status:   domain.EventStatus(req.Status),
+		After:    req.After,
+		Before:   req.Before,
+		Desc:     req.Desc,
+		Limit:    pageLimit(req.Limit, defaultPageLimit, maxPageLimit),
+	}
+	for _, kind := range req.EventKinds {
+		query.EventKinds = append(query.EventKinds, domain.EventKind(kind))
+	}
+
+	page, err := s.aggRepo.QueryEvents(ctx, query)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidEventCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.ListEventsReply{
+		Data:       convertEventsToPB(page.Events),
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}, nil
+}
+
+// 最后处理的区块号. 还没有处理任何区块时返回 0
+func (s *IndexHandler) lastHandleBlockNumber(ctx context.Context) (uint64, error) {
+	lastBlock, err := s.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil || lastBlock == nil {
+		return 0, err
+	}
+
+	return lastBlock.Number, nil
+}
+
+const (
+	// 分页查询的默认返回数量
+	defaultPageLimit = 100
+	// 分页查询的最大返回数量
+	maxPageLimit = 1000
+)
+
+// 分页查询的返回数量. limit 不大于 0 时返回默认数量 def, 最大为 maxLimit
+func pageLimit(limit int64, def, maxLimit int) int {
+	if limit <= 0 {
+		return def
+	}
+
+	return int(min(limit, int64(maxLimit)))
+}
+
+// 查询持仓统计. 没有任何余额变化的 tick 返回空的统计
+func (s *IndexHandler) loadHolderStats(ctx context.Context, tickName string) (*holder.Stats, error) {
+	tickName = strings.TrimSpace(tickName)
+	if tickName == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick is required")
+	}
+
+	entities, err := s.holderRepo.LoadStats(ctx, tickName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entities) == 0 {
+		return holder.NewStats(tickName), nil
+	}
+
+	return entities[0], nil
+}
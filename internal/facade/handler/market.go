@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/domain/order"
+	"github.com/kevin88886/eth_indexer/internal/domain/trade"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// K线查询的默认返回数量
+	defaultCandleLimit = 500
+	// K线查询的最大返回数量
+	maxCandleLimit = 1500
+)
+
+// 市场查询接口
+type MarketHandler struct {
+	pb.UnimplementedMarketServer
+
+	orderRepo  order.OrderRepository
+	tradeRepo  trade.TradeRepository
+	candleRepo trade.CandleRepository
+
+	logger *log.Helper
+}
+
+func NewMarketHandler(
+	orderRepo order.OrderRepository,
+	tradeRepo trade.TradeRepository,
+	candleRepo trade.CandleRepository,
+	logger log.Logger,
+) *MarketHandler {
+	return &MarketHandler{
+		UnimplementedMarketServer: pb.UnimplementedMarketServer{},
+		orderRepo:                 orderRepo,
+		tradeRepo:                 tradeRepo,
+		candleRepo:                candleRepo,
+		logger:                    log.NewHelper(log.With(logger, "module", "market")),
+	}
+}
+
+func (s *MarketHandler) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersReply, error) {
+	query := &order.OrderQuery{
+		Tick:   strings.TrimSpace(req.Tick),
+		Seller: address.Canonical(req.Seller),
+		Buyer:  address.Canonical(req.Buyer),
+		Status: order.Status(strings.TrimSpace(req.Status)),
+		Cursor: req.Cursor,
+		Limit:  pageLimit(req.Limit, defaultPageLimit, maxPageLimit),
+	}
+
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	orders, err := s.orderRepo.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var reply = &pb.ListOrdersReply{Data: make([]*pb.Order, 0, len(orders))}
+	for _, entity := range orders {
+		reply.Data = append(reply.Data, convertOrderToPB(entity))
+	}
+
+	if len(orders) == query.Limit {
+		reply.NextCursor = orders[len(orders)-1].ID
+	}
+
+	return reply, nil
+}
+
+func (s *MarketHandler) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderReply, error) {
+	var (
+		orderID = strings.ToLower(strings.TrimSpace(req.OrderId))
+		sign    = strings.TrimSpace(req.Sign)
+		entity  *order.Order
+		err     error
+	)
+
+	switch {
+	case orderID != "":
+		entity, err = s.orderRepo.Get(ctx, orderID)
+	case sign != "":
+		entity, err = s.orderRepo.GetBySign(ctx, sign)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "order_id or sign is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if entity == nil {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	return &pb.GetOrderReply{Data: convertOrderToPB(entity)}, nil
+}
+
+func (s *MarketHandler) ListTrades(ctx context.Context, req *pb.ListTradesRequest) (*pb.ListTradesReply, error) {
+	query := &trade.TradeQuery{
+		Tick:    strings.TrimSpace(req.Tick),
+		Address: address.Canonical(req.Address),
+		Cursor:  req.Cursor,
+		Limit:   pageLimit(req.Limit, defaultPageLimit, maxPageLimit),
+	}
+
+	trades, err := s.tradeRepo.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var reply = &pb.ListTradesReply{Data: make([]*pb.Trade, 0, len(trades))}
+	for _, entity := range trades {
+		reply.Data = append(reply.Data, &pb.Trade{
+			TradeId:     entity.TradeID,
+			OrderId:     entity.OrderID,
+			Tick:        entity.Tick,
+			Seller:      entity.Seller,
+			Buyer:       entity.Buyer,
+			Amount:      entity.Amount.String(),
+			Value:       entity.Value.String(),
+			Price:       entity.Price.String(),
+			TxHash:      entity.TxHash,
+			BlockNumber: entity.BlockNumber,
+			TradedAt:    entity.TradedAt.Unix(),
+		})
+	}
+
+	if len(trades) == query.Limit {
+		reply.NextCursor = trades[len(trades)-1].ID
+	}
+
+	return reply, nil
+}
+
+func (s *MarketHandler) ListCandles(ctx context.Context, req *pb.ListCandlesRequest) (*pb.ListCandlesReply, error) {
+	tickName := strings.TrimSpace(req.Tick)
+	if tickName == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick is required")
+	}
+
+	interval, err := trade.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	query := &trade.CandleQuery{
+		Tick:     tickName,
+		Interval: interval,
+		Limit:    pageLimit(req.Limit, defaultCandleLimit, maxCandleLimit),
+	}
+	if req.StartTime > 0 {
+		query.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		query.EndTime = time.Unix(req.EndTime, 0)
+	}
+
+	candles, err := s.candleRepo.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var reply = &pb.ListCandlesReply{
+		Tick:     tickName,
+		Interval: string(interval),
+		Data:     make([]*pb.Candle, 0, len(candles)),
+	}
+	for _, candle := range candles {
+		reply.Data = append(reply.Data, &pb.Candle{
+			OpenTime:    candle.OpenTime.Unix(),
+			Open:        candle.Open.String(),
+			High:        candle.High.String(),
+			Low:         candle.Low.String(),
+			Close:       candle.Close.String(),
+			Volume:      candle.Volume.String(),
+			QuoteVolume: candle.QuoteVolume.String(),
+			Trades:      candle.Trades,
+		})
+	}
+
+	return reply, nil
+}
+
+func (s *MarketHandler) GetTicker(ctx context.Context, req *pb.GetTickerRequest) (*pb.GetTickerReply, error) {
+	tickName := strings.TrimSpace(req.Tick)
+	if tickName == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick is required")
+	}
+
+	ticker, err := s.tradeRepo.Ticker(ctx, tickName, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+
+	// 没有任何成交
+	if ticker == nil {
+		return &pb.GetTickerReply{
+			Tick:                   tickName,
+			LastPrice:              "0",
+			OpenPrice_24H:          "0",
+			High_24H:               "0",
+			Low_24H:                "0",
+			Volume_24H:             "0",
+			QuoteVolume_24H:        "0",
+			PriceChangePercent_24H: "0",
+		}, nil
+	}
+
+	// 涨跌幅. 窗口内没有成交时为 0
+	changePercent := decimal.Zero
+	if ticker.OpenPrice.GreaterThan(decimal.Zero) {
+		changePercent = ticker.LastPrice.Sub(ticker.OpenPrice).Div(ticker.OpenPrice).Mul(decimal.NewFromInt(100)).Round(2)
+	}
+
+	return &pb.GetTickerReply{
+		Tick:                   ticker.Tick,
+		LastPrice:              ticker.LastPrice.String(),
+		LastTradedAt:           ticker.LastTradedAt.Unix(),
+		OpenPrice_24H:          ticker.OpenPrice.String(),
+		High_24H:               ticker.High.String(),
+		Low_24H:                ticker.Low.String(),
+		Volume_24H:             ticker.Volume.String(),
+		QuoteVolume_24H:        ticker.QuoteVolume.String(),
+		Trades_24H:             ticker.Trades,
+		PriceChangePercent_24H: changePercent.String(),
+	}, nil
+}
+
+func convertOrderToPB(entity *order.Order) *pb.Order {
+	return &pb.Order{
+		OrderId:      entity.OrderID,
+		Sign:         entity.Sign,
+		SignNonce:    entity.SignNonce,
+		Tick:         entity.Tick,
+		Seller:       entity.Seller,
+		Buyer:        entity.Buyer,
+		Amount:       entity.Amount.String(),
+		Value:        entity.Value.String(),
+		Price:        entity.Price.String(),
+		FilledAmount: entity.FilledAmount.String(),
+		FilledValue:  entity.FilledValue.String(),
+		Status:       string(entity.Status),
+		ListTxHash:   entity.ListTxHash,
+		ListBlock:    entity.ListBlock,
+		CloseTxHash:  entity.CloseTxHash,
+		CloseBlock:   entity.CloseBlock,
+	}
+}
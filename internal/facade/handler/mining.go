@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/mining"
+	"github.com/kevin88886/eth_indexer/internal/domain/tick"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 挖矿查询接口
+type MiningHandler struct {
+	pb.UnimplementedMiningServer
+
+	tickRepo   tick.TickRepository
+	eventRepo  domain.EventRepository
+	blockRepo  domain.BlockRepository
+	miningRepo domain.MiningStatsRepository
+
+	logger *log.Helper
+}
+
+func NewMiningHandler(
+	tickRepo tick.TickRepository,
+	eventRepo domain.EventRepository,
+	blockRepo domain.BlockRepository,
+	miningRepo domain.MiningStatsRepository,
+	logger log.Logger,
+) *MiningHandler {
+	return &MiningHandler{
+		UnimplementedMiningServer: pb.UnimplementedMiningServer{},
+		tickRepo:                  tickRepo,
+		eventRepo:                 eventRepo,
+		blockRepo:                 blockRepo,
+		miningRepo:                miningRepo,
+		logger:                    log.NewHelper(log.With(logger, "module", "mining")),
+	}
+}
+
+func (s *MiningHandler) EstimatePoWMint(ctx context.Context, req *pb.EstimatePoWMintRequest) (*pb.EstimatePoWMintReply, error) {
+	var (
+		tickName          = strings.TrimSpace(req.Tick)
+		hash              = strings.ToLower(strings.TrimSpace(req.Hash))
+		currentBlock      = req.CurrentBlock
+		competitionBlocks = int(req.CompetitionBlocks)
+	)
+
+	if tickName == "" || hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick and hash are required")
+	}
+
+	if competitionBlocks <= 0 {
+		competitionBlocks = 10
+	}
+	competitionBlocks = min(competitionBlocks, 100)
+
+	entity, err := s.tickRepo.Load(ctx, tickName)
+	if err != nil {
+		return nil, err
+	}
+
+	powTick, ok := entity.(*tick.IERCPoWTick)
+	if !ok || powTick == nil {
+		return nil, status.Error(codes.NotFound, "pow tick not found")
+	}
+
+	// 默认按下一个区块打包计算
+	if currentBlock == 0 {
+		lastBlock, err := s.blockRepo.GetLastHandleBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if lastBlock != nil {
+			currentBlock = lastBlock.Number + 1
+		}
+	}
+
+	shares, err := s.queryCompetitionShares(ctx, powTick.Tick, currentBlock, competitionBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := powTick.EstimatePoWMint(&tick.PoWMintEstimateParams{
+		CurrentBlock:      currentBlock,
+		EffectiveBlock:    req.Block,
+		Hash:              hash,
+		CompetitionShares: shares,
+	})
+
+	return &pb.EstimatePoWMintReply{
+		Tick:               powTick.Tick,
+		CurrentBlock:       currentBlock,
+		Share:              estimate.Share.String(),
+		Difficulty:         int64(estimate.Difficulty),
+		MinDifficulty:      int64(estimate.MinDifficulty),
+		HashValid:          estimate.Share.GreaterThan(decimal.Zero),
+		WindowValid:        estimate.WindowValid,
+		RewardBlockNum:     estimate.RewardBlockNum,
+		OutputOfBlock:      estimate.OutputOfBlock.String(),
+		CanMintAmount:      estimate.CanMintAmount.String(),
+		BurnAmount:         estimate.BurnAmount.String(),
+		MinReward:          estimate.MinReward.String(),
+		MaxReward:          estimate.MaxReward.String(),
+		CompetitionSamples: int64(len(shares)),
+	}, nil
+}
+
+func (s *MiningHandler) ListBlockStats(ctx context.Context, req *pb.ListBlockStatsRequest) (*pb.ListBlockStatsReply, error) {
+	tickName := strings.TrimSpace(req.Tick)
+	if tickName == "" {
+		return nil, status.Error(codes.InvalidArgument, "tick is required")
+	}
+
+	stats, err := s.miningRepo.Query(ctx, &mining.StatsQuery{
+		Tick:       tickName,
+		StartBlock: req.StartBlock,
+		EndBlock:   req.EndBlock,
+		Limit:      int(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var data = make([]*pb.MiningBlockStats, 0, len(stats))
+	for _, item := range stats {
+		data = append(data, convertMiningStatsToPB(item))
+	}
+
+	return &pb.ListBlockStatsReply{Data: data}, nil
+}
+
+func (s *MiningHandler) SubscribeBlockStats(req *pb.SubscribeBlockStatsRequest, conn pb.Mining_SubscribeBlockStatsServer) error {
+
+	stream, err := s.miningRepo.Subscribe(conn.Context(), strings.TrimSpace(req.Tick), req.StartBlock)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-conn.Context().Done():
+			s.logger.Info("SubscribeBlockStats stream closed")
+			return nil
+
+		case err := <-stream.Err():
+			return err
+
+		case data, ok := <-stream.Next():
+			if !ok {
+				return nil
+			}
+
+			if err := conn.Send(convertMiningStatsToPB(data)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// 统计打包区块之前(不包含打包区块) blockNum 个已经处理过的区块内, 每个区块 pow mint 的总份额.
+// 没有 pow mint 的区块竞争份额为 0, 同样计入样本
+func (s *MiningHandler) queryCompetitionShares(ctx context.Context, tickName string, currentBlock uint64, blockNum int) ([]decimal.Decimal, error) {
+	if currentBlock <= 1 || blockNum <= 0 {
+		return nil, nil
+	}
+
+	lastBlock, err := s.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil || lastBlock == nil {
+		return nil, err
+	}
+
+	// 统计区间 [startBlock, endBlock]
+	var (
+		endBlock   = min(currentBlock-1, lastBlock.Number)
+		startBlock = uint64(1)
+	)
+	if endBlock >= uint64(blockNum) {
+		startBlock = endBlock - uint64(blockNum) + 1
+	}
+	if endBlock < startBlock {
+		return nil, nil
+	}
+
+	// 只返回有事件的区块, 区间内最多 endBlock - startBlock + 1 个
+	blocks, err := s.eventRepo.QueryEventsByBlocks(ctx, startBlock-1, int(endBlock-startBlock+1))
+	if err != nil {
+		return nil, err
+	}
+
+	var sharesOfBlock = make(map[uint64]decimal.Decimal)
+	for _, block := range blocks {
+		if block.BlockNumber < startBlock || block.BlockNumber > endBlock {
+			continue
+		}
+
+		// 同一个区块内所有 pow mint 事件的总份额相同, 取第一个即可
+		for _, event := range block.Events {
+			ee, ok := event.(*domain.IERCPoWMintedEvent)
+			if !ok || ee.ErrCode != 0 || !ee.Data.IsPoW || ee.Data.Tick != tickName {
+				continue
+			}
+
+			sharesOfBlock[block.BlockNumber] = ee.Data.PoWTotalShare
+			break
+		}
+	}
+
+	var shares = make([]decimal.Decimal, 0, endBlock-startBlock+1)
+	for blockNumber := startBlock; blockNumber <= endBlock; blockNumber++ {
+		share, existed := sharesOfBlock[blockNumber]
+		if !existed {
+			share = decimal.Zero
+		}
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
+
+func convertMiningStatsToPB(stats *mining.BlockStats) *pb.MiningBlockStats {
+	var rejections = make([]*pb.MiningBlockStats_Rejection, 0, len(stats.Rejections))
+	for _, rejection := range stats.Rejections {
+		rejections = append(rejections, &pb.MiningBlockStats_Rejection{
+			Code:   rejection.Code,
+			Reason: rejection.Reason,
+			Count:  rejection.Count,
+		})
+	}
+
+	return &pb.MiningBlockStats{
+		Tick:          stats.Tick,
+		BlockNumber:   stats.BlockNumber,
+		Miners:        stats.Miners,
+		PowMiners:     stats.PoWMiners,
+		PosMiners:     stats.PoSMiners,
+		DualMiners:    stats.DualMiners,
+		PowTotalShare: stats.PoWTotalShare.String(),
+		PosTotalShare: stats.PoSTotalShare.String(),
+		PowOutput:     stats.PoWOutput.String(),
+		PosOutput:     stats.PoSOutput.String(),
+		BurnAmount:    stats.BurnAmount.String(),
+		Rejected:      stats.Rejected,
+		Rejections:    rejections,
+	}
+}
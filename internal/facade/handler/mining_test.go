@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMiningHandler(t *testing.T) {
+	suite.Run(t, new(TestMiningHandlerSuite))
+}
+
+type TestMiningHandlerSuite struct {
+	suite.Suite
+	events *mockEventRepo
+	blocks *mockBlockRepo
+	h      *MiningHandler
+}
+
+func (s *TestMiningHandlerSuite) SetupTest() {
+	s.events = &mockEventRepo{blocks: []*domain.EventsByBlock{
+		{BlockNumber: 96, Events: []domain.Event{newPoWMinted(96, "ierc-m", 50)}},
+		{BlockNumber: 97, Events: []domain.Event{newPoWMinted(97, "ethi", 10)}}, // 其他 tick
+		{BlockNumber: 98, Events: []domain.Event{newPoWMinted(98, "ierc-m", 20), newPoWMinted(98, "ierc-m", 20)}},
+		{BlockNumber: 100, Events: []domain.Event{newPoWMinted(100, "ierc-m", 30)}}, // 打包区块
+	}}
+	s.blocks = &mockBlockRepo{lastBlock: 100}
+	s.h = NewMiningHandler(nil, s.events, s.blocks, nil, log.DefaultLogger)
+}
+
+func (s *TestMiningHandlerSuite) TestCompetitionShares() {
+	shares, err := s.h.queryCompetitionShares(context.Background(), "ierc-m", 100, 4)
+	s.Require().NoError(err)
+
+	// 统计 [96, 99], 不包含打包区块. 没有 pow mint 的区块份额为 0
+	s.Equal([]string{"50", "0", "20", "0"}, decimalsToStrings(shares))
+}
+
+func (s *TestMiningHandlerSuite) TestCompetitionSharesUnhandled() {
+	// 只统计已经处理过的区块
+	s.blocks.lastBlock = 97
+	shares, err := s.h.queryCompetitionShares(context.Background(), "ierc-m", 100, 2)
+	s.Require().NoError(err)
+	s.Equal([]string{"50", "0"}, decimalsToStrings(shares))
+
+	// 从第一个区块开始统计
+	shares, err = s.h.queryCompetitionShares(context.Background(), "ierc-m", 3, 10)
+	s.Require().NoError(err)
+	s.Equal([]string{"0", "0"}, decimalsToStrings(shares))
+
+	// 没有可以统计的区块
+	shares, err = s.h.queryCompetitionShares(context.Background(), "ierc-m", 1, 10)
+	s.Require().NoError(err)
+	s.Empty(shares)
+
+	s.blocks.lastBlock = 0
+	shares, err = s.h.queryCompetitionShares(context.Background(), "ierc-m", 100, 10)
+	s.Require().NoError(err)
+	s.Empty(shares)
+}
+
+func newPoWMinted(blockNumber uint64, tickName string, totalShare int64) *domain.IERCPoWMintedEvent {
+	return &domain.IERCPoWMintedEvent{
+		BlockNumber: blockNumber,
+		Data: &domain.IERCPoWMinted{
+			Tick:          tickName,
+			IsPoW:         true,
+			PoWTotalShare: decimal.NewFromInt(totalShare),
+		},
+	}
+}
+
+func decimalsToStrings(items []decimal.Decimal) []string {
+	var result = make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.String())
+	}
+	return result
+}
+
+type mockEventRepo struct {
+	domain.EventRepository
+	blocks []*domain.EventsByBlock
+}
+
+func (m *mockEventRepo) QueryEventsByBlocks(_ context.Context, startBlock uint64, blockNum int) ([]*domain.EventsByBlock, error) {
+	var result []*domain.EventsByBlock
+	for _, block := range m.blocks {
+		if block.BlockNumber > startBlock && len(result) < blockNum {
+			result = append(result, block)
+		}
+	}
+	return result, nil
+}
+
+type mockBlockRepo struct {
+	domain.BlockRepository
+	lastBlock uint64
+}
+
+func (m *mockBlockRepo) GetLastHandleBlock(context.Context) (*domain.BlockHeader, error) {
+	if m.lastBlock == 0 {
+		return nil, nil
+	}
+	return &domain.BlockHeader{Number: m.lastBlock}, nil
+}
@@ -0,0 +1,380 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/domain/staking"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 质押查询接口
+type StakingHandler struct {
+	pb.UnimplementedStakingServer
+
+	stakingRepo staking.StakingRepository
+	rewardsRepo staking.RewardsRecordRepository
+	blockRepo   domain.BlockRepository
+
+	logger *log.Helper
+}
+
+func NewStakingHandler(
+	stakingRepo staking.StakingRepository,
+	rewardsRepo staking.RewardsRecordRepository,
+	blockRepo domain.BlockRepository,
+	logger log.Logger,
+) *StakingHandler {
+	return &StakingHandler{
+		UnimplementedStakingServer: pb.UnimplementedStakingServer{},
+		stakingRepo:                stakingRepo,
+		rewardsRepo:                rewardsRepo,
+		blockRepo:                  blockRepo,
+		logger:                     log.NewHelper(log.With(logger, "module", "staking")),
+	}
+}
+
+func (s *StakingHandler) ListRewardsHistory(ctx context.Context, req *pb.ListRewardsHistoryRequest) (*pb.ListRewardsHistoryReply, error) {
+	query := &staking.RewardsRecordQuery{
+		Pool:       address.Canonical(req.Pool),
+		PoolSubIDs: req.PoolSubIds,
+		Staker:     address.Canonical(req.Staker),
+		StartBlock: req.StartBlock,
+		EndBlock:   req.EndBlock,
+		Cursor:     strings.TrimSpace(req.Cursor),
+		Limit:      pageLimit(req.Limit, maxPageLimit, maxPageLimit),
+	}
+
+	// 至少指定池子或者质押人, 避免全表查询
+	if query.Pool == "" && query.Staker == "" {
+		return nil, status.Error(codes.InvalidArgument, "pool or staker is required")
+	}
+
+	records, err := s.rewardsRepo.Query(ctx, query)
+	if err != nil {
+		if errors.Is(err, staking.ErrInvalidRewardsCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	var data = make([]*pb.ListRewardsHistoryReply_Record, 0, len(records))
+	for _, record := range records {
+		data = append(data, convertRewardsRecordToPB(record))
+	}
+
+	var nextCursor string
+	if len(records) == query.Limit {
+		last := records[len(records)-1]
+		nextCursor = staking.RewardsRecordCursor{BlockNumber: last.BlockNumber, ID: last.ID}.Encode()
+	}
+
+	return &pb.ListRewardsHistoryReply{Data: data, NextCursor: nextCursor}, nil
+}
+
+func (s *StakingHandler) GetRewardsStats(ctx context.Context, req *pb.GetRewardsStatsRequest) (*pb.GetRewardsStatsReply, error) {
+	var (
+		poolAddress = address.Canonical(req.Pool)
+		staker      = address.Canonical(req.Staker)
+	)
+
+	if poolAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "pool is required")
+	}
+
+	var stakers []string
+	if staker != "" {
+		stakers = append(stakers, staker)
+	}
+
+	pool, err := s.stakingRepo.LoadPool(ctx, poolAddress, stakers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if pool == nil {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+
+	// 按最后处理的区块计算
+	blockNumber, err := s.getLastHandleBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectedBlock := max(blockNumber, req.ProjectedBlock)
+
+	var subPools []*pb.GetRewardsStatsReply_SubPoolStats
+	for _, subPool := range sortedSubPools(pool) {
+		stats := &pb.GetRewardsStatsReply_SubPoolStats{
+			PoolSubId: subPool.PoolSubID,
+			Name:      subPool.Detail.Name,
+			StopBlock: subPool.Detail.StopBlock,
+		}
+
+		for _, item := range subPool.CalcTickRewards(blockNumber) {
+			stats.Ticks = append(stats.Ticks, &pb.GetRewardsStatsReply_TickStats{
+				Tick:          item.Tick,
+				Ratio:         item.Ratio.String(),
+				Amount:        item.Amount.String(),
+				YearlyRewards: item.YearlyRewards.String(),
+			})
+		}
+
+		if staker != "" {
+			if rewards := subPool.CalcStakerRewards(blockNumber, projectedBlock, staker); rewards != nil {
+				stats.Staker = &pb.GetRewardsStatsReply_StakerStats{
+					RewardsPerBlock:  rewards.RewardsPerBlock.String(),
+					AvailableRewards: rewards.AvailableRewards.String(),
+					ProjectedRewards: rewards.ProjectedRewards.String(),
+				}
+			}
+		}
+
+		subPools = append(subPools, stats)
+	}
+
+	return &pb.GetRewardsStatsReply{
+		BlockNumber:    blockNumber,
+		ProjectedBlock: projectedBlock,
+		SubPools:       subPools,
+	}, nil
+}
+
+func (s *StakingHandler) ListPools(ctx context.Context, req *pb.ListPoolsRequest) (*pb.ListPoolsReply, error) {
+	var (
+		poolAddress = address.Canonical(req.Pool)
+		owner       = address.Canonical(req.Owner)
+		pools       []string
+	)
+
+	if poolAddress != "" {
+		pools = append(pools, poolAddress)
+	}
+
+	roots, err := s.stakingRepo.QueryPools(ctx, pools...)
+	if err != nil {
+		return nil, err
+	}
+
+	var data = make([]*pb.ListPoolsReply_Pool, 0, len(roots))
+	for _, root := range roots {
+		if owner != "" && root.Owner != owner {
+			continue
+		}
+
+		data = append(data, convertPoolAggregateToPB(root))
+	}
+
+	return &pb.ListPoolsReply{Data: data}, nil
+}
+
+func (s *StakingHandler) ListPositions(ctx context.Context, req *pb.ListPositionsRequest) (*pb.ListPositionsReply, error) {
+	var (
+		staker      = address.Canonical(req.Staker)
+		poolAddress = address.Canonical(req.Pool)
+		pools       []string
+	)
+
+	if staker == "" {
+		return nil, status.Error(codes.InvalidArgument, "staker is required")
+	}
+
+	if poolAddress != "" {
+		pools = append(pools, poolAddress)
+	}
+
+	blockNumber, err := s.getLastHandleBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := s.stakingRepo.QueryPools(ctx, pools...)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*pb.StakingPosition
+	for _, root := range roots {
+		subPools := sortedSubPools(root)
+		for i := range subPools {
+			position := subPools[i].GetPosition(staker)
+			if position == nil {
+				continue
+			}
+
+			data = append(data, convertStakingPositionToPB(subPools[i], position, blockNumber))
+		}
+	}
+
+	return &pb.ListPositionsReply{BlockNumber: blockNumber, Data: data}, nil
+}
+
+func (s *StakingHandler) ListStakers(ctx context.Context, req *pb.ListStakersRequest) (*pb.ListStakersReply, error) {
+	var (
+		poolAddress = address.Canonical(req.Pool)
+		cursor      = address.Canonical(req.Cursor)
+		limit       = pageLimit(req.Limit, defaultPageLimit, maxPageLimit)
+	)
+
+	if poolAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "pool is required")
+	}
+
+	root, err := s.stakingRepo.LoadPool(ctx, poolAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if root == nil {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+
+	pool, existed := root.GetStakingPool(req.PoolSubId)
+	if !existed {
+		return nil, status.Error(codes.NotFound, "sub pool not found")
+	}
+
+	blockNumber, err := s.getLastHandleBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 仓位已按质押人地址排序, 跳过游标及之前的质押人
+	positions := pool.GetPositions()
+	start := sort.Search(len(positions), func(i int) bool {
+		return positions[i].Staker > cursor
+	})
+	positions = positions[start:]
+
+	var nextCursor string
+	if len(positions) > limit {
+		positions = positions[:limit]
+		nextCursor = positions[limit-1].Staker
+	}
+
+	var data = make([]*pb.StakingPosition, 0, len(positions))
+	for _, position := range positions {
+		data = append(data, convertStakingPositionToPB(pool, position, blockNumber))
+	}
+
+	return &pb.ListStakersReply{BlockNumber: blockNumber, Data: data, NextCursor: nextCursor}, nil
+}
+
+// 最后处理的区块, 没有处理过区块时返回 0
+func (s *StakingHandler) getLastHandleBlockNumber(ctx context.Context) (uint64, error) {
+	lastBlock, err := s.blockRepo.GetLastHandleBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if lastBlock == nil {
+		return 0, nil
+	}
+
+	return lastBlock.Number, nil
+}
+
+// 各个接口统一按子池ID升序返回子池
+func sortedSubPools(root *staking.PoolAggregate) []*staking.StakingPool {
+	subPools := root.GetStakingPools()
+	sort.Slice(subPools, func(i, j int) bool {
+		return subPools[i].PoolSubID < subPools[j].PoolSubID
+	})
+
+	return subPools
+}
+
+func convertPoolAggregateToPB(root *staking.PoolAggregate) *pb.ListPoolsReply_Pool {
+	subPools := sortedSubPools(root)
+
+	var result = &pb.ListPoolsReply_Pool{
+		Pool:     root.PoolAddress,
+		Owner:    root.Owner,
+		SubPools: make([]*pb.ListPoolsReply_SubPool, 0, len(subPools)),
+	}
+
+	for _, pool := range subPools {
+		subPool := &pb.ListPoolsReply_SubPool{
+			PoolSubId:        pool.PoolSubID,
+			Name:             pool.Detail.Name,
+			Owner:            pool.Detail.Owner,
+			Admins:           pool.Detail.Admins,
+			StartBlock:       pool.Detail.StartBlock,
+			StopBlock:        pool.Detail.StopBlock,
+			StakerCount:      int64(pool.StakerCount()),
+			LastUpdatedBlock: pool.LastUpdatedBlock,
+		}
+
+		details := make([]*staking.PoolTickDetail, 0, len(pool.Detail.TickDetails))
+		for _, detail := range pool.Detail.TickDetails {
+			details = append(details, detail)
+		}
+		sort.Slice(details, func(i, j int) bool {
+			return details[i].Index < details[j].Index
+		})
+
+		for _, detail := range details {
+			subPool.Ticks = append(subPool.Ticks, &pb.ListPoolsReply_Tick{
+				Tick:          detail.Tick,
+				Ratio:         detail.Ratio.String(),
+				Amount:        detail.Amount.String(),
+				MaxAmount:     detail.MaxAmount.String(),
+				HistoryAmount: detail.HistoryAmount.String(),
+			})
+		}
+
+		result.SubPools = append(result.SubPools, subPool)
+	}
+
+	return result
+}
+
+func convertStakingPositionToPB(pool *staking.StakingPool, position *staking.StakingPosition, blockNumber uint64) *pb.StakingPosition {
+	ticks := make([]*pb.StakingPosition_Tick, 0, len(position.TickDetails))
+	for _, detail := range position.TickDetails {
+		ticks = append(ticks, &pb.StakingPosition_Tick{
+			Tick:   detail.Tick,
+			Ratio:  detail.Ratio.String(),
+			Amount: detail.Amount.String(),
+		})
+	}
+	sort.Slice(ticks, func(i, j int) bool {
+		return ticks[i].Tick < ticks[j].Tick
+	})
+
+	return &pb.StakingPosition{
+		Pool:             position.PoolAddress,
+		PoolSubId:        position.PoolSubID,
+		Staker:           position.Staker,
+		Ticks:            ticks,
+		RewardsPerBlock:  position.RewardsPerBlock.String(),
+		AccRewards:       position.AccReward.String(),
+		Debt:             position.Debt.String(),
+		AvailableRewards: pool.CalcAvailableRewards(blockNumber, position.Staker).String(),
+		LastRewardBlock:  position.LastRewardBlock,
+		LastUpdatedBlock: position.LastUpdatedBlock,
+	}
+}
+
+func convertRewardsRecordToPB(record *staking.RewardsRecord) *pb.ListRewardsHistoryReply_Record {
+	return &pb.ListRewardsHistoryReply_Record{
+		Pool:            record.Pool,
+		PoolSubId:       record.PoolSubID,
+		Staker:          record.Staker,
+		Kind:            string(record.Kind),
+		Amount:          record.Amount.String(),
+		AccRewards:      record.AccReward.String(),
+		Debt:            record.Debt.String(),
+		RewardsPerBlock: record.RewardsPerBlock.String(),
+		BlockNumber:     record.BlockNumber,
+		CreatedAt:       record.CreatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,326 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/url"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/go-kratos/kratos/v2/transport/http/binding"
+	"github.com/gorilla/websocket"
+	pb "github.com/kevin88886/eth_indexer/api/indexer"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/facade/handler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// 事件订阅, 参数与 SubscribeEvent 一致
+	streamEventsPath = "/api/v2/stream/events"
+	// 系统状态订阅, 参数与 SubscribeSystemStatus 一致
+	streamStatusPath = "/api/v2/stream/status"
+
+	// 心跳间隔, 避免代理断开空闲连接
+	streamHeartbeatInterval = time.Second * 15
+	// websocket 单条消息的写超时
+	streamWriteTimeout = time.Second * 10
+)
+
+// 流式接口网关. 将 gRPC 的订阅接口以 WebSocket 和 Server-Sent Events 的方式提供给浏览器.
+// 请求头带有 websocket 升级时使用 WebSocket, 否则使用 SSE. 消息体与 HTTP 接口一致, 使用 protojson 序列化.
+// 以 http.Filter 的方式挂载在路由之前, 不受 HTTP 接口超时时间的限制
+type streamGateway struct {
+	handler        *handler.IndexHandler
+	upgrader       websocket.Upgrader
+	allowedOrigins []string
+	logger         *log.Helper
+}
+
+// allowedOrigins 为允许跨域订阅的来源, 为空时只允许同源请求, "*" 表示允许所有来源
+func newStreamGateway(h *handler.IndexHandler, allowedOrigins []string, logger log.Logger) *streamGateway {
+	g := &streamGateway{
+		handler:        h,
+		allowedOrigins: allowedOrigins,
+		logger:         log.NewHelper(log.With(logger, "module", "stream")),
+	}
+	g.upgrader = websocket.Upgrader{CheckOrigin: g.checkOrigin}
+	return g
+}
+
+// 检查请求来源. 没有 Origin 请求头的非浏览器客户端和同源请求总是允许
+func (g *streamGateway) checkOrigin(r *nethttp.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	for _, allowed := range g.allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// 订阅过程中的 panic 转为错误返回, 避免单个连接导致进程退出
+func (g *streamGateway) recoverStream(path string, fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			g.logger.Errorf("stream panic. path: %s, panic: %v\n%s", path, r, debug.Stack())
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return fn()
+}
+
+func (g *streamGateway) Filter(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch r.URL.Path {
+		case streamEventsPath:
+			g.serveEvents(w, r)
+		case streamStatusPath:
+			g.serveStatus(w, r)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func (g *streamGateway) serveEvents(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req pb.SubscribeRequest
+	if err := binding.BindQuery(r.URL.Query(), &req); err != nil {
+		http.DefaultErrorEncoder(w, r, err)
+		return
+	}
+
+	// SSE 断线重连时, 浏览器通过 Last-Event-ID 带上最后收到的续订令牌
+	if req.ResumeToken == "" {
+		req.ResumeToken = r.Header.Get("Last-Event-ID")
+	}
+
+	// 升级连接之前校验参数, 以便返回 HTTP 错误
+	if req.ResumeToken != "" {
+		if _, err := domain.ParseResumeToken(req.ResumeToken); err != nil {
+			http.DefaultErrorEncoder(w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+	}
+
+	serve(g, w, r, func(ctx context.Context, send func(*pb.SubscribeReply) error) error {
+		return g.handler.StreamEvents(ctx, &req, send)
+	}, func(reply *pb.SubscribeReply) string {
+		return reply.ResumeToken
+	})
+}
+
+func (g *streamGateway) serveStatus(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var req pb.SubscribeSystemStatusRequest
+	if err := binding.BindQuery(r.URL.Query(), &req); err != nil {
+		http.DefaultErrorEncoder(w, r, err)
+		return
+	}
+
+	serve(g, w, r, func(ctx context.Context, send func(*pb.SubscribeSystemStatusReply) error) error {
+		return g.handler.StreamSystemStatus(ctx, &req, send)
+	}, nil)
+}
+
+// 根据请求选择 WebSocket 或 SSE. eventID 返回 SSE 消息的ID, 为 nil 时不设置
+func serve[T proto.Message](
+	g *streamGateway,
+	w nethttp.ResponseWriter,
+	r *nethttp.Request,
+	stream func(ctx context.Context, send func(T) error) error,
+	eventID func(T) string,
+) {
+	if websocket.IsWebSocketUpgrade(r) {
+		g.serveWebSocket(w, r, func(ctx context.Context, send func(proto.Message) error) error {
+			return stream(ctx, func(reply T) error { return send(reply) })
+		})
+		return
+	}
+
+	g.serveSSE(w, r, func(ctx context.Context, send func(proto.Message, string) error) error {
+		return stream(ctx, func(reply T) error {
+			var id string
+			if eventID != nil {
+				id = eventID(reply)
+			}
+			return send(reply, id)
+		})
+	})
+}
+
+func (g *streamGateway) serveSSE(w nethttp.ResponseWriter, r *nethttp.Request, stream func(ctx context.Context, send func(proto.Message, string) error) error) {
+	flusher, ok := w.(nethttp.Flusher)
+	if !ok {
+		http.DefaultErrorEncoder(w, r, status.Error(codes.Unimplemented, "streaming unsupported"))
+		return
+	}
+
+	if !g.checkOrigin(r) {
+		http.DefaultErrorEncoder(w, r, status.Error(codes.PermissionDenied, "origin not allowed"))
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	if origin := r.Header.Get("Origin"); origin != "" {
+		header.Set("Access-Control-Allow-Origin", origin)
+		header.Add("Vary", "Origin")
+	}
+	w.WriteHeader(nethttp.StatusOK)
+	flusher.Flush()
+
+	var (
+		ctx, cancel = context.WithCancel(r.Context())
+		writes      = make(chan []byte)
+		errCh       = make(chan error, 1)
+	)
+	defer cancel()
+
+	// 订阅在独立的协程中运行, 由当前协程负责写入和心跳, 避免并发写
+	go func() {
+		errCh <- g.recoverStream(r.URL.Path, func() error {
+			return stream(ctx, func(reply proto.Message, id string) error {
+				data, err := json.MarshalOptions.Marshal(reply)
+				if err != nil {
+					return err
+				}
+
+				var message []byte
+				if id != "" {
+					message = fmt.Appendf(message, "id: %s\n", id)
+				}
+				message = fmt.Appendf(message, "data: %s\n\n", data)
+
+				select {
+				case writes <- message:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+		})
+	}()
+
+	ticker := time.NewTicker(streamHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		var message []byte
+		select {
+		case <-ctx.Done():
+			return
+
+		case err := <-errCh:
+			if err != nil && ctx.Err() == nil {
+				g.logger.Errorf("sse stream failed. path: %s, error: %s", r.URL.Path, err)
+				data, _ := json.MarshalOptions.Marshal(status.Convert(err).Proto())
+				_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+				flusher.Flush()
+			}
+			return
+
+		case <-ticker.C:
+			message = []byte(": ping\n\n")
+
+		case message = <-writes:
+		}
+
+		if _, err := w.Write(message); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (g *streamGateway) serveWebSocket(w nethttp.ResponseWriter, r *nethttp.Request, stream func(ctx context.Context, send func(proto.Message) error) error) {
+	conn, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade 已经返回了 HTTP 错误
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// 客户端只接收消息, 读取是为了处理控制帧和感知连接断开
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	// 心跳在独立的协程中发送, WriteControl 可以与 WriteMessage 并发调用
+	go func() {
+		ticker := time.NewTicker(streamHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err = g.recoverStream(r.URL.Path, func() error {
+		return stream(ctx, func(reply proto.Message) error {
+			data, err := json.MarshalOptions.Marshal(reply)
+			if err != nil {
+				return err
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			return conn.WriteMessage(websocket.TextMessage, data)
+		})
+	})
+
+	var closeMessage = websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err != nil && ctx.Err() == nil {
+		g.logger.Errorf("websocket stream failed. path: %s, error: %s", r.URL.Path, err)
+
+		code := websocket.CloseInternalServerErr
+		if status.Code(err) == codes.InvalidArgument {
+			code = websocket.ClosePolicyViolation
+		}
+		// 关闭帧的原因最长 123 字节
+		reason := status.Convert(err).Message()
+		if len(reason) > 123 {
+			reason = reason[:123]
+		}
+		closeMessage = websocket.FormatCloseMessage(code, reason)
+	}
+
+	_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(streamWriteTimeout))
+}
@@ -0,0 +1,206 @@
+package facade
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/gorilla/websocket"
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/facade/handler"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStreamGateway(t *testing.T) {
+	suite.Run(t, new(TestStreamGatewaySuite))
+}
+
+type TestStreamGatewaySuite struct {
+	suite.Suite
+	repo   *mockEventRepo
+	server *httptest.Server
+}
+
+func (s *TestStreamGatewaySuite) SetupTest() {
+	s.repo = &mockEventRepo{subscribed: make(chan struct{}, 1)}
+	h := handler.NewIndexHandler(nil, s.repo, nil, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+
+	gateway := newStreamGateway(h, []string{"https://app.example.com"}, log.DefaultLogger)
+	s.server = httptest.NewServer(gateway.Filter(nethttp.NotFoundHandler()))
+}
+
+func (s *TestStreamGatewaySuite) TearDownTest() {
+	s.server.Close()
+}
+
+// 推送的订阅回复, 与 protojson 的字段名一致
+type streamReply struct {
+	BlockNumber string `json:"blockNumber"`
+	ResumeToken string `json:"resumeToken"`
+	CaughtUp    bool   `json:"caughtUp"`
+}
+
+func (s *TestStreamGatewaySuite) TestSSE() {
+	token := domain.EventStreamPosition{Cursor: 10, LastBlock: 8}.Token()
+
+	req, err := nethttp.NewRequest(nethttp.MethodGet, s.server.URL+streamEventsPath+"?ticks=ethi&success_only=true", nil)
+	s.Require().NoError(err)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Last-Event-ID", token)
+
+	resp, err := nethttp.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.Equal(nethttp.StatusOK, resp.StatusCode)
+	s.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+	s.Empty(resp.Header.Get("Connection"))
+
+	var (
+		ids     []string
+		replies []streamReply
+		reader  = bufio.NewReader(resp.Body)
+	)
+	for len(replies) < 2 {
+		line, err := reader.ReadString('\n')
+		s.Require().NoError(err)
+
+		if id, ok := strings.CutPrefix(line, "id: "); ok {
+			ids = append(ids, strings.TrimSpace(id))
+		}
+		if data, ok := strings.CutPrefix(line, "data: "); ok {
+			var reply streamReply
+			s.Require().NoError(json.Unmarshal([]byte(data), &reply))
+			replies = append(replies, reply)
+		}
+	}
+
+	// 通过 Last-Event-ID 续订, 过滤条件与 gRPC 订阅一致
+	<-s.repo.subscribed
+	s.Equal(domain.EventStreamPosition{Cursor: 10, LastBlock: 8}, s.repo.position)
+	s.Equal([]string{"ethi"}, s.repo.filter.Ticks)
+	s.True(s.repo.filter.SuccessOnly)
+
+	s.Equal("11", replies[0].BlockNumber)
+	s.Equal(ids[0], replies[0].ResumeToken)
+	s.True(replies[1].CaughtUp)
+	s.Len(ids, 2)
+}
+
+func (s *TestStreamGatewaySuite) TestWebSocket() {
+	url := "ws" + strings.TrimPrefix(s.server.URL, "http") + streamEventsPath + "?start_block=10&addresses=0xAA"
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	var replies []streamReply
+	for len(replies) < 2 {
+		var reply streamReply
+		s.Require().NoError(conn.ReadJSON(&reply))
+		replies = append(replies, reply)
+	}
+
+	<-s.repo.subscribed
+	s.Equal(domain.EventStreamPosition{Cursor: 10}, s.repo.position)
+	s.Equal([]string{"0xaa"}, s.repo.filter.Addresses)
+	s.Equal("11", replies[0].BlockNumber)
+	s.True(replies[1].CaughtUp)
+}
+
+func (s *TestStreamGatewaySuite) TestInvalidRequest() {
+	resp, err := nethttp.Get(s.server.URL + streamEventsPath + "?resume_token=invalid")
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(nethttp.StatusBadRequest, resp.StatusCode)
+
+	// 非流式接口交给路由处理
+	resp, err = nethttp.Get(s.server.URL + "/api/v2/index/events")
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(nethttp.StatusNotFound, resp.StatusCode)
+
+	select {
+	case <-s.repo.subscribed:
+		s.Fail("unexpected subscribe")
+	default:
+	}
+}
+
+func (s *TestStreamGatewaySuite) TestOrigin() {
+	url := "ws" + strings.TrimPrefix(s.server.URL, "http") + streamEventsPath
+
+	// 不在允许列表中的来源
+	header := nethttp.Header{"Origin": []string{"https://evil.example.com"}}
+	_, resp, err := websocket.DefaultDialer.Dial(url, header)
+	s.Error(err)
+	s.Require().NotNil(resp)
+	s.Equal(nethttp.StatusForbidden, resp.StatusCode)
+
+	req, err := nethttp.NewRequest(nethttp.MethodGet, s.server.URL+streamEventsPath, nil)
+	s.Require().NoError(err)
+	req.Header.Set("Origin", "https://evil.example.com")
+	resp, err = nethttp.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(nethttp.StatusForbidden, resp.StatusCode)
+
+	// 允许列表中的来源
+	header = nethttp.Header{"Origin": []string{"https://app.example.com"}}
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	s.Require().NoError(err)
+	_ = conn.Close()
+	<-s.repo.subscribed
+}
+
+func (s *TestStreamGatewaySuite) TestPanic() {
+	s.repo.panic = true
+
+	// SSE 返回错误事件
+	resp, err := nethttp.Get(s.server.URL + streamEventsPath)
+	s.Require().NoError(err)
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	s.Require().NoError(err)
+	s.Contains(string(body), "event: error")
+
+	// WebSocket 以内部错误关闭
+	url := "ws" + strings.TrimPrefix(s.server.URL, "http") + streamEventsPath
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	_, _, err = conn.ReadMessage()
+	s.True(websocket.IsCloseError(err, websocket.CloseInternalServerErr), "error: %v", err)
+}
+
+// 订阅后推送一个区块和追平标记
+type mockEventRepo struct {
+	domain.EventRepository
+	position   domain.EventStreamPosition
+	filter     *domain.EventFilter
+	subscribed chan struct{}
+	panic      bool
+}
+
+func (m *mockEventRepo) SubscribeEvent(_ context.Context, position domain.EventStreamPosition, filter *domain.EventFilter) (*domain.Stream[domain.EventNotification], error) {
+	if m.panic {
+		panic("subscribe failed")
+	}
+
+	m.position = position
+	m.filter = filter
+	m.subscribed <- struct{}{}
+
+	next := domain.EventStreamPosition{Cursor: position.Cursor + 1, LastBlock: position.Cursor + 1}
+	stream := domain.NewEventStream[domain.EventNotification](2)
+	stream.Send(&domain.EventNotification{Block: &domain.EventsByBlock{BlockNumber: next.Cursor}, Position: next})
+	stream.Send(&domain.EventNotification{Position: next, CaughtUp: true})
+	return stream, nil
+}
@@ -2,12 +2,14 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/allegro/bigcache"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/kevin88886/eth_indexer/internal/conf"
 	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
 	"gorm.io/driver/mysql"
@@ -71,6 +73,10 @@ func NewDB(c *conf.Config, l log.Logger) (*gorm.DB, func(), error) {
 		_ = db.Close()
 	}
 
+	// 部署区块字段是后加的, 只在字段新增时补全一次历史数据
+	backfillDeployBlock := inner.Migrator().HasTable(&models.IERCTick{}) &&
+		!inner.Migrator().HasColumn(&models.IERCTick{}, "deploy_block")
+
 	// 创建数据表
 	err = inner.
 		Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_bin").
@@ -83,9 +89,40 @@ func NewDB(c *conf.Config, l log.Logger) (*gorm.DB, func(), error) {
 			&models.StakingPool{},
 			&models.StakingPosition{},
 			&models.StakingBalance{},
+			&models.StakingRewardsRecord{},
+			&models.InvalidTx{},
+			&models.IERC20Allowance{},
+			&models.IERC20Vesting{},
+			&models.MiningBlockStats{},
+			&models.MarketOrder{},
+			&models.MarketTrade{},
+			&models.MarketCandle{},
+			&models.TickHolderStats{},
+			&models.Webhook{},
+			&models.WebhookDelivery{},
+			&models.WebhookCursor{},
+			&models.OutboxMessage{},
 		)
+	if err != nil {
+		return inner, cleanup, err
+	}
+
+	// 补全历史数据
+	if backfillDeployBlock {
+		if err = backfillTickDeployBlock(inner); err != nil {
+			return inner, cleanup, err
+		}
+	}
+
+	return inner, cleanup, nil
+}
 
-	return inner, cleanup, err
+// 新增部署区块字段之前部署的 tick, 从部署事件中补全部署区块
+func backfillTickDeployBlock(db *gorm.DB) error {
+	return db.Exec(fmt.Sprintf(
+		"UPDATE %s t JOIN (SELECT tick, MIN(block_number) AS block_number FROM %s WHERE operate = ? AND err_code = 0 GROUP BY tick) e ON e.tick = t.tick SET t.deploy_block = e.block_number WHERE t.deploy_block = 0",
+		(&models.IERCTick{}).TableName(), (&models.Event{}).TableName(),
+	), protocol.OpDeploy).Error
 }
 
 type Data struct {
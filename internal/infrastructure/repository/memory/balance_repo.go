@@ -65,6 +65,23 @@ func (repo *balanceMemoryRepo) Load(ctx context.Context, key balance.BalanceKey)
 	return entity, nil
 }
 
+// 查询接口需要返回一致的索引高度, 缓存无法保证, 直接查询数据库
+func (repo *balanceMemoryRepo) Get(ctx context.Context, key balance.BalanceKey) (*balance.Snapshot, error) {
+	return repo.db.Get(ctx, key)
+}
+
+func (repo *balanceMemoryRepo) GetMany(ctx context.Context, keys ...balance.BalanceKey) (*balance.Snapshot, error) {
+	return repo.db.GetMany(ctx, keys...)
+}
+
+func (repo *balanceMemoryRepo) QueryByAddress(ctx context.Context, query *balance.BalanceQuery) (*balance.Snapshot, error) {
+	return repo.db.QueryByAddress(ctx, query)
+}
+
+func (repo *balanceMemoryRepo) QueryByTick(ctx context.Context, query *balance.BalanceQuery) (*balance.Snapshot, error) {
+	return repo.db.QueryByTick(ctx, query)
+}
+
 func (repo *balanceMemoryRepo) updateCache(entities ...*balance.Balance) error {
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+)
+
+// 每个 tick 缓存的持仓排行数量
+const topHoldersCacheSize = 1000
+
+// 持仓统计和持仓排行的缓存. 统计在区块保存后更新, 排行在统计更新后失效
+type holderMemoryRepo struct {
+	db    holder.HolderRepository
+	mutex sync.RWMutex
+	stats map[string]*holder.Stats
+	tops  map[string][]*holder.Holder
+
+	version uint64 // 统计更新的次数. 避免把更新前查询到的排行写入缓存
+}
+
+func (repo *holderMemoryRepo) SaveStats(ctx context.Context, stats ...*holder.Stats) error {
+	updateKind := rctx.UpdateKindFromContext(ctx)
+	switch updateKind {
+	case rctx.UpdateCache:
+		return repo.updateCache(stats...)
+
+	case rctx.UpdateDB:
+		return repo.db.SaveStats(ctx, stats...)
+	default:
+		return nil
+	}
+}
+
+func (repo *holderMemoryRepo) LoadStats(ctx context.Context, ticks ...string) ([]*holder.Stats, error) {
+	var (
+		result  = make([]*holder.Stats, 0, len(ticks))
+		queries = make([]string, 0)
+	)
+
+	// 从缓存获取
+	repo.mutex.RLock()
+	for _, tickName := range ticks {
+		if stats, existed := repo.stats[tickName]; existed {
+			result = append(result, stats.Copy())
+			continue
+		}
+
+		queries = append(queries, tickName)
+	}
+	repo.mutex.RUnlock()
+
+	if len(queries) == 0 {
+		return result, nil
+	}
+
+	// 从数据库获取
+	entities, err := repo.db.LoadStats(ctx, queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	for _, stats := range entities {
+		// 加载期间缓存已被更新, 以缓存为准
+		if cached, existed := repo.stats[stats.Tick]; existed {
+			result = append(result, cached.Copy())
+			continue
+		}
+
+		repo.stats[stats.Tick] = stats.Copy()
+		result = append(result, stats)
+	}
+
+	return result, nil
+}
+
+func (repo *holderMemoryRepo) TopHolders(ctx context.Context, tick string, limit int) ([]*holder.Holder, error) {
+	if limit <= 0 || limit > topHoldersCacheSize {
+		limit = topHoldersCacheSize
+	}
+
+	repo.mutex.RLock()
+	holders, existed := repo.tops[tick]
+	version := repo.version
+	repo.mutex.RUnlock()
+
+	if !existed {
+		var err error
+		holders, err = repo.db.TopHolders(ctx, tick, topHoldersCacheSize)
+		if err != nil {
+			return nil, err
+		}
+
+		repo.mutex.Lock()
+		if repo.version == version {
+			repo.tops[tick] = holders
+		}
+		repo.mutex.Unlock()
+	}
+
+	// 缓存的数据只读, 返回前复制
+	limit = min(limit, len(holders))
+	var result = make([]*holder.Holder, 0, limit)
+	for _, entity := range holders[:limit] {
+		item := *entity
+		result = append(result, &item)
+	}
+
+	return result, nil
+}
+
+func (repo *holderMemoryRepo) Rebuild(ctx context.Context) error {
+	if err := repo.db.Rebuild(ctx); err != nil {
+		return err
+	}
+
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	repo.version++
+	repo.stats = make(map[string]*holder.Stats)
+	repo.tops = make(map[string][]*holder.Holder)
+	return nil
+}
+
+func (repo *holderMemoryRepo) updateCache(stats ...*holder.Stats) error {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	repo.version++
+	for _, entity := range stats {
+		repo.stats[entity.Tick] = entity.Copy()
+		delete(repo.tops, entity.Tick)
+	}
+
+	return nil
+}
+
+func NewHolderMemoryRepository(db holder.HolderRepository) holder.HolderRepository {
+	return &holderMemoryRepo{
+		db:    db,
+		mutex: sync.RWMutex{},
+		stats: make(map[string]*holder.Stats),
+		tops:  make(map[string][]*holder.Holder),
+	}
+}
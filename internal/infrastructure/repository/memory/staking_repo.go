@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"github.com/kevin88886/eth_indexer/internal/domain/staking"
 	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
@@ -10,6 +12,10 @@ import (
 type stakingMemoryRepo struct {
 	repo  staking.StakingRepository
 	pools map[string]*staking.PoolAggregate // 质押池. poolAddr => pools
+
+	// 质押池的只读快照, 供查询接口使用. 区块处理时会直接修改 pools, 所以查询不能读取 pools
+	mu        sync.RWMutex
+	snapshots map[string]*staking.PoolAggregate
 }
 
 // 查询质押池
@@ -22,18 +28,54 @@ func (s *stakingMemoryRepo) LoadAllPools(ctx context.Context) (map[string]*staki
 	return pools, nil
 }
 
+// 从快照中查询质押池, 快照包含所有仓位
+func (s *stakingMemoryRepo) LoadPool(ctx context.Context, pool string, stakers ...string) (*staking.PoolAggregate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.snapshots[pool], nil
+}
+
+func (s *stakingMemoryRepo) QueryPools(ctx context.Context, pools ...string) ([]*staking.PoolAggregate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result = make([]*staking.PoolAggregate, 0, len(s.snapshots))
+	if len(pools) == 0 {
+		for _, snapshot := range s.snapshots {
+			result = append(result, snapshot)
+		}
+	} else {
+		for _, pool := range pools {
+			if snapshot, existed := s.snapshots[pool]; existed {
+				result = append(result, snapshot)
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].PoolAddress < result[j].PoolAddress
+	})
+
+	return result, nil
+}
+
 // 保存质押池聚合
 func (s *stakingMemoryRepo) Save(ctx context.Context, blockNumber uint64, pools ...*staking.PoolAggregate) error {
 	updateKind := rctx.UpdateKindFromContext(ctx)
 	switch updateKind {
 	case rctx.UpdateCache:
 
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
 		for _, root := range pools {
 			if root.Owner == "" {
 				continue
 			}
 
 			s.pools[root.PoolAddress] = root
+			s.snapshots[root.PoolAddress] = root.Snapshot(blockNumber, s.snapshots[root.PoolAddress]) // 更新快照
 		}
 		return nil
 
@@ -57,9 +99,15 @@ func NewStakingMemoryRepository(repo staking.StakingRepository) (staking.Staking
 		roots = make(map[string]*staking.PoolAggregate)
 	}
 
+	var snapshots = make(map[string]*staking.PoolAggregate, len(roots))
+	for key, root := range roots {
+		snapshots[key] = root.Snapshot(0, nil)
+	}
+
 	srv := &stakingMemoryRepo{
-		repo:  repo,
-		pools: roots,
+		repo:      repo,
+		pools:     roots,
+		snapshots: snapshots,
 	}
 
 	return srv, nil
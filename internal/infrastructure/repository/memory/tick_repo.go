@@ -41,6 +41,11 @@ func (repo *tickMemoryRepo) Save(ctx context.Context, entities ...tick.Tick) err
 	}
 }
 
+// 列表查询直接查询数据库
+func (repo *tickMemoryRepo) Query(ctx context.Context, query *tick.TickQuery) ([]tick.Tick, error) {
+	return repo.db.Query(ctx, query)
+}
+
 func (repo *tickMemoryRepo) Load(ctx context.Context, tickName string) (tick.Tick, error) {
 
 	// 从缓存获取
@@ -73,6 +78,59 @@ func (repo *tickMemoryRepo) Load(ctx context.Context, tickName string) (tick.Tic
 	return entity, nil
 }
 
+// 先从缓存获取, 未命中的 tick 一次性从数据库查询
+func (repo *tickMemoryRepo) LoadMany(ctx context.Context, tickNames ...string) ([]tick.Tick, error) {
+	var (
+		result = make([]tick.Tick, 0, len(tickNames))
+		misses []string
+	)
+	for _, name := range tickNames {
+		entity, err := repo.getCache(name)
+		if err != nil {
+			misses = append(misses, name)
+			continue
+		}
+
+		result = append(result, entity)
+	}
+
+	if len(misses) == 0 {
+		return result, nil
+	}
+
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	// 再次从缓存获取
+	var names []string
+	for _, name := range misses {
+		entity, err := repo.getCache(name)
+		if err != nil {
+			names = append(names, name)
+			continue
+		}
+
+		result = append(result, entity)
+	}
+
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	// 从数据库获取
+	entities, err := repo.db.LoadMany(ctx, names...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entity := range entities {
+		repo.setCache(entity)
+		result = append(result, entity)
+	}
+
+	return result, nil
+}
+
 func (repo *tickMemoryRepo) updateCache(entities ...tick.Tick) error {
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
@@ -0,0 +1,34 @@
+package acl
+
+import (
+	"github.com/kevin88886/eth_indexer/internal/domain/allowance"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertAllowanceEntityToModel(entity *allowance.Allowance) *models.IERC20Allowance {
+	return &models.IERC20Allowance{
+		ID:               entity.ID,
+		Owner:            entity.Owner,
+		Spender:          entity.Spender,
+		Tick:             entity.Tick,
+		Amount:           entity.Amount,
+		SignNonce:        entity.SignNonce,
+		LastUpdatedBlock: entity.LastUpdatedBlock,
+		CreatedAt:        entity.CreatedAt,
+		UpdatedAt:        entity.UpdatedAt,
+	}
+}
+
+func ConvertAllowanceModelToEntity(m *models.IERC20Allowance) *allowance.Allowance {
+	return &allowance.Allowance{
+		ID:               m.ID,
+		Owner:            m.Owner,
+		Spender:          m.Spender,
+		Tick:             m.Tick,
+		Amount:           m.Amount,
+		SignNonce:        m.SignNonce,
+		LastUpdatedBlock: m.LastUpdatedBlock,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
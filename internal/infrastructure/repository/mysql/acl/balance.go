@@ -12,6 +12,7 @@ func ConvertBalanceEntityToModel(balance *balance.Balance) *models.IERC20Balance
 		Tick:             balance.Tick,
 		Available:        balance.Available,
 		Freeze:           balance.Freeze,
+		Total:            balance.Total(),
 		Minted:           balance.MintedAmount,
 		LastUpdatedBlock: balance.LastUpdatedBlock,
 		CreatedAt:        balance.CreatedAt,
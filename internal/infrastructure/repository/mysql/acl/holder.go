@@ -0,0 +1,43 @@
+package acl
+
+import (
+	"encoding/json"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertHolderStatsEntityToModel(stats *holder.Stats) *models.TickHolderStats {
+	buckets, _ := json.Marshal(stats.Buckets)
+
+	return &models.TickHolderStats{
+		Tick:               stats.Tick,
+		Holders:            stats.Holders,
+		Buckets:            buckets,
+		FirstActivityBlock: stats.FirstActivityBlock,
+		FirstActivityAt:    stats.FirstActivityAt,
+		LastActivityBlock:  stats.LastActivityBlock,
+		LastActivityAt:     stats.LastActivityAt,
+		LastUpdatedBlock:   stats.LastUpdatedBlock,
+	}
+}
+
+func ConvertHolderStatsModelToEntity(m *models.TickHolderStats) *holder.Stats {
+	stats := holder.NewStats(m.Tick)
+	_ = json.Unmarshal(m.Buckets, &stats.Buckets)
+
+	// 区间数量变化后, 补齐或截断
+	if len(stats.Buckets) != len(holder.BucketBounds)+1 {
+		buckets := make([]int64, len(holder.BucketBounds)+1)
+		copy(buckets, stats.Buckets)
+		stats.Buckets = buckets
+	}
+
+	stats.Holders = m.Holders
+	stats.FirstActivityBlock = m.FirstActivityBlock
+	stats.FirstActivityAt = m.FirstActivityAt
+	stats.LastActivityBlock = m.LastActivityBlock
+	stats.LastActivityAt = m.LastActivityAt
+	stats.LastUpdatedBlock = m.LastUpdatedBlock
+	return stats
+}
@@ -0,0 +1,26 @@
+package acl
+
+import (
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertInvalidTxEntityToModel(entity *domain.InvalidTx) *models.InvalidTx {
+	return &models.InvalidTx{
+		TxHash:         entity.TxHash,
+		Category:       entity.Category,
+		Reason:         entity.Reason,
+		EffectiveBlock: entity.EffectiveBlock,
+		CreatedAt:      entity.CreatedAt,
+	}
+}
+
+func ConvertInvalidTxModelToEntity(m *models.InvalidTx) *domain.InvalidTx {
+	return &domain.InvalidTx{
+		TxHash:         m.TxHash,
+		Category:       m.Category,
+		Reason:         m.Reason,
+		EffectiveBlock: m.EffectiveBlock,
+		CreatedAt:      m.CreatedAt,
+	}
+}
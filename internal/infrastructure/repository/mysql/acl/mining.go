@@ -0,0 +1,53 @@
+package acl
+
+import (
+	"encoding/json"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/mining"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertMiningStatsEntityToModel(stats *mining.BlockStats) *models.MiningBlockStats {
+	rejections, _ := json.Marshal(stats.Rejections)
+
+	return &models.MiningBlockStats{
+		ID:            stats.ID,
+		Tick:          stats.Tick,
+		BlockNumber:   stats.BlockNumber,
+		Miners:        stats.Miners,
+		PoWMiners:     stats.PoWMiners,
+		PoSMiners:     stats.PoSMiners,
+		DualMiners:    stats.DualMiners,
+		PoWTotalShare: stats.PoWTotalShare,
+		PoSTotalShare: stats.PoSTotalShare,
+		PoWOutput:     stats.PoWOutput,
+		PoSOutput:     stats.PoSOutput,
+		BurnAmount:    stats.BurnAmount,
+		Rejected:      stats.Rejected,
+		Rejections:    rejections,
+		CreatedAt:     stats.CreatedAt,
+	}
+}
+
+func ConvertMiningStatsModelToEntity(m *models.MiningBlockStats) *mining.BlockStats {
+	var rejections []*mining.Rejection
+	_ = json.Unmarshal(m.Rejections, &rejections)
+
+	return &mining.BlockStats{
+		ID:            m.ID,
+		Tick:          m.Tick,
+		BlockNumber:   m.BlockNumber,
+		Miners:        m.Miners,
+		PoWMiners:     m.PoWMiners,
+		PoSMiners:     m.PoSMiners,
+		DualMiners:    m.DualMiners,
+		PoWTotalShare: m.PoWTotalShare,
+		PoSTotalShare: m.PoSTotalShare,
+		PoWOutput:     m.PoWOutput,
+		PoSOutput:     m.PoSOutput,
+		BurnAmount:    m.BurnAmount,
+		Rejected:      m.Rejected,
+		Rejections:    rejections,
+		CreatedAt:     m.CreatedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package acl
+
+import (
+	"github.com/kevin88886/eth_indexer/internal/domain/order"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertOrderEntityToModel(entity *order.Order) *models.MarketOrder {
+	return &models.MarketOrder{
+		ID:               entity.ID,
+		OrderID:          entity.OrderID,
+		Sign:             entity.Sign,
+		SignNonce:        entity.SignNonce,
+		Tick:             entity.Tick,
+		Seller:           entity.Seller,
+		Buyer:            entity.Buyer,
+		Amount:           entity.Amount,
+		Value:            entity.Value,
+		Price:            entity.Price,
+		FilledAmount:     entity.FilledAmount,
+		FilledValue:      entity.FilledValue,
+		Status:           string(entity.Status),
+		ListTxHash:       entity.ListTxHash,
+		ListBlock:        entity.ListBlock,
+		CloseTxHash:      entity.CloseTxHash,
+		CloseBlock:       entity.CloseBlock,
+		LastUpdatedBlock: entity.LastUpdatedBlock,
+		CreatedAt:        entity.CreatedAt,
+		UpdatedAt:        entity.UpdatedAt,
+	}
+}
+
+func ConvertOrderModelToEntity(m *models.MarketOrder) *order.Order {
+	return &order.Order{
+		ID:               m.ID,
+		OrderID:          m.OrderID,
+		Sign:             m.Sign,
+		SignNonce:        m.SignNonce,
+		Tick:             m.Tick,
+		Seller:           m.Seller,
+		Buyer:            m.Buyer,
+		Amount:           m.Amount,
+		Value:            m.Value,
+		Price:            m.Price,
+		FilledAmount:     m.FilledAmount,
+		FilledValue:      m.FilledValue,
+		Status:           order.Status(m.Status),
+		ListTxHash:       m.ListTxHash,
+		ListBlock:        m.ListBlock,
+		CloseTxHash:      m.CloseTxHash,
+		CloseBlock:       m.CloseBlock,
+		LastUpdatedBlock: m.LastUpdatedBlock,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
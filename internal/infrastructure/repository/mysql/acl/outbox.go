@@ -0,0 +1,41 @@
+package acl
+
+import (
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertOutboxMessageEntityToModel(entity *outbox.Message) *models.OutboxMessage {
+	var publishedAt *time.Time
+	if !entity.PublishedAt.IsZero() {
+		publishedAt = &entity.PublishedAt
+	}
+
+	return &models.OutboxMessage{
+		ID:          entity.ID,
+		Topic:       entity.Topic,
+		BlockNumber: entity.BlockNumber,
+		Payload:     entity.Payload,
+		Published:   publishedAt != nil,
+		PublishedAt: publishedAt,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func ConvertOutboxMessageModelToEntity(m *models.OutboxMessage) *outbox.Message {
+	var publishedAt time.Time
+	if m.PublishedAt != nil {
+		publishedAt = *m.PublishedAt
+	}
+
+	return &outbox.Message{
+		ID:          m.ID,
+		Topic:       m.Topic,
+		BlockNumber: m.BlockNumber,
+		Payload:     m.Payload,
+		CreatedAt:   m.CreatedAt,
+		PublishedAt: publishedAt,
+	}
+}
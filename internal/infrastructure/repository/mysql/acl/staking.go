@@ -86,3 +86,35 @@ func ConvertPositionEntityToBalanceModel(position *staking.StakingPosition) map[
 
 	return balancesMap
 }
+
+func ConvertRewardsRecordEntityToModel(record *staking.RewardsRecord) *models.StakingRewardsRecord {
+	return &models.StakingRewardsRecord{
+		ID:              record.ID,
+		Pool:            record.Pool,
+		PoolID:          record.PoolSubID,
+		Staker:          record.Staker,
+		Kind:            string(record.Kind),
+		Amount:          record.Amount,
+		AccRewards:      record.AccReward,
+		Debt:            record.Debt,
+		RewardsPerBlock: record.RewardsPerBlock,
+		BlockNumber:     record.BlockNumber,
+		CreatedAt:       record.CreatedAt,
+	}
+}
+
+func ConvertRewardsRecordModelToEntity(m *models.StakingRewardsRecord) *staking.RewardsRecord {
+	return &staking.RewardsRecord{
+		ID:              m.ID,
+		Pool:            m.Pool,
+		PoolSubID:       m.PoolID,
+		Staker:          m.Staker,
+		Kind:            staking.RewardsKind(m.Kind),
+		Amount:          m.Amount,
+		AccReward:       m.AccRewards,
+		Debt:            m.Debt,
+		RewardsPerBlock: m.RewardsPerBlock,
+		BlockNumber:     m.BlockNumber,
+		CreatedAt:       m.CreatedAt,
+	}
+}
@@ -21,6 +21,7 @@ func ConvertTickEntityToModel(entity domain.Tick) *models.IERCTick {
 			Tick:             ee.Tick,
 			Decimals:         ee.Decimals,
 			Creator:          ee.Creator,
+			DeployBlock:      ee.DeployBlock,
 			MaxSupply:        ee.MaxSupply,
 			Supply:           ee.Supply,
 			LastUpdatedBlock: ee.LastUpdatedAtBlock,
@@ -38,6 +39,7 @@ func ConvertTickEntityToModel(entity domain.Tick) *models.IERCTick {
 			Tick:             ee.Tick,
 			Decimals:         ee.Decimals,
 			Creator:          ee.Creator,
+			DeployBlock:      ee.DeployBlock,
 			MaxSupply:        ee.MaxSupply,
 			Supply:           ee.Supply(),
 			LastUpdatedBlock: ee.LastUpdatedBlock(),
@@ -61,6 +63,9 @@ func ConvertTickModelToEntity(m *models.IERCTick) (domain.Tick, error) {
 		}
 
 		entity.ID = m.ID
+		if entity.DeployBlock == 0 {
+			entity.DeployBlock = m.DeployBlock
+		}
 		return entity, nil
 
 	case protocol.ProtocolIERC20, protocol.ProtocolTERC20:
@@ -70,6 +75,9 @@ func ConvertTickModelToEntity(m *models.IERCTick) (domain.Tick, error) {
 		}
 
 		entity.ID = m.ID
+		if entity.DeployBlock == 0 {
+			entity.DeployBlock = m.DeployBlock
+		}
 		return entity, nil
 
 	default:
@@ -0,0 +1,74 @@
+package acl
+
+import (
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/trade"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertTradeEntityToModel(entity *trade.Trade) *models.MarketTrade {
+	return &models.MarketTrade{
+		ID:          entity.ID,
+		TradeID:     entity.TradeID,
+		OrderID:     entity.OrderID,
+		Tick:        entity.Tick,
+		Seller:      entity.Seller,
+		Buyer:       entity.Buyer,
+		Amount:      entity.Amount,
+		Value:       entity.Value,
+		Price:       entity.Price,
+		TxHash:      entity.TxHash,
+		BlockNumber: entity.BlockNumber,
+		TradedAt:    entity.TradedAt,
+	}
+}
+
+func ConvertTradeModelToEntity(m *models.MarketTrade) *trade.Trade {
+	return &trade.Trade{
+		ID:          m.ID,
+		TradeID:     m.TradeID,
+		OrderID:     m.OrderID,
+		Tick:        m.Tick,
+		Seller:      m.Seller,
+		Buyer:       m.Buyer,
+		Amount:      m.Amount,
+		Value:       m.Value,
+		Price:       m.Price,
+		TxHash:      m.TxHash,
+		BlockNumber: m.BlockNumber,
+		TradedAt:    m.TradedAt,
+	}
+}
+
+func ConvertCandleEntityToModel(entity *trade.Candle) *models.MarketCandle {
+	return &models.MarketCandle{
+		Tick:             entity.Tick,
+		Interval:         string(entity.Interval),
+		OpenTime:         entity.OpenTime.Unix(),
+		Open:             entity.Open,
+		High:             entity.High,
+		Low:              entity.Low,
+		Close:            entity.Close,
+		Volume:           entity.Volume,
+		QuoteVolume:      entity.QuoteVolume,
+		Trades:           entity.Trades,
+		LastUpdatedBlock: entity.LastUpdatedBlock,
+	}
+}
+
+func ConvertCandleModelToEntity(m *models.MarketCandle) *trade.Candle {
+	return &trade.Candle{
+		Tick:             m.Tick,
+		Interval:         trade.Interval(m.Interval),
+		OpenTime:         time.Unix(m.OpenTime, 0).UTC(),
+		Open:             m.Open,
+		High:             m.High,
+		Low:              m.Low,
+		Close:            m.Close,
+		Volume:           m.Volume,
+		QuoteVolume:      m.QuoteVolume,
+		Trades:           m.Trades,
+		LastUpdatedBlock: m.LastUpdatedBlock,
+	}
+}
@@ -0,0 +1,44 @@
+package acl
+
+import (
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+func ConvertVestingEntityToModel(entity *vesting.Vesting) *models.IERC20Vesting {
+	return &models.IERC20Vesting{
+		ID:               entity.ID,
+		VestID:           entity.VestID,
+		Tick:             entity.Tick,
+		From:             entity.From,
+		Recipient:        entity.Recipient,
+		Total:            entity.Total,
+		Released:         entity.Released,
+		StartBlock:       entity.StartBlock,
+		CliffBlock:       entity.CliffBlock,
+		EndBlock:         entity.EndBlock,
+		Decimals:         entity.Decimals,
+		LastUpdatedBlock: entity.LastUpdatedBlock,
+		CreatedAt:        entity.CreatedAt,
+		UpdatedAt:        entity.UpdatedAt,
+	}
+}
+
+func ConvertVestingModelToEntity(m *models.IERC20Vesting) *vesting.Vesting {
+	return &vesting.Vesting{
+		ID:               m.ID,
+		VestID:           m.VestID,
+		Tick:             m.Tick,
+		From:             m.From,
+		Recipient:        m.Recipient,
+		Total:            m.Total,
+		Released:         m.Released,
+		StartBlock:       m.StartBlock,
+		CliffBlock:       m.CliffBlock,
+		EndBlock:         m.EndBlock,
+		Decimals:         m.Decimals,
+		LastUpdatedBlock: m.LastUpdatedBlock,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
@@ -0,0 +1,99 @@
+package acl
+
+import (
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/kevin88886/eth_indexer/internal/domain/webhook"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+)
+
+// 回调地址的过滤条件, 以 json 保存
+type webhookFilter struct {
+	Ticks     []string `json:"ticks,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+	Operates  []string `json:"operates,omitempty"`
+}
+
+func ConvertWebhookEntityToModel(entity *webhook.Webhook) *models.Webhook {
+	filter, _ := jsoniter.Marshal(&webhookFilter{
+		Ticks:     entity.Ticks,
+		Addresses: entity.Addresses,
+		Operates:  entity.Operates,
+	})
+
+	return &models.Webhook{
+		ID:         entity.ID,
+		URL:        entity.URL,
+		Secret:     entity.Secret,
+		Filter:     filter,
+		StartBlock: entity.StartBlock,
+		Enabled:    entity.Enabled,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
+
+func ConvertWebhookModelToEntity(m *models.Webhook) *webhook.Webhook {
+	var filter webhookFilter
+	_ = jsoniter.Unmarshal(m.Filter, &filter)
+
+	return &webhook.Webhook{
+		ID:         m.ID,
+		URL:        m.URL,
+		Secret:     m.Secret,
+		Ticks:      filter.Ticks,
+		Addresses:  filter.Addresses,
+		Operates:   filter.Operates,
+		StartBlock: m.StartBlock,
+		Enabled:    m.Enabled,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func ConvertWebhookDeliveryEntityToModel(entity *webhook.Delivery) *models.WebhookDelivery {
+	m := &models.WebhookDelivery{
+		ID:            entity.ID,
+		WebhookID:     entity.WebhookID,
+		BlockNumber:   entity.BlockNumber,
+		Payload:       entity.Payload,
+		Status:        uint8(entity.Status),
+		Attempts:      entity.Attempts,
+		NextAttemptAt: entity.NextAttemptAt,
+		LastError:     entity.LastError,
+		CreatedAt:     entity.CreatedAt,
+	}
+
+	// 超出字段长度的错误信息截断
+	if len(m.LastError) > 512 {
+		m.LastError = m.LastError[:512]
+	}
+
+	if !entity.DeliveredAt.IsZero() {
+		deliveredAt := entity.DeliveredAt
+		m.DeliveredAt = &deliveredAt
+	}
+
+	return m
+}
+
+func ConvertWebhookDeliveryModelToEntity(m *models.WebhookDelivery) *webhook.Delivery {
+	var deliveredAt time.Time
+	if m.DeliveredAt != nil {
+		deliveredAt = *m.DeliveredAt
+	}
+
+	return &webhook.Delivery{
+		ID:            m.ID,
+		WebhookID:     m.WebhookID,
+		BlockNumber:   m.BlockNumber,
+		Payload:       m.Payload,
+		Status:        webhook.DeliveryStatus(m.Status),
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+		DeliveredAt:   deliveredAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/allowance"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type allowanceMySQLRepo struct {
+	db *gorm.DB
+}
+
+func NewAllowanceRepo(db *gorm.DB) allowance.AllowanceRepository {
+	return &allowanceMySQLRepo{db: db}
+}
+
+func (repo *allowanceMySQLRepo) Load(ctx context.Context, key allowance.AllowanceKey) (*allowance.Allowance, error) {
+	var m models.IERC20Allowance
+	err := repo.db.WithContext(ctx).
+		Where("owner = ? and spender = ? and tick = ?", key.Owner, key.Spender, key.Tick).
+		Take(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return acl.ConvertAllowanceModelToEntity(&m), nil
+}
+
+func (repo *allowanceMySQLRepo) Query(ctx context.Context, owner, spender, tick string) ([]*allowance.Allowance, error) {
+	db := repo.db.WithContext(ctx)
+	if owner != "" {
+		db = db.Where("owner = ?", owner)
+	}
+	if spender != "" {
+		db = db.Where("spender = ?", spender)
+	}
+	if tick != "" {
+		db = db.Where("tick = ?", tick)
+	}
+
+	var ms []*models.IERC20Allowance
+	if err := db.Order("id asc").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var entities = make([]*allowance.Allowance, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertAllowanceModelToEntity(m))
+	}
+
+	return entities, nil
+}
+
+func (repo *allowanceMySQLRepo) Save(ctx context.Context, entities ...*allowance.Allowance) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms []*models.IERC20Allowance
+	for _, entity := range entities {
+		ms = append(ms, acl.ConvertAllowanceEntityToModel(entity))
+	}
+
+	// 以 owner、spender、tick 作为唯一键更新
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: `owner`}, {Name: `spender`}, {Name: `tick`}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			`amount`,
+			`sign_nonce`,
+			`last_updated_block`,
+			`updated_at`,
+		}),
+	}).CreateInBatches(ms, 1000).Error
+}
@@ -8,6 +8,7 @@ import (
 	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -24,7 +25,7 @@ func (repo *balanceMySQLRepo) Load(ctx context.Context, key balance.BalanceKey)
 
 	// 指定查询地址
 	var m models.IERC20Balance
-	err := repo.db.WithContext(ctx).Where("address = ? and tick = ?", key.Address, key.Tick).Take(&m).Error
+	err := repo.db.WithContext(ctx).Where("address = ? and tick = ?", key.Address.String(), key.Tick).Take(&m).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -36,6 +37,90 @@ func (repo *balanceMySQLRepo) Load(ctx context.Context, key balance.BalanceKey)
 	return acl.ConvertBalanceModelToEntity(&m), nil
 }
 
+func (repo *balanceMySQLRepo) Get(ctx context.Context, key balance.BalanceKey) (*balance.Snapshot, error) {
+	return repo.snapshot(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("address = ? and tick = ?", key.Address.String(), key.Tick)
+	})
+}
+
+func (repo *balanceMySQLRepo) GetMany(ctx context.Context, keys ...balance.BalanceKey) (*balance.Snapshot, error) {
+	var pairs = make([][]interface{}, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, []interface{}{key.Address.String(), key.Tick})
+	}
+
+	return repo.snapshot(ctx, func(tx *gorm.DB) *gorm.DB {
+		if len(pairs) == 0 {
+			return tx.Where("1 = 0")
+		}
+
+		return tx.Where("(address, tick) in ?", pairs)
+	})
+}
+
+func (repo *balanceMySQLRepo) QueryByAddress(ctx context.Context, query *balance.BalanceQuery) (*balance.Snapshot, error) {
+	return repo.snapshot(ctx, func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("address = ?", query.Address)
+		if query.Cursor != "" {
+			tx = tx.Where("tick > ?", query.Cursor)
+		}
+		if !query.IncludeZero {
+			tx = tx.Where("total > 0")
+		}
+
+		return tx.Order("tick ASC").Limit(query.Limit)
+	})
+}
+
+func (repo *balanceMySQLRepo) QueryByTick(ctx context.Context, query *balance.BalanceQuery) (*balance.Snapshot, error) {
+	var (
+		total decimal.Decimal
+		last  string
+	)
+	if query.Cursor != "" {
+		var err error
+		total, last, err = balance.ParseHolderCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return repo.snapshot(ctx, func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where("tick = ? and total > 0", query.Tick)
+		if query.Cursor != "" {
+			// 以 decimal 比较, 避免游标中的数量被当作字符串或浮点数比较
+			tx = tx.Where(
+				"(total < CAST(? AS DECIMAL(65,18)) or (total = CAST(? AS DECIMAL(65,18)) and address > ?))",
+				total.String(), total.String(), last,
+			)
+		}
+
+		return tx.Order("total DESC, address ASC").Limit(query.Limit)
+	})
+}
+
+// 在同一个只读事务(可重复读)中查询余额和索引高度, 保证两者一致
+func (repo *balanceMySQLRepo) snapshot(ctx context.Context, scope func(tx *gorm.DB) *gorm.DB) (*balance.Snapshot, error) {
+	var ms []*models.IERC20Balance
+
+	blockNumber, err := snapshotTx(ctx, repo.db, func(tx *gorm.DB) error {
+		return scope(tx.Model(&models.IERC20Balance{})).Find(&ms).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result = &balance.Snapshot{
+		BlockNumber: blockNumber,
+		Balances:    make([]*balance.Balance, 0, len(ms)),
+	}
+	for _, m := range ms {
+		result.Balances = append(result.Balances, acl.ConvertBalanceModelToEntity(m))
+	}
+
+	return result, nil
+}
+
 func (repo *balanceMySQLRepo) Save(ctx context.Context, entities ...*balance.Balance) error {
 	if len(entities) == 0 {
 		return nil
@@ -57,6 +142,7 @@ func (repo *balanceMySQLRepo) Save(ctx context.Context, entities ...*balance.Bal
 		DoUpdates: clause.AssignmentColumns([]string{
 			`available`,
 			`freeze`,
+			`total`,
 			`minted`,
 			`last_updated_block`,
 			`updated_at`,
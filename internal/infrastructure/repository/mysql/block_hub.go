@@ -0,0 +1,201 @@
+package mysqlimpl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+)
+
+// 从数据库加载 startBlock 之后的区块数据. 最后一个区块的数据必须完整
+type blockLoader[B any] func(ctx context.Context, startBlock uint64, limit int) ([]B, error)
+
+// 订阅者的订阅位置, 只在订阅者自己的协程中读写
+type blockCursor[B any, N any] interface {
+	// 当前订阅位置, 从数据库回放时从这个区块之后开始加载
+	Cursor() uint64
+	// 推进订阅位置, 返回需要推送的数据. 已经推送过的区块返回空
+	Deliver(block B, caughtUp bool) []*N
+	// 回放完成并且追平时推送的数据, 返回 nil 表示不推送
+	CaughtUp() *N
+}
+
+// 按区块发布数据的订阅中心. 保证每个订阅者按区块顺序、无缺失地收到数据:
+//  1. 订阅者先注册, 之后发布的区块都会进入订阅者的缓冲区
+//  2. 从数据库回放订阅位置之后的数据
+//  3. 回放完成后消费缓冲区, 跳过已经回放过的区块
+//
+// 缓冲区满时丢弃缓冲区并标记为落后, 订阅者重新从数据库回放. 发布方永远不会被订阅者阻塞,
+// 订阅者的推送速度由消费方决定.
+// 区块必须在事务提交之后按顺序发布, 否则回放时可能读不到已经发布的区块
+type blockHub[B any, N any] struct {
+	load       blockLoader[B]
+	bufferSize int
+
+	mutex       sync.Mutex
+	subscribers map[string]*blockSubscriber[B, N]
+}
+
+type blockSubscriber[B any, N any] struct {
+	cursor blockCursor[B, N]
+	notify chan struct{}
+
+	// 以下字段由 blockHub.mutex 保护
+	buffer []B
+	lagged bool
+}
+
+func newBlockHub[B any, N any](load blockLoader[B], bufferSize int) *blockHub[B, N] {
+	return &blockHub[B, N]{
+		load:        load,
+		bufferSize:  bufferSize,
+		subscribers: make(map[string]*blockSubscriber[B, N]),
+	}
+}
+
+// 从 cursor 的位置开始订阅. ctx 结束时关闭 stream
+func (h *blockHub[B, N]) subscribe(ctx context.Context, cursor blockCursor[B, N]) *domain.Stream[N] {
+	var (
+		stream     = domain.NewEventStream[N](100)
+		subscriber = &blockSubscriber[B, N]{
+			cursor: cursor,
+			notify: make(chan struct{}, 1),
+			lagged: true, // 新的订阅者需要先从数据库回放
+		}
+	)
+
+	h.mutex.Lock()
+	h.subscribers[stream.ID()] = subscriber
+	h.mutex.Unlock()
+
+	go func() {
+		defer func() {
+			h.mutex.Lock()
+			delete(h.subscribers, stream.ID())
+			h.mutex.Unlock()
+		}()
+
+		if err := h.serve(ctx, stream, subscriber); err != nil && ctx.Err() == nil {
+			stream.SendErr(err)
+			return
+		}
+
+		stream.Close()
+	}()
+
+	return stream
+}
+
+// 发布一个已经提交的区块
+func (h *blockHub[B, N]) publish(block B) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, subscriber := range h.subscribers {
+		if subscriber.lagged {
+			continue
+		}
+
+		if len(subscriber.buffer) >= h.bufferSize {
+			subscriber.lagged = true
+			subscriber.buffer = nil
+		} else {
+			subscriber.buffer = append(subscriber.buffer, block)
+		}
+
+		select {
+		case subscriber.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// 取出缓冲区中的区块. 落后时清空缓冲区并返回 lagged = true, 调用方需要从数据库回放
+func (h *blockHub[B, N]) take(subscriber *blockSubscriber[B, N]) (blocks []B, lagged bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if subscriber.lagged {
+		subscriber.lagged = false
+		subscriber.buffer = nil
+		return nil, true
+	}
+
+	blocks, subscriber.buffer = subscriber.buffer, nil
+	return blocks, false
+}
+
+func (h *blockHub[B, N]) serve(ctx context.Context, stream *domain.Stream[N], subscriber *blockSubscriber[B, N]) error {
+	var caughtUp bool
+	for {
+		blocks, lagged := h.take(subscriber)
+		if lagged {
+			caughtUp = false
+			if err := h.replay(ctx, stream, subscriber); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, block := range blocks {
+			if err := subscriber.deliver(ctx, stream, block, caughtUp); err != nil {
+				return err
+			}
+		}
+
+		// 回放完成并且缓冲区已经消费完, 通知客户端已经追平
+		if !caughtUp {
+			caughtUp = true
+			if data := subscriber.cursor.CaughtUp(); data != nil {
+				if err := subscriber.send(ctx, stream, data); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-subscriber.notify:
+		}
+	}
+}
+
+// 从数据库回放订阅位置之后的所有数据
+func (h *blockHub[B, N]) replay(ctx context.Context, stream *domain.Stream[N], subscriber *blockSubscriber[B, N]) error {
+	for {
+		blocks, err := h.load(ctx, subscriber.cursor.Cursor(), eventReplayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(blocks) == 0 {
+			return nil
+		}
+
+		for _, block := range blocks {
+			if err := subscriber.deliver(ctx, stream, block, false); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *blockSubscriber[B, N]) deliver(ctx context.Context, stream *domain.Stream[N], block B, caughtUp bool) error {
+	for _, data := range s.cursor.Deliver(block, caughtUp) {
+		if err := s.send(ctx, stream, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *blockSubscriber[B, N]) send(ctx context.Context, stream *domain.Stream[N], data *N) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case stream.Input() <- data:
+		return nil
+	}
+}
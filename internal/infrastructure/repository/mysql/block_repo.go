@@ -2,6 +2,7 @@ package mysqlimpl
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
 	"github.com/kevin88886/eth_indexer/internal/domain"
@@ -51,9 +52,26 @@ func (repo *blockMySQLRepo) GetLastIndexedBlock(ctx context.Context) (*domain.Bl
 
 func (repo *blockMySQLRepo) GetLastHandleBlock(ctx context.Context) (*domain.BlockHeader, error) {
 
+	block, err := lastHandleBlock(repo.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if block == nil {
+		return nil, nil
+	}
+
+	return &domain.BlockHeader{
+		Number:     block.Number,
+		Hash:       block.Hash,
+		ParentHash: block.ParentHash,
+	}, nil
+}
+
+// 最后一个处理完成的区块, 没有时返回 nil
+func lastHandleBlock(tx *gorm.DB) (*models.Block, error) {
 	var block models.Block
-	err := repo.db.WithContext(ctx).
-		Table(block.TableName()).
+	err := tx.Table(block.TableName()).
 		Where("tx_count > 0 and is_processed = 1").
 		Order("block_number DESC").
 		Take(&block).Error
@@ -66,11 +84,34 @@ func (repo *blockMySQLRepo) GetLastHandleBlock(ctx context.Context) (*domain.Blo
 		return nil, err
 	}
 
-	return &domain.BlockHeader{
-		Number:     block.Number,
-		Hash:       block.Hash,
-		ParentHash: block.ParentHash,
-	}, nil
+	return &block, nil
+}
+
+// 在同一个只读事务(可重复读)中执行查询并返回索引高度, 保证查询结果与索引高度一致
+func snapshotTx(ctx context.Context, db *gorm.DB, query func(tx *gorm.DB) error) (uint64, error) {
+	var blockNumber uint64
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := query(tx); err != nil {
+			return err
+		}
+
+		block, err := lastHandleBlock(tx)
+		if err != nil {
+			return err
+		}
+
+		if block != nil {
+			blockNumber = block.Number
+		}
+
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return 0, err
+	}
+
+	return blockNumber, nil
 }
 
 func (repo *blockMySQLRepo) QueryLastProcessedBlock(ctx context.Context, blockNumber uint64) (*domain.BlockHeader, error) {
@@ -212,7 +253,7 @@ func (repo *blockMySQLRepo) QueryTransactionByHash(ctx context.Context, hash str
 	err := repo.db.WithContext(ctx).Table(m.TableName()).Where("hash = ?", hash).Take(&m).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("not found")
+			return nil, nil
 		}
 
 		return nil, err
@@ -220,30 +261,38 @@ func (repo *blockMySQLRepo) QueryTransactionByHash(ctx context.Context, hash str
 
 	transaction := acl.ConvertTransactionModelToEntity(&m)
 
-	// 加载的时候同时解析IERC20交易, 加快处理流程
-	tx, err := repo.parser.Parse(transaction)
+	// 只用于查询, 处理状态以数据库为准. 解析失败时 IERCTransaction 为空
+	if tx, err := repo.parser.Parse(transaction); err == nil {
+		transaction.IERCTransaction = tx
+	}
+
+	return transaction, nil
+}
+
+func (repo *blockMySQLRepo) QueryTransactionsByHashes(ctx context.Context, hashes ...string) ([]*domain.Transaction, error) {
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	var ms []*models.Transaction
+	err := repo.db.WithContext(ctx).Table((&models.Transaction{}).TableName()).Where("hash in ?", hashes).Find(&ms).Error
 	if err != nil {
-		var (
-			code    int32
-			message string
-		)
-		var pErr *protocol.ProtocolError
-		if errors.As(err, &pErr) {
-			code = pErr.Code()
-			message = pErr.Message()
-		} else {
-			code = int32(protocol.UnknownError)
-			message = err.Error()
+		return nil, err
+	}
+
+	var transactions = make([]*domain.Transaction, 0, len(ms))
+	for _, m := range ms {
+		transaction := acl.ConvertTransactionModelToEntity(m)
+
+		// 与 QueryTransactionByHash 一致, 解析失败时 IERCTransaction 为空
+		if tx, err := repo.parser.Parse(transaction); err == nil {
+			transaction.IERCTransaction = tx
 		}
 
-		transaction.IsProcessed = true
-		transaction.Code = code
-		transaction.Remark = message
-	} else {
-		transaction.IERCTransaction = tx
+		transactions = append(transactions, transaction)
 	}
 
-	return transaction, nil
+	return transactions, nil
 }
 
 func (repo *blockMySQLRepo) BulkSaveBlock(ctx context.Context, blocks []*domain.Block) error {
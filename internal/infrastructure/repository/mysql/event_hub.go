@@ -0,0 +1,63 @@
+package mysqlimpl
+
+import (
+	"context"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+)
+
+const (
+	// 订阅者缓冲的区块数量. 超过后丢弃缓冲区, 订阅者改为从数据库回放
+	eventSubscriberBufferSize = 256
+	// 从数据库回放时每次加载的区块数据数量
+	eventReplayBatchSize = 100
+)
+
+// 从数据库加载 startBlock 之后的事件, 按区块归集. 最后一个区块的事件必须完整
+type eventLoader = blockLoader[*domain.EventsByBlock]
+
+type eventSubscriber = blockSubscriber[*domain.EventsByBlock, domain.EventNotification]
+
+// 事件订阅中心. 没有事件的区块也需要发布, 用于推进订阅位置
+type eventHub struct {
+	*blockHub[*domain.EventsByBlock, domain.EventNotification]
+}
+
+func newEventHub(load eventLoader, bufferSize int) *eventHub {
+	return &eventHub{blockHub: newBlockHub[*domain.EventsByBlock, domain.EventNotification](load, bufferSize)}
+}
+
+// 订阅 position 之后的事件. ctx 结束时关闭 stream
+func (h *eventHub) subscribe(ctx context.Context, position domain.EventStreamPosition, filter *domain.EventFilter) *domain.Stream[domain.EventNotification] {
+	return h.blockHub.subscribe(ctx, &eventCursor{filter: filter, position: position})
+}
+
+// 事件订阅位置
+type eventCursor struct {
+	filter   *domain.EventFilter
+	position domain.EventStreamPosition
+}
+
+func (c *eventCursor) Cursor() uint64 {
+	return c.position.Cursor
+}
+
+// 推送一个区块中命中过滤条件的事件, 并推进订阅位置. 已经推送过的区块直接跳过
+func (c *eventCursor) Deliver(block *domain.EventsByBlock, caughtUp bool) []*domain.EventNotification {
+	if block.BlockNumber <= c.position.Cursor {
+		return nil
+	}
+
+	filtered := c.filter.Apply(block, c.position.LastBlock)
+	c.position.Cursor = block.BlockNumber
+	if filtered == nil || len(filtered.Events) == 0 {
+		return nil
+	}
+
+	c.position.LastBlock = block.BlockNumber
+	return []*domain.EventNotification{{Block: filtered, Position: c.position, CaughtUp: caughtUp}}
+}
+
+func (c *eventCursor) CaughtUp() *domain.EventNotification {
+	return &domain.EventNotification{Position: c.position, CaughtUp: true}
+}
@@ -0,0 +1,214 @@
+package mysqlimpl
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/mining"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEventHub(t *testing.T) {
+	suite.Run(t, new(TestEventHubSuite))
+}
+
+type TestEventHubSuite struct {
+	suite.Suite
+
+	mutex  sync.Mutex
+	blocks []*domain.EventsByBlock // 模拟已经提交到数据库的区块
+}
+
+func (s *TestEventHubSuite) SetupTest() {
+	s.mutex.Lock()
+	s.blocks = nil
+	s.mutex.Unlock()
+}
+
+func (s *TestEventHubSuite) load(_ context.Context, startBlock uint64, limit int) ([]*domain.EventsByBlock, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var result []*domain.EventsByBlock
+	for _, block := range s.blocks {
+		if block.BlockNumber > startBlock && len(result) < limit {
+			result = append(result, block)
+		}
+	}
+	return result, nil
+}
+
+// 提交区块. 与 BlockService 一致, 先落库再发布
+func (s *TestEventHubSuite) commit(hub *eventHub, numbers ...uint64) {
+	for _, number := range numbers {
+		block := &domain.EventsByBlock{
+			BlockNumber: number,
+			Events:      []domain.Event{&domain.IERC20TransferredEvent{BlockNumber: number}},
+		}
+
+		s.mutex.Lock()
+		s.blocks = append(s.blocks, block)
+		s.mutex.Unlock()
+
+		hub.publish(block)
+	}
+}
+
+// 读取通知直到追平, 返回收到的区块号
+func (s *TestEventHubSuite) receiveUntilCaughtUp(stream *domain.Stream[domain.EventNotification]) ([]uint64, *domain.EventNotification) {
+	var numbers []uint64
+	for {
+		select {
+		case data := <-stream.Next():
+			if data.Block == nil {
+				s.True(data.CaughtUp)
+				return numbers, data
+			}
+			numbers = append(numbers, data.Block.BlockNumber)
+		case <-time.After(time.Second):
+			s.FailNow("timeout")
+		}
+	}
+}
+
+func (s *TestEventHubSuite) TestReplayThenLive() {
+	hub := newEventHub(s.load, eventSubscriberBufferSize)
+	s.commit(hub, 1, 2, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := hub.subscribe(ctx, domain.EventStreamPosition{Cursor: 1}, nil)
+	numbers, marker := s.receiveUntilCaughtUp(stream)
+	s.Equal([]uint64{2, 3}, numbers)
+	s.Equal(uint64(3), marker.Position.Cursor)
+
+	s.commit(hub, 4, 5)
+	for _, expected := range []uint64{4, 5} {
+		data := <-stream.Next()
+		s.True(data.CaughtUp)
+		s.Equal(expected, data.Block.BlockNumber)
+	}
+}
+
+func (s *TestEventHubSuite) TestLaggedSubscriber() {
+	hub := newEventHub(s.load, 2)
+	subscriber := &eventSubscriber{notify: make(chan struct{}, 1)}
+	hub.subscribers["lagged"] = subscriber
+
+	s.commit(hub, 1, 2)
+	blocks, lagged := hub.take(subscriber)
+	s.False(lagged)
+	s.Len(blocks, 2)
+
+	// 缓冲区溢出后丢弃缓冲区, 之后发布的区块也不再缓冲, 直到订阅者从数据库回放
+	s.commit(hub, 3, 4, 5, 6)
+	blocks, lagged = hub.take(subscriber)
+	s.True(lagged)
+	s.Empty(blocks)
+}
+
+func (s *TestEventHubSuite) TestNoGapWhenSlow() {
+	hub := newEventHub(s.load, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := hub.subscribe(ctx, domain.EventStreamPosition{}, nil)
+	_, _ = s.receiveUntilCaughtUp(stream)
+
+	var expected []uint64
+	for number := uint64(1); number <= 50; number++ {
+		s.commit(hub, number)
+		expected = append(expected, number)
+	}
+
+	var numbers []uint64
+	for len(numbers) < len(expected) {
+		select {
+		case data := <-stream.Next():
+			if data.Block != nil {
+				numbers = append(numbers, data.Block.BlockNumber)
+			}
+		case <-time.After(time.Second):
+			s.FailNow("timeout")
+		}
+	}
+
+	s.Equal(expected, numbers)
+}
+
+func (s *TestEventHubSuite) TestResume() {
+	hub := newEventHub(s.load, eventSubscriberBufferSize)
+	s.commit(hub, 1, 2, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := hub.subscribe(ctx, domain.EventStreamPosition{}, nil)
+	first := <-stream.Next()
+	cancel()
+
+	position, err := domain.ParseResumeToken(first.Position.Token())
+	s.Require().NoError(err)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	numbers, _ := s.receiveUntilCaughtUp(hub.subscribe(ctx, position, nil))
+	s.Equal([]uint64{2, 3}, numbers)
+}
+
+func (s *TestEventHubSuite) TestMiningStats() {
+	var (
+		mutex  sync.Mutex
+		blocks []*miningStatsBlock
+	)
+	load := func(_ context.Context, startBlock uint64, limit int) ([]*miningStatsBlock, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		var result []*miningStatsBlock
+		for _, block := range blocks {
+			if block.BlockNumber > startBlock && len(result) < limit {
+				result = append(result, block)
+			}
+		}
+		return result, nil
+	}
+
+	hub := newBlockHub[*miningStatsBlock, mining.BlockStats](load, eventSubscriberBufferSize)
+	commit := func(number uint64) {
+		block := &miningStatsBlock{BlockNumber: number, Stats: []*mining.BlockStats{
+			{Tick: "ierc-m", BlockNumber: number},
+			{Tick: "ierc-pow", BlockNumber: number},
+		}}
+
+		mutex.Lock()
+		blocks = append(blocks, block)
+		mutex.Unlock()
+
+		hub.publish(block)
+	}
+
+	commit(1)
+	commit(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 先回放 startBlock 之后的数据, 再推送新提交的区块. 只推送订阅的 tick
+	stream := hub.subscribe(ctx, &miningStatsCursor{tick: "ierc-m", cursor: 1})
+	commit(3)
+
+	for _, expected := range []uint64{2, 3} {
+		select {
+		case data := <-stream.Next():
+			s.Equal("ierc-m", data.Tick)
+			s.Equal(expected, data.BlockNumber)
+		case <-time.After(time.Second):
+			s.FailNow("timeout")
+		}
+	}
+}
@@ -3,7 +3,9 @@ package mysqlimpl
 import (
 	"context"
 	"errors"
-	"sync"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/kevin88886/eth_indexer/internal/domain"
 	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
@@ -13,18 +15,14 @@ import (
 )
 
 type eventRepo struct {
-	db *gorm.DB
-
-	subscriber map[string]*domain.Stream[domain.EventsByBlock]
-	rw         sync.Mutex
+	db  *gorm.DB
+	hub *eventHub
 }
 
 func NewEventRepository(db *gorm.DB) domain.EventRepository {
-	return &eventRepo{
-		db:         db,
-		subscriber: make(map[string]*domain.Stream[domain.EventsByBlock]),
-		rw:         sync.Mutex{},
-	}
+	repo := &eventRepo{db: db}
+	repo.hub = newEventHub(repo.LoadEventsByBlocks, eventSubscriberBufferSize)
+	return repo
 }
 
 func (repo *eventRepo) GetBlockNumberByLastEvent(ctx context.Context) (uint64, error) {
@@ -86,51 +84,8 @@ func (repo *eventRepo) queryEventBySignature(ctx context.Context, signs string)
 	return acl.ConvertModelToEvent(&m), nil
 }
 
-func (repo *eventRepo) SubscribeEvent(ctx context.Context, startBlock uint64) (*domain.Stream[domain.EventsByBlock], error) {
-
-	stream := domain.NewEventStream[domain.EventsByBlock](100)
-
-	go func() {
-
-		startBlock := startBlock
-		for {
-			blocks, err := repo.LoadEventsByBlocks(ctx, startBlock, 100) // 加载一千事件, 返回可能超过1000条, 因为是按区块加载的事件
-			if err != nil {
-				stream.SendErr(err)
-				return
-			}
-
-			if len(blocks) == 0 {
-				break
-			}
-
-			// 按区块归集事件
-			for _, m := range blocks {
-				stream.Send(m)
-				startBlock = m.BlockNumber
-			}
-
-			// 查询出来的最后一个块高度等于查询高度, 说明已经没有更新的区块了
-			if blocks[len(blocks)-1].BlockNumber == startBlock {
-				break
-			}
-		}
-
-		// 添加到订阅channel中
-		repo.rw.Lock()
-		repo.subscriber[stream.ID()] = stream
-		repo.rw.Unlock()
-
-		select {
-		case <-ctx.Done():
-			repo.rw.Lock()
-			delete(repo.subscriber, stream.ID())
-			repo.rw.Unlock()
-			stream.Close()
-		}
-	}()
-
-	return stream, nil
+func (repo *eventRepo) SubscribeEvent(ctx context.Context, position domain.EventStreamPosition, filter *domain.EventFilter) (*domain.Stream[domain.EventNotification], error) {
+	return repo.hub.subscribe(ctx, position, filter), nil
 }
 
 func (repo *eventRepo) LoadEventsByBlocks(ctx context.Context, startBlock uint64, limit int) ([]*domain.EventsByBlock, error) {
@@ -259,46 +214,261 @@ func (repo *eventRepo) QueryEventsByHash(ctx context.Context, hash string) ([]do
 	return events, nil
 }
 
-func (repo *eventRepo) Save(ctx context.Context, event *domain.EventsByBlock) error {
+func (repo *eventRepo) QueryEventsByHashes(ctx context.Context, hashes ...string) (map[string][]domain.Event, error) {
+	if len(hashes) == 0 {
+		return map[string][]domain.Event{}, nil
+	}
 
-	if len(event.Events) == 0 {
-		return nil
+	var ms []*models.Event
+	err := repo.db.WithContext(ctx).
+		Table((&models.Event{}).TableName()).
+		Where("`tx_hash` in ?", hashes).
+		Order("`id` ASC").
+		Find(&ms).
+		Error
+	if err != nil {
+		return nil, err
 	}
 
-	dbWithTx := rctx.TransactionDBFromContext(ctx)
-	if dbWithTx == nil {
-		panic("missing db instance")
+	var result = make(map[string][]domain.Event, len(hashes))
+	for _, m := range ms {
+		result[m.TxHash] = append(result[m.TxHash], acl.ConvertModelToEvent(m))
+	}
+
+	return result, nil
+}
+
+func (repo *eventRepo) QueryEvents(ctx context.Context, query *domain.EventQuery) (*domain.EventPage, error) {
+
+	db, err := repo.eventQueryScope(repo.db.WithContext(ctx), query)
+	if err != nil {
+		return nil, err
 	}
 
 	var ms []*models.Event
-	for _, entity := range event.Events {
-		ms = append(ms, acl.ConvertEventToModel(entity))
+	if err := db.Find(&ms).Error; err != nil {
+		return nil, err
 	}
 
-	// 记录事件
-	if err := dbWithTx.CreateInBatches(ms, 1000).Error; err != nil {
-		return err
+	return newEventPage(query, ms), nil
+}
+
+// 批量查询时的结果行, batch_idx 为所属查询的下标
+type batchEvent struct {
+	models.Event
+	BatchIdx int `gorm:"column:batch_idx"`
+}
+
+func (repo *eventRepo) QueryEventsBatch(ctx context.Context, queries []*domain.EventQuery) ([]*domain.EventPage, error) {
+
+	switch len(queries) {
+	case 0:
+		return nil, nil
+	case 1:
+		page, err := repo.QueryEvents(ctx, queries[0])
+		if err != nil {
+			return nil, err
+		}
+		return []*domain.EventPage{page}, nil
+	}
+
+	// 每个查询作为一个子查询, 以 UNION ALL 合并为一次查询
+	var (
+		table = (&models.Event{}).TableName()
+		subs  = make([]string, 0, len(queries))
+		args  = make([]interface{}, 0, len(queries))
+	)
+	for idx, query := range queries {
+		db := repo.db.WithContext(ctx).Select(fmt.Sprintf("`%s`.*, ? AS `batch_idx`", table), idx)
+		db, err := repo.eventQueryScope(db, query)
+		if err != nil {
+			return nil, err
+		}
+
+		subs = append(subs, "(?)")
+		args = append(args, db)
+	}
+
+	var rows []*batchEvent
+	if err := repo.db.WithContext(ctx).Raw(strings.Join(subs, " UNION ALL "), args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	var groups = make([][]*models.Event, len(queries))
+	for _, row := range rows {
+		groups[row.BatchIdx] = append(groups[row.BatchIdx], &row.Event)
+	}
+
+	var pages = make([]*domain.EventPage, 0, len(queries))
+	for idx, query := range queries {
+		// UNION ALL 不保证子查询的顺序, 按扫描方向重新排序
+		ms, desc := groups[idx], eventScanDesc(query)
+		sort.Slice(ms, func(i, j int) bool {
+			if ms[i].BlockNumber != ms[j].BlockNumber {
+				return (ms[i].BlockNumber < ms[j].BlockNumber) != desc
+			}
+			return (ms[i].ID < ms[j].ID) != desc
+		})
+
+		pages = append(pages, newEventPage(query, ms))
 	}
 
-	return repo.publishEvents(ctx, event)
+	return pages, nil
 }
 
-// 推送消息
-// TODO: z 先这么实现, 后续再优化
-func (repo *eventRepo) publishEvents(ctx context.Context, event *domain.EventsByBlock) error {
-	if len(repo.subscriber) == 0 {
-		return nil
+// 向前翻页时, 实际的扫描方向与返回顺序相反, 查询后再反转结果
+func eventScanDesc(query *domain.EventQuery) bool {
+	return query.Desc != (query.Before != "")
+}
+
+// 按查询条件设置过滤条件、游标、排序和数量. 多查一条, 用于判断是否还有更多数据
+func (repo *eventRepo) eventQueryScope(db *gorm.DB, query *domain.EventQuery) (*gorm.DB, error) {
+
+	db = db.Table((&models.Event{}).TableName())
+
+	if query.Address != "" {
+		db = db.Where("(`ierc_from` = ? or `ierc_to` = ? or `eth_from` = ?)", query.Address, query.Address, query.Address)
+	}
+	if query.IERCFrom != "" {
+		db = db.Where("`ierc_from` = ?", query.IERCFrom)
+	}
+	if query.IERCTo != "" {
+		db = db.Where("`ierc_to` = ?", query.IERCTo)
+	}
+	if query.ETHFrom != "" {
+		db = db.Where("`eth_from` = ?", query.ETHFrom)
+	}
+	if query.Tick != "" {
+		db = db.Where("`tick` = ?", query.Tick)
+	}
+	if query.Operate != "" {
+		db = db.Where("`operate` = ?", query.Operate)
+	}
+	if len(query.EventKinds) != 0 {
+		db = db.Where("`event_kind` in ?", query.EventKinds)
+	}
+	if query.TxHash != "" {
+		db = db.Where("`tx_hash` = ?", query.TxHash)
+	}
+
+	switch query.Status {
+	case domain.EventStatusSuccess:
+		db = db.Where("`err_code` = 0")
+	case domain.EventStatusFailed:
+		db = db.Where("`err_code` <> 0")
+	}
+
+	rawCursor := query.After
+	if query.Before != "" {
+		rawCursor = query.Before
+	}
+	scanDesc := eventScanDesc(query)
+
+	if rawCursor != "" {
+		cursor, err := domain.ParseEventCursor(rawCursor)
+		if err != nil {
+			return nil, err
+		}
+
+		op := ">"
+		if scanDesc {
+			op = "<"
+		}
+		db = db.Where(
+			fmt.Sprintf("(`block_number` %s ? or (`block_number` = ? and `id` %s ?))", op, op),
+			cursor.BlockNumber, cursor.BlockNumber, cursor.ID,
+		)
+	}
+
+	order := "ASC"
+	if scanDesc {
+		order = "DESC"
 	}
 
-	// 推送事件
-	repo.rw.Lock()
-	defer repo.rw.Unlock()
-	for _, stream := range repo.subscriber {
-		select {
-		case stream.Input() <- event:
-		default:
+	return db.Order(fmt.Sprintf("`block_number` %s, `id` %s", order, order)).Limit(query.Limit + 1), nil
+}
+
+// 根据按扫描方向排列的查询结果生成分页结果
+func newEventPage(query *domain.EventQuery, ms []*models.Event) *domain.EventPage {
+
+	backward := query.Before != ""
+	rawCursor := query.After
+	if backward {
+		rawCursor = query.Before
+	}
+
+	hasMore := len(ms) > query.Limit
+	if hasMore {
+		ms = ms[:query.Limit]
+	}
+
+	if backward {
+		for i, j := 0, len(ms)-1; i < j; i, j = i+1, j-1 {
+			ms[i], ms[j] = ms[j], ms[i]
 		}
 	}
 
-	return nil
+	page := &domain.EventPage{Events: make([]domain.Event, 0, len(ms))}
+	for _, m := range ms {
+		page.Events = append(page.Events, acl.ConvertModelToEvent(m))
+	}
+
+	// 结果为空时, 原游标仍然可以用于反方向翻页
+	if len(ms) == 0 {
+		if backward {
+			page.NextCursor = query.Before
+		} else {
+			page.PrevCursor = query.After
+		}
+		return page
+	}
+
+	first := domain.EventCursor{BlockNumber: ms[0].BlockNumber, ID: ms[0].ID}
+	last := domain.EventCursor{BlockNumber: ms[len(ms)-1].BlockNumber, ID: ms[len(ms)-1].ID}
+	if backward {
+		page.NextCursor = last.Encode()
+		if hasMore {
+			page.PrevCursor = first.Encode()
+		}
+	} else {
+		if hasMore {
+			page.NextCursor = last.Encode()
+		}
+		if rawCursor != "" {
+			page.PrevCursor = first.Encode()
+		}
+	}
+
+	return page
+}
+
+// 在事务中保存事件. 事务提交之后需要再以 rctx.UpdateCache 调用一次, 将区块推送给订阅者
+func (repo *eventRepo) Save(ctx context.Context, event *domain.EventsByBlock) error {
+
+	switch rctx.UpdateKindFromContext(ctx) {
+	case rctx.UpdateCache:
+		repo.hub.publish(event)
+		return nil
+
+	case rctx.UpdateDB:
+	default:
+		return nil
+	}
+
+	if len(event.Events) == 0 {
+		return nil
+	}
+
+	dbWithTx := rctx.TransactionDBFromContext(ctx)
+	if dbWithTx == nil {
+		panic("missing db instance")
+	}
+
+	var ms []*models.Event
+	for _, entity := range event.Events {
+		ms = append(ms, acl.ConvertEventToModel(entity))
+	}
+
+	// 记录事件
+	return dbWithTx.CreateInBatches(ms, 1000).Error
 }
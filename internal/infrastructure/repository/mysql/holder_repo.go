@@ -0,0 +1,228 @@
+package mysqlimpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 单次查询的最大数量
+const maxHolderLimit = 1000
+
+type holderMySQLRepo struct {
+	db *gorm.DB
+}
+
+func NewHolderRepo(db *gorm.DB) holder.HolderRepository {
+	return &holderMySQLRepo{db: db}
+}
+
+func (repo *holderMySQLRepo) SaveStats(ctx context.Context, stats ...*holder.Stats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.TickHolderStats, 0, len(stats))
+	for _, entity := range stats {
+		ms = append(ms, acl.ConvertHolderStatsEntityToModel(entity))
+	}
+
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: `tick`}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			`holders`,
+			`buckets`,
+			`first_activity_block`,
+			`first_activity_at`,
+			`last_activity_block`,
+			`last_activity_at`,
+			`last_updated_block`,
+			`updated_at`,
+		}),
+	}).CreateInBatches(ms, 1000).Error
+}
+
+func (repo *holderMySQLRepo) LoadStats(ctx context.Context, ticks ...string) ([]*holder.Stats, error) {
+	if len(ticks) == 0 {
+		return nil, nil
+	}
+
+	var ms []*models.TickHolderStats
+	if err := repo.db.WithContext(ctx).Where("tick IN ?", ticks).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var result = make([]*holder.Stats, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, acl.ConvertHolderStatsModelToEntity(m))
+	}
+
+	return result, nil
+}
+
+func (repo *holderMySQLRepo) TopHolders(ctx context.Context, tick string, limit int) ([]*holder.Holder, error) {
+	if limit <= 0 || limit > maxHolderLimit {
+		limit = maxHolderLimit
+	}
+
+	var ms []*models.IERC20Balance
+	err := repo.db.WithContext(ctx).
+		Select("address, total").
+		Where("tick = ? AND total > 0", tick).
+		Order("total desc, id asc").
+		Limit(limit).
+		Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make([]*holder.Holder, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, &holder.Holder{Address: m.Address, Amount: m.Total})
+	}
+
+	return result, nil
+}
+
+func (repo *holderMySQLRepo) Rebuild(ctx context.Context) error {
+	var count int64
+	if err := repo.db.WithContext(ctx).Model(&models.TickHolderStats{}).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count != 0 {
+		return nil
+	}
+
+	return repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 补全余额总量
+		err := tx.Model(&models.IERC20Balance{}).
+			Where("total <> available + freeze").
+			Update("total", gorm.Expr("available + freeze")).Error
+		if err != nil {
+			return err
+		}
+
+		// 按区间统计持仓地址数
+		var columns = []string{"tick", "COUNT(*) AS holders", "MAX(last_updated_block) AS last_updated_block"}
+		for idx := 0; idx <= len(holder.BucketBounds); idx++ {
+			var conditions []string
+			if idx > 0 {
+				conditions = append(conditions, fmt.Sprintf("total >= %s", holder.BucketBounds[idx-1]))
+			}
+			if idx < len(holder.BucketBounds) {
+				conditions = append(conditions, fmt.Sprintf("total < %s", holder.BucketBounds[idx]))
+			}
+			columns = append(columns, fmt.Sprintf("SUM(%s) AS bucket%d", strings.Join(conditions, " AND "), idx))
+		}
+
+		var rows []map[string]interface{}
+		err = tx.Model(&models.IERC20Balance{}).
+			Select(strings.Join(columns, ", ")).
+			Where("total > 0").
+			Group("tick").
+			Find(&rows).Error
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		var (
+			statsMap = make(map[string]*holder.Stats, len(rows))
+			ticks    = make([]string, 0, len(rows))
+		)
+		for _, row := range rows {
+			stats := holder.NewStats(toString(row["tick"]))
+			stats.Holders = toInt64(row["holders"])
+			stats.LastUpdatedBlock = uint64(toInt64(row["last_updated_block"]))
+			for idx := range stats.Buckets {
+				stats.Buckets[idx] = toInt64(row[fmt.Sprintf("bucket%d", idx)])
+			}
+
+			statsMap[stats.Tick] = stats
+			ticks = append(ticks, stats.Tick)
+		}
+
+		// 历史的活跃时间以 tick 成功事件的区块近似
+		var activities []struct {
+			Tick       string
+			FirstBlock uint64
+			FirstAt    time.Time
+			LastBlock  uint64
+			LastAt     time.Time
+		}
+		err = tx.Model(&models.Event{}).
+			Select("tick, MIN(block_number) AS first_block, MIN(event_at) AS first_at, MAX(block_number) AS last_block, MAX(event_at) AS last_at").
+			Where("tick IN ? AND err_code = 0", ticks).
+			Group("tick").
+			Find(&activities).Error
+		if err != nil {
+			return err
+		}
+
+		for _, activity := range activities {
+			stats, existed := statsMap[activity.Tick]
+			if !existed {
+				continue
+			}
+
+			stats.FirstActivityBlock = activity.FirstBlock
+			stats.FirstActivityAt = activity.FirstAt
+			stats.LastActivityBlock = activity.LastBlock
+			stats.LastActivityAt = activity.LastAt
+		}
+
+		var result = make([]*holder.Stats, 0, len(statsMap))
+		for _, tickName := range ticks {
+			result = append(result, statsMap[tickName])
+		}
+
+		return repo.SaveStats(rctx.WithTransactionDB(ctx, tx), result...)
+	})
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// 聚合函数的结果类型与驱动有关, 统一转换为 int64
+func toInt64(value interface{}) int64 {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	default:
+		d, err := decimal.NewFromString(toString(v))
+		if err != nil {
+			return 0
+		}
+		return d.IntPart()
+	}
+}
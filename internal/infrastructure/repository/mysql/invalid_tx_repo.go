@@ -0,0 +1,59 @@
+package mysqlimpl
+
+import (
+	"context"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type invalidTxRepo struct {
+	db *gorm.DB
+}
+
+func NewInvalidTxRepo(db *gorm.DB) domain.InvalidTxRepository {
+	return &invalidTxRepo{db: db}
+}
+
+func (repo *invalidTxRepo) LoadAll(ctx context.Context) ([]*domain.InvalidTx, error) {
+	var ms []*models.InvalidTx
+	if err := repo.db.WithContext(ctx).Order("id").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var entities = make([]*domain.InvalidTx, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertInvalidTxModelToEntity(m))
+	}
+
+	return entities, nil
+}
+
+func (repo *invalidTxRepo) Create(ctx context.Context, entries ...*domain.InvalidTx) (int64, error) {
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	var ms = make([]*models.InvalidTx, 0, len(entries))
+	for _, entry := range entries {
+		ms = append(ms, acl.ConvertInvalidTxEntityToModel(entry))
+	}
+
+	// 已经存在的交易hash不覆盖, 避免修改已生效记录的生效区块
+	result := repo.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(ms, 1000)
+
+	return result.RowsAffected, result.Error
+}
+
+func (repo *invalidTxRepo) Delete(ctx context.Context, hashes ...string) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	return repo.db.WithContext(ctx).Where("tx_hash IN ?", hashes).Delete(&models.InvalidTx{}).Error
+}
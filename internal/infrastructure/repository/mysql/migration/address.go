@@ -0,0 +1,569 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// dry-run 时用于回滚事务
+var errDryRun = errors.New("dry run")
+
+// 地址规范化的审计报告
+type AddressReport struct {
+	DryRun            bool           `json:"dry_run"`
+	StartedAt         time.Time      `json:"started_at"`
+	FinishedAt        time.Time      `json:"finished_at"`
+	HolderStatsReset  bool           `json:"holder_stats_reset"` // 余额合并后, 持仓统计需要重建
+	Tables            []*TableReport `json:"tables"`
+	UnresolvedPools   []*MergeGroup  `json:"unresolved_pools,omitempty"` // 大小写不同的同一个池子, 配置无法自动合并, 需要人工处理
+	TotalNormalized   int64          `json:"total_normalized"`
+	TotalMergedGroups int            `json:"total_merged_groups"`
+	TotalDeleted      int            `json:"total_deleted"`
+}
+
+type TableReport struct {
+	Table        string        `json:"table"`
+	Normalized   int64         `json:"normalized"`    // 只需要改写为规范地址的记录数
+	MergedGroups int           `json:"merged_groups"` // 合并的重复记录组数
+	Deleted      int           `json:"deleted"`       // 合并后删除的记录数
+	Groups       []*MergeGroup `json:"groups,omitempty"`
+}
+
+// 一组大小写不同的重复记录
+type MergeGroup struct {
+	Key        string            `json:"key"`
+	Variants   []string          `json:"variants"`
+	KeptID     int64             `json:"kept_id"`
+	RemovedIDs []int64           `json:"removed_ids"`
+	Before     []json.RawMessage `json:"before"`
+	After      json.RawMessage   `json:"after"`
+}
+
+func (r *AddressReport) addTable(t *TableReport) {
+	r.Tables = append(r.Tables, t)
+	r.TotalNormalized += t.Normalized
+	r.TotalMergedGroups += t.MergedGroups
+	r.TotalDeleted += t.Deleted
+}
+
+func (t *TableReport) addGroup(g *MergeGroup) {
+	t.Groups = append(t.Groups, g)
+	t.MergedGroups++
+	t.Deleted += len(g.RemovedIDs)
+}
+
+// 规范化 ierc_balances 和 staking_* 表中的地址, 合并大小写不同的重复记录.
+// staking_pools 中大小写不同的同一个池子不合并, 只记录在 UnresolvedPools 中.
+// 所有修改在同一个事务中完成; dryRun 时执行完成后回滚, 报告内容与实际执行一致
+func NormalizeAddresses(ctx context.Context, db *gorm.DB, dryRun bool) (*AddressReport, error) {
+	report := &AddressReport{DryRun: dryRun, StartedAt: time.Now()}
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		steps := []func(tx *gorm.DB) (*TableReport, error){
+			normalizeBalances,
+			normalizeStakingPositions,
+			normalizeStakingBalances,
+			normalizeStakingRewardsRecords,
+			func(tx *gorm.DB) (*TableReport, error) { return normalizeStakingPools(tx, report) },
+		}
+
+		for _, step := range steps {
+			t, err := step(tx)
+			if err != nil {
+				return err
+			}
+			report.addTable(t)
+		}
+
+		// 持仓统计只会在表为空时重建, 余额合并后清空, 由 indexer 启动时重新统计
+		if report.Tables[0].MergedGroups > 0 {
+			if err := tx.Exec(fmt.Sprintf("DELETE FROM %s", (&models.TickHolderStats{}).TableName())).Error; err != nil {
+				return err
+			}
+			report.HolderStatsReset = true
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// 地址列不是规范格式的条件
+func nonCanonical(column string) string {
+	return fmt.Sprintf("BINARY %s <> BINARY LOWER(TRIM(%s))", column, column)
+}
+
+// 规范化后的地址列
+func canonicalColumn(column string) string {
+	return fmt.Sprintf("LOWER(TRIM(%s))", column)
+}
+
+// 从重复记录中选出要保留的一条: 优先保留已经是规范地址的记录, 否则保留最早的记录
+func pickKept(ids []int64, canonical []bool) int {
+	for i := range ids {
+		if canonical[i] {
+			return i
+		}
+	}
+
+	kept := 0
+	for i := range ids {
+		if ids[i] < ids[kept] {
+			kept = i
+		}
+	}
+
+	return kept
+}
+
+func variants(values ...string) []string {
+	var set = make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+
+	var result = make([]string, 0, len(set))
+	for v := range set {
+		result = append(result, v)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func mustJSON(v interface{}) json.RawMessage {
+	data, _ := json.Marshal(v)
+	return data
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+// ====== ierc_balances
+
+type balanceKey struct {
+	Address string
+	Tick    string
+}
+
+func normalizeBalances(tx *gorm.DB) (*TableReport, error) {
+	table := (&models.IERC20Balance{}).TableName()
+	report := &TableReport{Table: table}
+
+	var keys []*balanceKey
+	err := tx.Table(table).
+		Select(fmt.Sprintf("DISTINCT %s AS address, tick", canonicalColumn("address"))).
+		Where(nonCanonical("address")).
+		Scan(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		var rows []*models.IERC20Balance
+		err := tx.Where(fmt.Sprintf("%s = ? AND tick = ?", canonicalColumn("address")), key.Address, key.Tick).
+			Order("id").Find(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 {
+			continue
+		}
+
+		if len(rows) == 1 {
+			err := tx.Exec(fmt.Sprintf("UPDATE %s SET address = ? WHERE id = ?", table), key.Address, rows[0].ID).Error
+			if err != nil {
+				return nil, err
+			}
+			report.Normalized++
+			continue
+		}
+
+		kept, group := mergeBalances(key.Address, rows)
+		if err := deleteByIDs(tx, table, group.RemovedIDs); err != nil {
+			return nil, err
+		}
+
+		err = tx.Exec(
+			fmt.Sprintf("UPDATE %s SET address = ?, available = ?, freeze = ?, total = ?, minted = ?, last_updated_block = ?, created_at = ? WHERE id = ?", table),
+			kept.Address, kept.Available, kept.Freeze, kept.Total, kept.Minted, kept.LastUpdatedBlock, kept.CreatedAt, kept.ID,
+		).Error
+		if err != nil {
+			return nil, err
+		}
+		report.addGroup(group)
+	}
+
+	return report, nil
+}
+
+// 合并同一个地址(大小写不同)在同一个 tick 下的余额
+func mergeBalances(canonical string, rows []*models.IERC20Balance) (*models.IERC20Balance, *MergeGroup) {
+	var (
+		ids         = make([]int64, len(rows))
+		isCanonical = make([]bool, len(rows))
+		addresses   = make([]string, len(rows))
+		before      = make([]json.RawMessage, len(rows))
+	)
+	for i, row := range rows {
+		ids[i] = row.ID
+		isCanonical[i] = row.Address == canonical
+		addresses[i] = row.Address
+		before[i] = mustJSON(row)
+	}
+
+	idx := pickKept(ids, isCanonical)
+	kept := *rows[idx]
+	kept.Address = canonical
+	kept.Available, kept.Freeze, kept.Minted = decimal.Zero, decimal.Zero, decimal.Zero
+
+	group := &MergeGroup{
+		Key:      fmt.Sprintf("%s-%s", canonical, kept.Tick),
+		Variants: variants(addresses...),
+		KeptID:   kept.ID,
+		Before:   before,
+	}
+
+	for i, row := range rows {
+		kept.Available = kept.Available.Add(row.Available)
+		kept.Freeze = kept.Freeze.Add(row.Freeze)
+		kept.Minted = kept.Minted.Add(row.Minted)
+		kept.LastUpdatedBlock = max(kept.LastUpdatedBlock, row.LastUpdatedBlock)
+		kept.CreatedAt = minTime(kept.CreatedAt, row.CreatedAt)
+		if i != idx {
+			group.RemovedIDs = append(group.RemovedIDs, row.ID)
+		}
+	}
+	kept.Total = kept.Available.Add(kept.Freeze)
+	group.After = mustJSON(&kept)
+
+	return &kept, group
+}
+
+// ====== staking_positions
+
+type positionKey struct {
+	Pool   string
+	PoolID uint64
+	Staker string
+}
+
+func normalizeStakingPositions(tx *gorm.DB) (*TableReport, error) {
+	table := (&models.StakingPosition{}).TableName()
+	report := &TableReport{Table: table}
+
+	var keys []*positionKey
+	err := tx.Table(table).
+		Select(fmt.Sprintf("DISTINCT %s AS pool, pool_id, %s AS staker", canonicalColumn("pool"), canonicalColumn("staker"))).
+		Where(nonCanonical("pool")).Or(nonCanonical("staker")).
+		Scan(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		var rows []*models.StakingPosition
+		err := tx.Where(
+			fmt.Sprintf("%s = ? AND pool_id = ? AND %s = ?", canonicalColumn("pool"), canonicalColumn("staker")),
+			key.Pool, key.PoolID, key.Staker,
+		).Order("id").Find(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 {
+			continue
+		}
+
+		if len(rows) == 1 {
+			err := tx.Exec(fmt.Sprintf("UPDATE %s SET pool = ?, staker = ? WHERE id = ?", table), key.Pool, key.Staker, rows[0].ID).Error
+			if err != nil {
+				return nil, err
+			}
+			report.Normalized++
+			continue
+		}
+
+		kept, group := mergePositions(key, rows)
+		if err := deleteByIDs(tx, table, group.RemovedIDs); err != nil {
+			return nil, err
+		}
+
+		err = tx.Exec(
+			fmt.Sprintf("UPDATE %s SET pool = ?, staker = ?, acc_rewards = ?, debt = ?, rewards_per_block = ?, last_reward_block = ?, last_updated_block = ?, staker_amounts = ?, created_at = ? WHERE id = ?", table),
+			kept.Pool, kept.Staker, kept.AccRewards, kept.Debt, kept.RewardsPerBlock, kept.LastRewardBlock, kept.LastUpdatedBlock, kept.Amounts, kept.CreatedAt, kept.ID,
+		).Error
+		if err != nil {
+			return nil, err
+		}
+		report.addGroup(group)
+	}
+
+	return report, nil
+}
+
+// 合并同一个质押人(大小写不同)的仓位. 奖励的结算由领域模型完成
+func mergePositions(key *positionKey, rows []*models.StakingPosition) (*models.StakingPosition, *MergeGroup) {
+	var (
+		ids         = make([]int64, len(rows))
+		isCanonical = make([]bool, len(rows))
+		stakers     = make([]string, len(rows))
+		before      = make([]json.RawMessage, len(rows))
+	)
+	for i, row := range rows {
+		ids[i] = row.ID
+		isCanonical[i] = row.Pool == key.Pool && row.Staker == key.Staker
+		stakers[i] = row.Staker
+		before[i] = mustJSON(row)
+	}
+
+	idx := pickKept(ids, isCanonical)
+	position := acl.ConvertPositionModelToEntity(rows[idx])
+	position.PoolAddress = key.Pool
+	position.Staker = key.Staker
+
+	group := &MergeGroup{
+		Key:      fmt.Sprintf("%s-%d-%s", key.Pool, key.PoolID, key.Staker),
+		Variants: variants(stakers...),
+		KeptID:   rows[idx].ID,
+		Before:   before,
+	}
+
+	for i, row := range rows {
+		if i == idx {
+			continue
+		}
+
+		position.Merge(acl.ConvertPositionModelToEntity(row))
+		group.RemovedIDs = append(group.RemovedIDs, row.ID)
+	}
+
+	kept := acl.ConvertPositionEntityToModel(position)
+	kept.ID = rows[idx].ID
+	group.After = mustJSON(kept)
+
+	return kept, group
+}
+
+// ====== staking_balances
+
+type stakingBalanceKey struct {
+	Staker string
+	Pool   string
+	PoolID uint64
+	Tick   string
+}
+
+func normalizeStakingBalances(tx *gorm.DB) (*TableReport, error) {
+	table := (&models.StakingBalance{}).TableName()
+	report := &TableReport{Table: table}
+
+	var keys []*stakingBalanceKey
+	err := tx.Table(table).
+		Select(fmt.Sprintf("DISTINCT %s AS staker, %s AS pool, pool_id, tick", canonicalColumn("staker"), canonicalColumn("pool"))).
+		Where(nonCanonical("pool")).Or(nonCanonical("staker")).
+		Scan(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		var rows []*models.StakingBalance
+		err := tx.Where(
+			fmt.Sprintf("%s = ? AND %s = ? AND pool_id = ? AND tick = ?", canonicalColumn("staker"), canonicalColumn("pool")),
+			key.Staker, key.Pool, key.PoolID, key.Tick,
+		).Order("id").Find(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 {
+			continue
+		}
+
+		if len(rows) == 1 {
+			err := tx.Exec(fmt.Sprintf("UPDATE %s SET staker = ?, pool = ? WHERE id = ?", table), key.Staker, key.Pool, rows[0].ID).Error
+			if err != nil {
+				return nil, err
+			}
+			report.Normalized++
+			continue
+		}
+
+		kept, group := mergeStakingBalances(key, rows)
+		if err := deleteByIDs(tx, table, group.RemovedIDs); err != nil {
+			return nil, err
+		}
+
+		err = tx.Exec(
+			fmt.Sprintf("UPDATE %s SET staker = ?, pool = ?, amount = ?, block_number = ?, created_at = ? WHERE id = ?", table),
+			kept.Staker, kept.Pool, kept.Amount, kept.BlockNumber, kept.CreatedAt, kept.ID,
+		).Error
+		if err != nil {
+			return nil, err
+		}
+		report.addGroup(group)
+	}
+
+	return report, nil
+}
+
+func mergeStakingBalances(key *stakingBalanceKey, rows []*models.StakingBalance) (*models.StakingBalance, *MergeGroup) {
+	var (
+		ids         = make([]int64, len(rows))
+		isCanonical = make([]bool, len(rows))
+		stakers     = make([]string, len(rows))
+		before      = make([]json.RawMessage, len(rows))
+	)
+	for i, row := range rows {
+		ids[i] = row.ID
+		isCanonical[i] = row.Pool == key.Pool && row.Staker == key.Staker
+		stakers[i] = row.Staker
+		before[i] = mustJSON(row)
+	}
+
+	idx := pickKept(ids, isCanonical)
+	kept := *rows[idx]
+	kept.Staker, kept.Pool, kept.Amount = key.Staker, key.Pool, decimal.Zero
+
+	group := &MergeGroup{
+		Key:      fmt.Sprintf("%s-%s-%d-%s", key.Staker, key.Pool, key.PoolID, key.Tick),
+		Variants: variants(stakers...),
+		KeptID:   kept.ID,
+		Before:   before,
+	}
+
+	for i, row := range rows {
+		kept.Amount = kept.Amount.Add(row.Amount)
+		kept.BlockNumber = max(kept.BlockNumber, row.BlockNumber)
+		kept.CreatedAt = minTime(kept.CreatedAt, row.CreatedAt)
+		if i != idx {
+			group.RemovedIDs = append(group.RemovedIDs, row.ID)
+		}
+	}
+	group.After = mustJSON(&kept)
+
+	return &kept, group
+}
+
+// ====== staking_rewards_records
+
+// 奖励记录是流水, 没有唯一约束, 只需要改写地址
+func normalizeStakingRewardsRecords(tx *gorm.DB) (*TableReport, error) {
+	table := (&models.StakingRewardsRecord{}).TableName()
+
+	result := tx.Exec(fmt.Sprintf(
+		"UPDATE %s SET pool = %s, staker = %s WHERE %s OR %s",
+		table, canonicalColumn("pool"), canonicalColumn("staker"), nonCanonical("pool"), nonCanonical("staker"),
+	))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &TableReport{Table: table, Normalized: result.RowsAffected}, nil
+}
+
+// ====== staking_pools
+
+// 池子数量很少, 全部加载后在内存中处理. 池子的配置数据(json)中也包含地址
+func normalizeStakingPools(tx *gorm.DB, report *AddressReport) (*TableReport, error) {
+	table := (&models.StakingPool{}).TableName()
+	t := &TableReport{Table: table}
+
+	var rows []*models.StakingPool
+	if err := tx.Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	var groups = make(map[string][]*models.StakingPool)
+	for _, row := range rows {
+		key := fmt.Sprintf("%s-%d", address.Canonical(row.Pool), row.PoolID)
+		groups[key] = append(groups[key], row)
+	}
+
+	var keys = make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		group := groups[key]
+
+		// 同一个池子存在多份配置时无法判断以哪一份为准, 只记录不处理. 这些记录的地址也保持不变
+		if len(group) > 1 {
+			unresolved := &MergeGroup{Key: key}
+			for _, row := range group {
+				unresolved.Variants = append(unresolved.Variants, row.Pool)
+				unresolved.Before = append(unresolved.Before, mustJSON(row))
+			}
+			report.UnresolvedPools = append(report.UnresolvedPools, unresolved)
+			continue
+		}
+
+		row := group[0]
+		pool, err := acl.ConvertPoolModelToEntity(row)
+		if err != nil {
+			return nil, fmt.Errorf("invalid staking pool data. id: %d, err: %w", row.ID, err)
+		}
+
+		// mysql 返回的 json 会被重新格式化, 不能直接比较 data, 只比较其中的地址
+		changed := !address.Normalize(row.Pool).IsCanonical() || !address.Normalize(row.Owner).IsCanonical()
+		canonicalize := func(s string) string {
+			c := address.Canonical(s)
+			changed = changed || c != s
+			return c
+		}
+
+		pool.Pool = canonicalize(pool.Pool)
+		pool.Detail.Owner = canonicalize(pool.Detail.Owner)
+		for i, admin := range pool.Detail.Admins {
+			pool.Detail.Admins[i] = canonicalize(admin)
+		}
+
+		if !changed {
+			continue
+		}
+
+		m := acl.ConvertPoolEntityToModel(pool)
+
+		err = tx.Exec(fmt.Sprintf("UPDATE %s SET pool = ?, owner = ?, data = ? WHERE id = ?", table), m.Pool, m.Owner, m.Data, row.ID).Error
+		if err != nil {
+			return nil, err
+		}
+		t.Normalized++
+	}
+
+	return t, nil
+}
+
+func deleteByIDs(tx *gorm.DB, table string, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE id IN ?", table), ids).Error
+}
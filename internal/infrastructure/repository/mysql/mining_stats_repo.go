@@ -0,0 +1,159 @@
+package mysqlimpl
+
+import (
+	"context"
+
+	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/mining"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+)
+
+// 单次查询的最大数量
+const maxMiningStatsLimit = 1000
+
+// 一个区块中所有 tick 的挖矿统计
+type miningStatsBlock struct {
+	BlockNumber uint64
+	Stats       []*mining.BlockStats
+}
+
+type miningStatsRepo struct {
+	db  *gorm.DB
+	hub *blockHub[*miningStatsBlock, mining.BlockStats]
+}
+
+func NewMiningStatsRepo(db *gorm.DB) domain.MiningStatsRepository {
+	repo := &miningStatsRepo{db: db}
+	repo.hub = newBlockHub[*miningStatsBlock, mining.BlockStats](repo.loadBlocks, eventSubscriberBufferSize)
+	return repo
+}
+
+// 在事务中保存挖矿统计. 事务提交之后需要再以 rctx.UpdateCache 调用一次, 将统计推送给订阅者.
+// 同一次调用中的统计必须属于同一个区块
+func (repo *miningStatsRepo) Save(ctx context.Context, stats ...*mining.BlockStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	switch rctx.UpdateKindFromContext(ctx) {
+	case rctx.UpdateCache:
+		repo.hub.publish(&miningStatsBlock{BlockNumber: stats[0].BlockNumber, Stats: stats})
+		return nil
+
+	case rctx.UpdateDB:
+	default:
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.MiningBlockStats, 0, len(stats))
+	for _, item := range stats {
+		ms = append(ms, acl.ConvertMiningStatsEntityToModel(item))
+	}
+
+	return db.WithContext(ctx).CreateInBatches(ms, 1000).Error
+}
+
+func (repo *miningStatsRepo) Query(ctx context.Context, query *mining.StatsQuery) ([]*mining.BlockStats, error) {
+	db := repo.db.WithContext(ctx)
+	if query.Tick != "" {
+		db = db.Where("tick = ?", query.Tick)
+	}
+	if query.StartBlock != 0 {
+		db = db.Where("block_number >= ?", query.StartBlock)
+	}
+	if query.EndBlock != 0 {
+		db = db.Where("block_number <= ?", query.EndBlock)
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > maxMiningStatsLimit {
+		limit = maxMiningStatsLimit
+	}
+
+	var ms []*models.MiningBlockStats
+	if err := db.Order("block_number asc, id asc").Limit(limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var result = make([]*mining.BlockStats, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, acl.ConvertMiningStatsModelToEntity(m))
+	}
+
+	return result, nil
+}
+
+// 订阅 startBlock 之后的挖矿统计. 先从数据库回放, 追平后推送新提交的区块, 推送过程中不会丢失数据.
+// 客户端断开后以收到的最后一个区块号作为 startBlock 重新订阅即可继续
+func (repo *miningStatsRepo) Subscribe(ctx context.Context, tick string, startBlock uint64) (*domain.Stream[mining.BlockStats], error) {
+	return repo.hub.subscribe(ctx, &miningStatsCursor{tick: tick, cursor: startBlock}), nil
+}
+
+// 加载 startBlock 之后的挖矿统计, 按区块归集. 最后一个区块的数据不完整时留到下一次加载
+func (repo *miningStatsRepo) loadBlocks(ctx context.Context, startBlock uint64, limit int) ([]*miningStatsBlock, error) {
+	stats, err := repo.Query(ctx, &mining.StatsQuery{StartBlock: startBlock + 1, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	// 不是最后一页时, 最后一个区块的数据可能不完整. 一页只有一个区块时说明该区块的数据超过一页, 直接返回
+	if len(stats) == limit && stats[0].BlockNumber != stats[len(stats)-1].BlockNumber {
+		lastBlock := stats[len(stats)-1].BlockNumber
+		for len(stats) > 0 && stats[len(stats)-1].BlockNumber == lastBlock {
+			stats = stats[:len(stats)-1]
+		}
+	}
+
+	var blocks []*miningStatsBlock
+	for _, item := range stats {
+		if len(blocks) == 0 || blocks[len(blocks)-1].BlockNumber != item.BlockNumber {
+			blocks = append(blocks, &miningStatsBlock{BlockNumber: item.BlockNumber})
+		}
+
+		last := blocks[len(blocks)-1]
+		last.Stats = append(last.Stats, item)
+	}
+
+	return blocks, nil
+}
+
+// 挖矿统计订阅位置. tick 为空时推送所有 tick
+type miningStatsCursor struct {
+	tick   string
+	cursor uint64
+}
+
+func (c *miningStatsCursor) Cursor() uint64 {
+	return c.cursor
+}
+
+func (c *miningStatsCursor) Deliver(block *miningStatsBlock, _ bool) []*mining.BlockStats {
+	if block.BlockNumber <= c.cursor {
+		return nil
+	}
+
+	c.cursor = block.BlockNumber
+
+	var result []*mining.BlockStats
+	for _, item := range block.Stats {
+		if c.tick != "" && c.tick != item.Tick {
+			continue
+		}
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// 挖矿统计没有追平通知
+func (c *miningStatsCursor) CaughtUp() *mining.BlockStats {
+	return nil
+}
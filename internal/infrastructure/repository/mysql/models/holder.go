@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// tick 的持仓统计
+type TickHolderStats struct {
+	ID                 int64     `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Tick               string    `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_tick;not null;default:'';comment:'tick'"`
+	Holders            int64     `gorm:"column:holders;type:bigint;not null;default:0;comment:'持仓地址数'"`
+	Buckets            []byte    `gorm:"column:buckets;type:json;comment:'各区间的持仓地址数'"`
+	FirstActivityBlock uint64    `gorm:"column:first_activity_block;type:bigint;not null;default:0;comment:'第一次余额变化的区块'"`
+	FirstActivityAt    time.Time `gorm:"column:first_activity_at;type:datetime(3);comment:'第一次余额变化的时间'"`
+	LastActivityBlock  uint64    `gorm:"column:last_activity_block;type:bigint;not null;default:0;comment:'最后一次余额变化的区块'"`
+	LastActivityAt     time.Time `gorm:"column:last_activity_at;type:datetime(3);comment:'最后一次余额变化的时间'"`
+	LastUpdatedBlock   uint64    `gorm:"column:last_updated_block;type:bigint;comment:'区块号'"`
+	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (t *TickHolderStats) TableName() string {
+	return "tick_holder_stats"
+}
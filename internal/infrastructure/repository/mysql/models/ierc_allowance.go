@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 授权额度
+type IERC20Allowance struct {
+	ID               int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Owner            string          `gorm:"<-:create;column:owner;type:varchar(42);uniqueIndex:uni_owner_spender_tick,priority:1;not null;default:'';comment:'资产所有者'"`
+	Spender          string          `gorm:"<-:create;column:spender;type:varchar(42);uniqueIndex:uni_owner_spender_tick,priority:2;index:idx_spender;not null;default:'';comment:'被授权者'"`
+	Tick             string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_owner_spender_tick,priority:3;not null;default:'';comment:'tick'"`
+	Amount           decimal.Decimal `gorm:"column:amount;type:decimal(50,18);not null;default:0.000000000000000000;comment:'剩余授权额度'"`
+	SignNonce        decimal.Decimal `gorm:"column:sign_nonce;type:decimal(65,0);not null;default:0;comment:'最后一次签名授权的nonce'"`
+	LastUpdatedBlock uint64          `gorm:"column:last_updated_block;type:bigint;comment:'区块号'"`
+	CreatedAt        time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt        time.Time       `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (a *IERC20Allowance) TableName() string {
+	return "ierc_allowances"
+}
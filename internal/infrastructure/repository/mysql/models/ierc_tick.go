@@ -12,6 +12,7 @@ type IERCTick struct {
 	Tick             string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:idx_tick;not null;default:'';comment:'tick 名称'"`
 	Decimals         int64           `gorm:"<-:create;column:decimals;type:int;not null;default:0;comment:'tick 精度'"`
 	Creator          string          `gorm:"<-:create;column:creator;type:varchar(64);not null;default:'';comment:'tick 创建者'"`
+	DeployBlock      uint64          `gorm:"<-:create;column:deploy_block;type:bigint;not null;default:0;comment:'部署区块'"`
 	MaxSupply        decimal.Decimal `gorm:"<-:create;column:max_supply;type:decimal(50,18);not null;default:0.000000000000000000;comment:'最大发行数量'"`
 	Supply           decimal.Decimal `gorm:"column:supply;type:decimal(50,18);not null;default:0.000000000000000000;comment:'已发行数量'"`
 	Detail           []byte          `gorm:"column:detail;type:json;comment:'详情数据'"`
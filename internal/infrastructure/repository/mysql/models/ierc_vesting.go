@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 锁仓
+type IERC20Vesting struct {
+	ID               int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	VestID           string          `gorm:"<-:create;column:vest_id;type:varchar(96);uniqueIndex:uni_vest_id;not null;default:'';comment:'锁仓ID'"`
+	Tick             string          `gorm:"<-:create;column:tick;type:varchar(64);index:idx_recipient_tick,priority:2;not null;default:'';comment:'tick'"`
+	From             string          `gorm:"<-:create;column:from;type:varchar(42);index:idx_from;not null;default:'';comment:'锁仓发起人'"`
+	Recipient        string          `gorm:"<-:create;column:recipient;type:varchar(42);index:idx_recipient_tick,priority:1;not null;default:'';comment:'接收者'"`
+	Total            decimal.Decimal `gorm:"<-:create;column:total;type:decimal(50,18);not null;default:0.000000000000000000;comment:'锁仓总量'"`
+	Released         decimal.Decimal `gorm:"column:released;type:decimal(50,18);not null;default:0.000000000000000000;comment:'已领取数量'"`
+	StartBlock       uint64          `gorm:"<-:create;column:start_block;type:bigint;not null;default:0;comment:'开始释放区块'"`
+	CliffBlock       uint64          `gorm:"<-:create;column:cliff_block;type:bigint;not null;default:0;comment:'锁定期结束区块'"`
+	EndBlock         uint64          `gorm:"<-:create;column:end_block;type:bigint;not null;default:0;comment:'全部释放区块'"`
+	Decimals         int32           `gorm:"<-:create;column:decimals;type:tinyint;not null;default:18;comment:'释放精度'"`
+	LastUpdatedBlock uint64          `gorm:"column:last_updated_block;type:bigint;comment:'区块号'"`
+	CreatedAt        time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt        time.Time       `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (v *IERC20Vesting) TableName() string {
+	return "ierc_vestings"
+}
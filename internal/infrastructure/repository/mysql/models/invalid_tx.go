@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// 无效交易
+type InvalidTx struct {
+	ID             int64     `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	TxHash         string    `gorm:"<-:create;column:tx_hash;type:varchar(66);uniqueIndex:uni_tx_hash;not null;comment:'交易哈希'"`
+	Category       string    `gorm:"<-:create;column:category;type:varchar(64);index:idx_category;not null;default:'';comment:'分类'"`
+	Reason         string    `gorm:"<-:create;column:reason;type:varchar(255);not null;default:'';comment:'原因'"`
+	EffectiveBlock uint64    `gorm:"<-:create;column:effective_block;type:bigint;not null;default:0;comment:'生效区块. 只对该区块及之后的交易生效'"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (t *InvalidTx) TableName() string {
+	return "invalid_txs"
+}
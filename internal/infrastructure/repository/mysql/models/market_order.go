@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 市场订单
+type MarketOrder struct {
+	ID               int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	OrderID          string          `gorm:"<-:create;column:order_id;type:varchar(96);uniqueIndex:uni_order_id;not null;default:'';comment:'订单ID'"`
+	Sign             string          `gorm:"<-:create;column:sign;type:varchar(256);index:idx_sign;not null;default:'';comment:'卖家签名'"`
+	SignNonce        string          `gorm:"<-:create;column:sign_nonce;type:varchar(128);not null;default:'';comment:'签名nonce'"`
+	Tick             string          `gorm:"<-:create;column:tick;type:varchar(64);index:idx_tick_status,priority:1;not null;default:'';comment:'tick'"`
+	Seller           string          `gorm:"<-:create;column:seller;type:varchar(42);index:idx_seller;not null;default:'';comment:'卖家'"`
+	Buyer            string          `gorm:"column:buyer;type:varchar(42);index:idx_buyer;not null;default:'';comment:'买家'"`
+	Amount           decimal.Decimal `gorm:"<-:create;column:amount;type:decimal(50,18);not null;default:0.000000000000000000;comment:'挂单数量'"`
+	Value            decimal.Decimal `gorm:"<-:create;column:value;type:decimal(50,18);not null;default:0.000000000000000000;comment:'挂单总价'"`
+	Price            decimal.Decimal `gorm:"<-:create;column:price;type:decimal(50,18);not null;default:0.000000000000000000;comment:'单价'"`
+	FilledAmount     decimal.Decimal `gorm:"column:filled_amount;type:decimal(50,18);not null;default:0.000000000000000000;comment:'成交数量'"`
+	FilledValue      decimal.Decimal `gorm:"column:filled_value;type:decimal(50,18);not null;default:0.000000000000000000;comment:'成交总价'"`
+	Status           string          `gorm:"column:status;type:varchar(32);index:idx_tick_status,priority:2;not null;default:'';comment:'订单状态'"`
+	ListTxHash       string          `gorm:"<-:create;column:list_tx_hash;type:varchar(66);not null;default:'';comment:'挂单交易'"`
+	ListBlock        uint64          `gorm:"<-:create;column:list_block;type:bigint;not null;default:0;comment:'挂单区块'"`
+	CloseTxHash      string          `gorm:"column:close_tx_hash;type:varchar(66);not null;default:'';comment:'成交或撤单的交易'"`
+	CloseBlock       uint64          `gorm:"column:close_block;type:bigint;not null;default:0;comment:'成交或撤单的区块'"`
+	LastUpdatedBlock uint64          `gorm:"column:last_updated_block;type:bigint;comment:'区块号'"`
+	CreatedAt        time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt        time.Time       `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (o *MarketOrder) TableName() string {
+	return "market_orders"
+}
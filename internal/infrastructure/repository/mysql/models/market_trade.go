@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 市场成交记录
+type MarketTrade struct {
+	ID          int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	TradeID     string          `gorm:"<-:create;column:trade_id;type:varchar(96);uniqueIndex:uni_trade_id;not null;default:'';comment:'成交ID'"`
+	OrderID     string          `gorm:"<-:create;column:order_id;type:varchar(96);not null;default:'';comment:'订单ID'"`
+	Tick        string          `gorm:"<-:create;column:tick;type:varchar(64);index:idx_tick_traded_at,priority:1;not null;default:'';comment:'tick'"`
+	Seller      string          `gorm:"<-:create;column:seller;type:varchar(42);index:idx_seller;not null;default:'';comment:'卖家'"`
+	Buyer       string          `gorm:"<-:create;column:buyer;type:varchar(42);index:idx_buyer;not null;default:'';comment:'买家'"`
+	Amount      decimal.Decimal `gorm:"<-:create;column:amount;type:decimal(50,18);not null;default:0.000000000000000000;comment:'成交数量'"`
+	Value       decimal.Decimal `gorm:"<-:create;column:value;type:decimal(50,18);not null;default:0.000000000000000000;comment:'成交总价'"`
+	Price       decimal.Decimal `gorm:"<-:create;column:price;type:decimal(50,18);not null;default:0.000000000000000000;comment:'单价'"`
+	TxHash      string          `gorm:"<-:create;column:tx_hash;type:varchar(66);not null;default:'';comment:'成交交易'"`
+	BlockNumber uint64          `gorm:"<-:create;column:block_number;type:bigint;not null;default:0;comment:'成交区块'"`
+	TradedAt    time.Time       `gorm:"<-:create;column:traded_at;type:datetime(3);index:idx_tick_traded_at,priority:2;comment:'成交时间'"`
+	CreatedAt   time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (t *MarketTrade) TableName() string {
+	return "market_trades"
+}
+
+// K线
+type MarketCandle struct {
+	ID               int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Tick             string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_tick_interval_open_time,priority:1;not null;default:'';comment:'tick'"`
+	Interval         string          `gorm:"<-:create;column:interval;type:varchar(8);uniqueIndex:uni_tick_interval_open_time,priority:2;not null;default:'';comment:'K线周期'"`
+	OpenTime         int64           `gorm:"<-:create;column:open_time;type:bigint;uniqueIndex:uni_tick_interval_open_time,priority:3;not null;default:0;comment:'开盘时间, unix 秒'"`
+	Open             decimal.Decimal `gorm:"column:open;type:decimal(50,18);not null;default:0.000000000000000000;comment:'开盘价'"`
+	High             decimal.Decimal `gorm:"column:high;type:decimal(50,18);not null;default:0.000000000000000000;comment:'最高价'"`
+	Low              decimal.Decimal `gorm:"column:low;type:decimal(50,18);not null;default:0.000000000000000000;comment:'最低价'"`
+	Close            decimal.Decimal `gorm:"column:close;type:decimal(50,18);not null;default:0.000000000000000000;comment:'收盘价'"`
+	Volume           decimal.Decimal `gorm:"column:volume;type:decimal(60,18);not null;default:0.000000000000000000;comment:'成交数量'"`
+	QuoteVolume      decimal.Decimal `gorm:"column:quote_volume;type:decimal(60,18);not null;default:0.000000000000000000;comment:'成交额'"`
+	Trades           int64           `gorm:"column:trades;type:bigint;not null;default:0;comment:'成交笔数'"`
+	LastUpdatedBlock uint64          `gorm:"column:last_updated_block;type:bigint;comment:'区块号'"`
+	CreatedAt        time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt        time.Time       `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (c *MarketCandle) TableName() string {
+	return "market_candles"
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// tick 每个区块的挖矿统计
+type MiningBlockStats struct {
+	ID            int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Tick          string          `gorm:"<-:create;column:tick;type:varchar(64);uniqueIndex:uni_tick_block,priority:1;not null;comment:'tick'"`
+	BlockNumber   uint64          `gorm:"<-:create;column:block_number;type:bigint;uniqueIndex:uni_tick_block,priority:2;index:idx_block_number;comment:'区块高度'"`
+	Miners        int64           `gorm:"column:miners;type:bigint;not null;default:0;comment:'mint 成功的矿工数'"`
+	PoWMiners     int64           `gorm:"column:pow_miners;type:bigint;not null;default:0;comment:'获得 pow 份额的矿工数'"`
+	PoSMiners     int64           `gorm:"column:pos_miners;type:bigint;not null;default:0;comment:'获得 pos 份额的矿工数'"`
+	DualMiners    int64           `gorm:"column:dual_miners;type:bigint;not null;default:0;comment:'同时获得 pow 和 pos 份额的矿工数'"`
+	PoWTotalShare decimal.Decimal `gorm:"column:pow_total_share;type:decimal(60,18);not null;default:0.000000000000000000;comment:'pow 总份额'"`
+	PoSTotalShare decimal.Decimal `gorm:"column:pos_total_share;type:decimal(60,18);not null;default:0.000000000000000000;comment:'pos 总份额'"`
+	PoWOutput     decimal.Decimal `gorm:"column:pow_output;type:decimal(50,18);not null;default:0.000000000000000000;comment:'pow mint 的数量'"`
+	PoSOutput     decimal.Decimal `gorm:"column:pos_output;type:decimal(50,18);not null;default:0.000000000000000000;comment:'pos mint 的数量'"`
+	BurnAmount    decimal.Decimal `gorm:"column:burn_amount;type:decimal(50,18);not null;default:0.000000000000000000;comment:'销毁的数量'"`
+	Rejected      int64           `gorm:"column:rejected;type:bigint;not null;default:0;comment:'mint 失败次数'"`
+	Rejections    []byte          `gorm:"column:rejections;type:json;comment:'按错误码统计的失败次数'"`
+	CreatedAt     time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (t *MiningBlockStats) TableName() string {
+	return "mining_block_stats"
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// 发件箱消息
+type OutboxMessage struct {
+	ID          int64      `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Topic       string     `gorm:"<-:create;column:topic;type:varchar(64);uniqueIndex:uni_topic_block,priority:1;not null;comment:'主题'"`
+	BlockNumber uint64     `gorm:"<-:create;column:block_number;type:bigint;uniqueIndex:uni_topic_block,priority:2;not null;comment:'区块号'"`
+	Payload     []byte     `gorm:"<-:create;column:payload;type:MEDIUMBLOB;not null;comment:'消息体'"`
+	Published   bool       `gorm:"column:published;type:tinyint;index:idx_published_id,priority:1;not null;default:0;comment:'是否已经发布'"`
+	PublishedAt *time.Time `gorm:"column:published_at;comment:'发布成功的时间'"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (m *OutboxMessage) TableName() string {
+	return "outbox_messages"
+}
@@ -56,3 +56,22 @@ type StakingBalance struct {
 func (t *StakingBalance) TableName() string {
 	return "staking_balances"
 }
+
+// 奖励变更记录
+type StakingRewardsRecord struct {
+	ID              int64           `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	Pool            string          `gorm:"<-:create;column:pool;type:varchar(42);index:idx_pool_staker,priority:1;not null;comment:'质押池地址'"`
+	PoolID          uint64          `gorm:"<-:create;column:pool_id;type:bigint;comment:'池子ID'"`
+	Staker          string          `gorm:"<-:create;column:staker;type:varchar(42);index:idx_pool_staker,priority:2;not null;comment:'质押者地址'"`
+	Kind            string          `gorm:"<-:create;column:kind;type:varchar(16);not null;default:'';comment:'变更类型. settle: 结算, use: 使用'"`
+	Amount          decimal.Decimal `gorm:"<-:create;column:amount;type:decimal(50,18);not null;default:0.000000000000000000;comment:'变更数量'"`
+	AccRewards      decimal.Decimal `gorm:"<-:create;column:acc_rewards;type:decimal(50,18);not null;default:0.000000000000000000;comment:'变更后的累积奖励'"`
+	Debt            decimal.Decimal `gorm:"<-:create;column:debt;type:decimal(50,18);not null;default:0.000000000000000000;comment:'变更后已使用的奖励'"`
+	RewardsPerBlock decimal.Decimal `gorm:"<-:create;column:rewards_per_block;type:decimal(50,18);not null;default:0.000000000000000000;comment:'变更时每个块的奖励'"`
+	BlockNumber     uint64          `gorm:"<-:create;column:block_number;type:bigint;index:idx_block_number;comment:'变更的区块'"`
+	CreatedAt       time.Time       `gorm:"column:created_at;autoCreateTime:milli"`
+}
+
+func (t *StakingRewardsRecord) TableName() string {
+	return "staking_rewards_records"
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// 回调地址
+type Webhook struct {
+	ID         int64     `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	URL        string    `gorm:"column:url;type:varchar(512);not null;comment:'回调地址'"`
+	Secret     string    `gorm:"column:secret;type:varchar(128);not null;default:'';comment:'签名密钥'"`
+	Filter     []byte    `gorm:"column:filter;type:json;comment:'过滤条件'"`
+	StartBlock uint64    `gorm:"<-:create;column:start_block;type:bigint;not null;default:0;comment:'只推送大于该区块的事件'"`
+	Enabled    bool      `gorm:"column:enabled;type:tinyint;not null;default:1;comment:'是否启用'"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (w *Webhook) TableName() string {
+	return "webhooks"
+}
+
+// 推送记录, 同时作为推送队列和死信表
+type WebhookDelivery struct {
+	ID            int64      `gorm:"<-:create;column:id;primaryKey;autoIncrement"`
+	WebhookID     int64      `gorm:"<-:create;column:webhook_id;type:bigint;uniqueIndex:uni_webhook_block,priority:1;not null;comment:'回调地址ID'"`
+	BlockNumber   uint64     `gorm:"<-:create;column:block_number;type:bigint;uniqueIndex:uni_webhook_block,priority:2;not null;comment:'区块号'"`
+	Payload       []byte     `gorm:"<-:create;column:payload;type:MEDIUMBLOB;not null;comment:'推送的请求体'"`
+	Status        uint8      `gorm:"column:status;type:tinyint;index:idx_status_next,priority:1;not null;comment:'推送状态. 1: 待推送; 2: 成功; 3: 死信'"`
+	Attempts      int        `gorm:"column:attempts;type:int;not null;default:0;comment:'已经尝试的次数'"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;index:idx_status_next,priority:2;comment:'下一次推送的时间'"`
+	LastError     string     `gorm:"column:last_error;type:varchar(512);not null;default:'';comment:'最后一次失败的原因'"`
+	DeliveredAt   *time.Time `gorm:"column:delivered_at;comment:'推送成功的时间'"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime:milli"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (w *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// 推送记录的入队进度, 只有一条记录
+type WebhookCursor struct {
+	ID        int64     `gorm:"column:id;primaryKey"`
+	Cursor    uint64    `gorm:"column:cursor;type:bigint;not null;default:0;comment:'已经处理到的区块'"`
+	LastBlock uint64    `gorm:"column:last_block;type:bigint;not null;default:0;comment:'最后一个有事件的区块'"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime:milli"`
+}
+
+func (w *WebhookCursor) TableName() string {
+	return "webhook_cursors"
+}
@@ -0,0 +1,112 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/order"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 单次查询的最大数量
+const maxOrderLimit = 1000
+
+type orderMySQLRepo struct {
+	db *gorm.DB
+}
+
+func NewOrderRepo(db *gorm.DB) order.OrderRepository {
+	return &orderMySQLRepo{db: db}
+}
+
+func (repo *orderMySQLRepo) Save(ctx context.Context, orders ...*order.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.MarketOrder, 0, len(orders))
+	for _, entity := range orders {
+		ms = append(ms, acl.ConvertOrderEntityToModel(entity))
+	}
+
+	// 挂单后只有成交、撤单相关的字段会变化
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: `order_id`}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			`buyer`,
+			`filled_amount`,
+			`filled_value`,
+			`status`,
+			`close_tx_hash`,
+			`close_block`,
+			`last_updated_block`,
+			`updated_at`,
+		}),
+	}).CreateInBatches(ms, 1000).Error
+}
+
+func (repo *orderMySQLRepo) Get(ctx context.Context, orderID string) (*order.Order, error) {
+	return repo.take(repo.db.WithContext(ctx).Where("order_id = ?", orderID))
+}
+
+func (repo *orderMySQLRepo) GetBySign(ctx context.Context, sign string) (*order.Order, error) {
+	return repo.take(repo.db.WithContext(ctx).Where("sign = ?", sign).Order("list_block desc, id desc"))
+}
+
+func (repo *orderMySQLRepo) take(db *gorm.DB) (*order.Order, error) {
+	var m models.MarketOrder
+	if err := db.Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return acl.ConvertOrderModelToEntity(&m), nil
+}
+
+func (repo *orderMySQLRepo) Query(ctx context.Context, query *order.OrderQuery) ([]*order.Order, error) {
+	db := repo.db.WithContext(ctx)
+	if query.Tick != "" {
+		db = db.Where("tick = ?", query.Tick)
+	}
+	if query.Seller != "" {
+		db = db.Where("seller = ?", query.Seller)
+	}
+	if query.Buyer != "" {
+		db = db.Where("buyer = ?", query.Buyer)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", string(query.Status))
+	}
+	if query.Cursor > 0 {
+		db = db.Where("id < ?", query.Cursor)
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > maxOrderLimit {
+		limit = maxOrderLimit
+	}
+
+	var ms []*models.MarketOrder
+	if err := db.Order("id desc").Limit(limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var result = make([]*order.Order, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, acl.ConvertOrderModelToEntity(m))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,114 @@
+package mysqlimpl
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxRepo struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepo(db *gorm.DB) outbox.OutboxRepository {
+	return &outboxRepo{db: db}
+}
+
+func (repo *outboxRepo) Save(ctx context.Context, messages ...*outbox.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.OutboxMessage, 0, len(messages))
+	for _, entity := range messages {
+		ms = append(ms, acl.ConvertOutboxMessageEntityToModel(entity))
+	}
+
+	// 重复处理区块时保留第一次写入的消息, 避免重复发布
+	return db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(ms, 100).Error
+}
+
+func (repo *outboxRepo) FetchPending(ctx context.Context, limit int) ([]*outbox.Message, error) {
+	var ms []*models.OutboxMessage
+	err := repo.db.WithContext(ctx).
+		Where("published = ?", false).
+		Order("id").
+		Limit(limit).
+		Find(&ms).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	var entities = make([]*outbox.Message, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertOutboxMessageModelToEntity(m))
+	}
+
+	return entities, nil
+}
+
+func (repo *outboxRepo) MarkPublished(ctx context.Context, ids []int64, now time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return repo.db.WithContext(ctx).
+		Model(&models.OutboxMessage{}).
+		Where("id in ?", ids).
+		Updates(map[string]any{
+			"published":    true,
+			"published_at": now,
+		}).
+		Error
+}
+
+func (repo *outboxRepo) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result := repo.db.WithContext(ctx).
+		Where("published = ? and published_at < ?", true, before).
+		Delete(&models.OutboxMessage{})
+
+	return result.RowsAffected, result.Error
+}
+
+// 中继锁的名称. 同一个数据库的所有实例共用
+const outboxRelayLockName = "eth_indexer:outbox_relay"
+
+// 使用 GET_LOCK 实现中继锁. 锁属于数据库连接, 需要在同一个连接上加锁和释放, 连接断开时自动释放
+func (repo *outboxRepo) WithRelayLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	sqlDB, err := repo.db.DB()
+	if err != nil {
+		return err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", outboxRelayLockName).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		return outbox.ErrRelayLocked
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", outboxRelayLockName)
+	}()
+
+	return fn(ctx)
+}
@@ -2,7 +2,9 @@ package mysqlimpl
 
 import (
 	"context"
+	"sort"
 
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/staking"
 	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
@@ -48,7 +50,7 @@ func (repo *stakingRepo) LoadAllPools(ctx context.Context) (map[string]*staking.
 
 func (repo *stakingRepo) QueryPoolAggregate(ctx context.Context, pool string) (*staking.PoolAggregate, error) {
 	var ms []*models.StakingPool
-	if err := repo.db.WithContext(ctx).Where("pool = ?", pool).Find(&ms).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Where("pool = ?", address.Canonical(pool)).Find(&ms).Error; err != nil {
 		return nil, err
 	}
 
@@ -70,6 +72,75 @@ func (repo *stakingRepo) QueryPoolAggregate(ctx context.Context, pool string) (*
 	return aggregate, nil
 }
 
+func (repo *stakingRepo) LoadPool(ctx context.Context, pool string, stakers ...string) (*staking.PoolAggregate, error) {
+	poolRoot, err := repo.QueryPoolAggregate(ctx, pool)
+	if err != nil || poolRoot == nil {
+		return nil, err
+	}
+
+	db := repo.db.WithContext(ctx).Where("pool = ?", poolRoot.PoolAddress)
+	if len(stakers) != 0 {
+		var canonical = make([]string, 0, len(stakers))
+		for _, staker := range stakers {
+			canonical = append(canonical, address.Canonical(staker))
+		}
+		db = db.Where("staker in ?", canonical)
+	}
+
+	var ms []*models.StakingPosition
+	err = db.FindInBatches(&ms, 1000, func(tx *gorm.DB, batch int) error {
+		for _, m := range ms {
+			poolRoot.InitPosition(acl.ConvertPositionModelToEntity(m))
+		}
+
+		return nil
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return poolRoot, nil
+}
+
+func (repo *stakingRepo) QueryPools(ctx context.Context, pools ...string) ([]*staking.PoolAggregate, error) {
+	if len(pools) == 0 {
+		poolRoots, err := repo.LoadAllPools(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for pool := range poolRoots {
+			pools = append(pools, pool)
+		}
+		sort.Strings(pools)
+
+		var result = make([]*staking.PoolAggregate, 0, len(pools))
+		for _, pool := range pools {
+			result = append(result, poolRoots[pool])
+		}
+
+		return result, nil
+	}
+
+	sort.Strings(pools)
+
+	var result = make([]*staking.PoolAggregate, 0, len(pools))
+	for _, pool := range pools {
+		poolRoot, err := repo.LoadPool(ctx, pool)
+		if err != nil {
+			return nil, err
+		}
+
+		if poolRoot == nil {
+			continue
+		}
+
+		result = append(result, poolRoot)
+	}
+
+	return result, nil
+}
+
 func (repo *stakingRepo) LoadAllPositionsByPool(ctx context.Context, pool *staking.PoolAggregate) error {
 
 	var ms []*models.StakingPosition
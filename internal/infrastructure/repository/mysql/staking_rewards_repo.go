@@ -0,0 +1,84 @@
+package mysqlimpl
+
+import (
+	"context"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/staking"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+)
+
+// 单次查询的最大数量
+const maxRewardsRecordLimit = 1000
+
+type rewardsRecordRepo struct {
+	db *gorm.DB
+}
+
+func NewRewardsRecordRepo(db *gorm.DB) staking.RewardsRecordRepository {
+	return &rewardsRecordRepo{db: db}
+}
+
+func (repo *rewardsRecordRepo) Save(ctx context.Context, records ...*staking.RewardsRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.StakingRewardsRecord, 0, len(records))
+	for _, record := range records {
+		ms = append(ms, acl.ConvertRewardsRecordEntityToModel(record))
+	}
+
+	return db.WithContext(ctx).CreateInBatches(ms, 1000).Error
+}
+
+func (repo *rewardsRecordRepo) Query(ctx context.Context, query *staking.RewardsRecordQuery) ([]*staking.RewardsRecord, error) {
+	db := repo.db.WithContext(ctx)
+	if query.Pool != "" {
+		db = db.Where("pool = ?", query.Pool)
+	}
+	if len(query.PoolSubIDs) != 0 {
+		db = db.Where("pool_id in ?", query.PoolSubIDs)
+	}
+	if query.Staker != "" {
+		db = db.Where("staker = ?", query.Staker)
+	}
+	if query.StartBlock != 0 {
+		db = db.Where("block_number >= ?", query.StartBlock)
+	}
+	if query.EndBlock != 0 {
+		db = db.Where("block_number <= ?", query.EndBlock)
+	}
+	if query.Cursor != "" {
+		cursor, err := staking.ParseRewardsRecordCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		db = db.Where("(block_number < ? or (block_number = ? and id < ?))", cursor.BlockNumber, cursor.BlockNumber, cursor.ID)
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > maxRewardsRecordLimit {
+		limit = maxRewardsRecordLimit
+	}
+
+	var ms []*models.StakingRewardsRecord
+	if err := db.Order("block_number desc, id desc").Limit(limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var records = make([]*staking.RewardsRecord, 0, len(ms))
+	for _, m := range ms {
+		records = append(records, acl.ConvertRewardsRecordModelToEntity(m))
+	}
+
+	return records, nil
+}
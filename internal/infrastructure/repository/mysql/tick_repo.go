@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	domain "github.com/kevin88886/eth_indexer/internal/domain/tick"
 	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
@@ -30,6 +31,66 @@ func (repo *tickRepo) Load(ctx context.Context, tick string) (domain.Tick, error
 	return acl.ConvertTickModelToEntity(&m)
 }
 
+func (repo *tickRepo) LoadMany(ctx context.Context, ticks ...string) ([]domain.Tick, error) {
+	if len(ticks) == 0 {
+		return nil, nil
+	}
+
+	var ms []*models.IERCTick
+	if err := repo.db.WithContext(ctx).Where("tick in ?", ticks).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var result = make([]domain.Tick, 0, len(ms))
+	for _, m := range ms {
+		entity, err := acl.ConvertTickModelToEntity(m)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, entity)
+	}
+
+	return result, nil
+}
+
+func (repo *tickRepo) Query(ctx context.Context, query *domain.TickQuery) ([]domain.Tick, error) {
+	db := repo.db.WithContext(ctx)
+	if query.Protocol != "" {
+		db = db.Where("protocol = ?", string(query.Protocol))
+	}
+	if query.Creator != "" {
+		db = db.Where("creator = ?", query.Creator)
+	}
+	if query.Mintable {
+		// 与 IsMintable 的判断保持一致. pow tick 剩余发行量需要大于最小精度
+		db = db.Where(
+			"((protocol = ? and max_supply - supply > ?) or (protocol <> ? and supply < max_supply))",
+			string(protocol.ProtocolIERCPoW), domain.MinDecimal, string(protocol.ProtocolIERCPoW),
+		)
+	}
+	if query.Cursor > 0 {
+		db = db.Where("id > ?", query.Cursor)
+	}
+
+	var ms []*models.IERCTick
+	if err := db.Order("id ASC").Limit(query.Limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var result = make([]domain.Tick, 0, len(ms))
+	for _, m := range ms {
+		entity, err := acl.ConvertTickModelToEntity(m)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, entity)
+	}
+
+	return result, nil
+}
+
 func (repo *tickRepo) Save(ctx context.Context, entities ...domain.Tick) error {
 
 	if len(entities) == 0 {
@@ -0,0 +1,234 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/trade"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 单次查询的最大数量
+const (
+	maxTradeLimit  = 1000
+	maxCandleLimit = 1500
+)
+
+type tradeMySQLRepo struct {
+	db *gorm.DB
+}
+
+func NewTradeRepo(db *gorm.DB) trade.TradeRepository {
+	return &tradeMySQLRepo{db: db}
+}
+
+func (repo *tradeMySQLRepo) Save(ctx context.Context, trades ...*trade.Trade) error {
+	if len(trades) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.MarketTrade, 0, len(trades))
+	for _, entity := range trades {
+		ms = append(ms, acl.ConvertTradeEntityToModel(entity))
+	}
+
+	// 成交记录不会变化, 重复处理区块时忽略
+	return db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(ms, 1000).Error
+}
+
+func (repo *tradeMySQLRepo) Query(ctx context.Context, query *trade.TradeQuery) ([]*trade.Trade, error) {
+	db := repo.db.WithContext(ctx)
+	if query.Tick != "" {
+		db = db.Where("tick = ?", query.Tick)
+	}
+	if query.Address != "" {
+		db = db.Where("(seller = ? OR buyer = ?)", query.Address, query.Address)
+	}
+	if query.Cursor > 0 {
+		db = db.Where("id < ?", query.Cursor)
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > maxTradeLimit {
+		limit = maxTradeLimit
+	}
+
+	var ms []*models.MarketTrade
+	if err := db.Order("id desc").Limit(limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var result = make([]*trade.Trade, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, acl.ConvertTradeModelToEntity(m))
+	}
+
+	return result, nil
+}
+
+func (repo *tradeMySQLRepo) Ticker(ctx context.Context, tick string, since time.Time) (*trade.Ticker, error) {
+	// 最新一笔成交
+	var last models.MarketTrade
+	err := repo.db.WithContext(ctx).Where("tick = ?", tick).Order("id desc").Take(&last).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var ticker = &trade.Ticker{
+		Tick:         tick,
+		LastPrice:    last.Price,
+		LastTradedAt: last.TradedAt,
+		OpenPrice:    decimal.Zero,
+		High:         decimal.Zero,
+		Low:          decimal.Zero,
+		Volume:       decimal.Zero,
+		QuoteVolume:  decimal.Zero,
+	}
+
+	// 窗口内的统计
+	var stats struct {
+		High        decimal.Decimal
+		Low         decimal.Decimal
+		Volume      decimal.Decimal
+		QuoteVolume decimal.Decimal
+		Trades      int64
+	}
+	err = repo.db.WithContext(ctx).
+		Model(&models.MarketTrade{}).
+		Select("COALESCE(MAX(price), 0) AS high, COALESCE(MIN(price), 0) AS low, COALESCE(SUM(amount), 0) AS volume, COALESCE(SUM(value), 0) AS quote_volume, COUNT(*) AS trades").
+		Where("tick = ? AND traded_at >= ?", tick, since).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ticker.High = stats.High
+	ticker.Low = stats.Low
+	ticker.Volume = stats.Volume
+	ticker.QuoteVolume = stats.QuoteVolume
+	ticker.Trades = stats.Trades
+	if stats.Trades == 0 {
+		return ticker, nil
+	}
+
+	// 窗口内第一笔成交
+	var first models.MarketTrade
+	err = repo.db.WithContext(ctx).
+		Where("tick = ? AND traded_at >= ?", tick, since).
+		Order("traded_at asc, id asc").
+		Take(&first).Error
+	if err != nil {
+		return nil, err
+	}
+	ticker.OpenPrice = first.Price
+
+	return ticker, nil
+}
+
+type candleMySQLRepo struct {
+	db *gorm.DB
+}
+
+func NewCandleRepo(db *gorm.DB) trade.CandleRepository {
+	return &candleMySQLRepo{db: db}
+}
+
+func (repo *candleMySQLRepo) Save(ctx context.Context, candles ...*trade.Candle) error {
+	if len(candles) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms = make([]*models.MarketCandle, 0, len(candles))
+	for _, entity := range candles {
+		ms = append(ms, acl.ConvertCandleEntityToModel(entity))
+	}
+
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: `tick`}, {Name: `interval`}, {Name: `open_time`}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			`open`,
+			`high`,
+			`low`,
+			`close`,
+			`volume`,
+			`quote_volume`,
+			`trades`,
+			`last_updated_block`,
+			`updated_at`,
+		}),
+	}).CreateInBatches(ms, 1000).Error
+}
+
+func (repo *candleMySQLRepo) Load(ctx context.Context, keys ...trade.CandleKey) ([]*trade.Candle, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	var conditions = make([][]interface{}, 0, len(keys))
+	for _, key := range keys {
+		conditions = append(conditions, []interface{}{key.Tick, string(key.Interval), key.OpenTime})
+	}
+
+	var ms []*models.MarketCandle
+	err := repo.db.WithContext(ctx).
+		Where("(tick, `interval`, open_time) IN ?", conditions).
+		Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make([]*trade.Candle, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, acl.ConvertCandleModelToEntity(m))
+	}
+
+	return result, nil
+}
+
+func (repo *candleMySQLRepo) Query(ctx context.Context, query *trade.CandleQuery) ([]*trade.Candle, error) {
+	db := repo.db.WithContext(ctx).Where("tick = ? AND `interval` = ?", query.Tick, string(query.Interval))
+	if !query.StartTime.IsZero() {
+		db = db.Where("open_time >= ?", query.StartTime.Unix())
+	}
+	if !query.EndTime.IsZero() {
+		db = db.Where("open_time <= ?", query.EndTime.Unix())
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > maxCandleLimit {
+		limit = maxCandleLimit
+	}
+
+	var ms []*models.MarketCandle
+	if err := db.Order("open_time desc").Limit(limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	// 倒序查询最近的K线, 按开盘时间正序返回
+	var result = make([]*trade.Candle, 0, len(ms))
+	for i := len(ms) - 1; i >= 0; i-- {
+		result = append(result, acl.ConvertCandleModelToEntity(ms[i]))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,87 @@
+package mysqlimpl
+
+import (
+	"context"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/vesting"
+	rctx "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/context"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type vestingMySQLRepo struct {
+	db *gorm.DB
+}
+
+func NewVestingRepo(db *gorm.DB) vesting.VestingRepository {
+	return &vestingMySQLRepo{db: db}
+}
+
+func (repo *vestingMySQLRepo) Load(ctx context.Context, key vesting.VestingKey) ([]*vesting.Vesting, error) {
+	var ms []*models.IERC20Vesting
+	err := repo.db.WithContext(ctx).
+		Where("recipient = ? and tick = ? and released < total", key.Recipient, key.Tick).
+		Order("id asc").
+		Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convertVestingModels(ms), nil
+}
+
+func (repo *vestingMySQLRepo) Query(ctx context.Context, from, recipient, tick string) ([]*vesting.Vesting, error) {
+	db := repo.db.WithContext(ctx)
+	if from != "" {
+		db = db.Where("`from` = ?", from)
+	}
+	if recipient != "" {
+		db = db.Where("recipient = ?", recipient)
+	}
+	if tick != "" {
+		db = db.Where("tick = ?", tick)
+	}
+
+	var ms []*models.IERC20Vesting
+	if err := db.Order("id asc").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	return convertVestingModels(ms), nil
+}
+
+func (repo *vestingMySQLRepo) Save(ctx context.Context, entities ...*vesting.Vesting) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	db := rctx.TransactionDBFromContext(ctx)
+	if db == nil {
+		panic("missing db instance")
+	}
+
+	var ms []*models.IERC20Vesting
+	for _, entity := range entities {
+		ms = append(ms, acl.ConvertVestingEntityToModel(entity))
+	}
+
+	// 锁仓创建后只有已领取数量会变化
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: `vest_id`}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			`released`,
+			`last_updated_block`,
+			`updated_at`,
+		}),
+	}).CreateInBatches(ms, 1000).Error
+}
+
+func convertVestingModels(ms []*models.IERC20Vesting) []*vesting.Vesting {
+	var entities = make([]*vesting.Vesting, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertVestingModelToEntity(m))
+	}
+	return entities
+}
@@ -0,0 +1,187 @@
+package mysqlimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/webhook"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/acl"
+	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 入队进度只有一条记录
+const webhookCursorID = 1
+
+type webhookRepo struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepo(db *gorm.DB) webhook.WebhookRepository {
+	return &webhookRepo{db: db}
+}
+
+func (repo *webhookRepo) Create(ctx context.Context, entity *webhook.Webhook) error {
+	m := acl.ConvertWebhookEntityToModel(entity)
+	if err := repo.db.WithContext(ctx).Create(m).Error; err != nil {
+		return err
+	}
+
+	entity.ID = m.ID
+	entity.CreatedAt = m.CreatedAt
+	entity.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+func (repo *webhookRepo) Update(ctx context.Context, entity *webhook.Webhook) error {
+	m := acl.ConvertWebhookEntityToModel(entity)
+	return repo.db.WithContext(ctx).
+		Model(m).
+		Select("url", "secret", "filter", "enabled", "updated_at").
+		Updates(m).
+		Error
+}
+
+func (repo *webhookRepo) Delete(ctx context.Context, id int64) error {
+	result := repo.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Webhook{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (repo *webhookRepo) Get(ctx context.Context, id int64) (*webhook.Webhook, error) {
+	var m models.Webhook
+	if err := repo.db.WithContext(ctx).Where("id = ?", id).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return acl.ConvertWebhookModelToEntity(&m), nil
+}
+
+func (repo *webhookRepo) List(ctx context.Context) ([]*webhook.Webhook, error) {
+	var ms []*models.Webhook
+	if err := repo.db.WithContext(ctx).Order("id").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	var entities = make([]*webhook.Webhook, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertWebhookModelToEntity(m))
+	}
+
+	return entities, nil
+}
+
+func (repo *webhookRepo) LoadCursor(ctx context.Context) (webhook.Cursor, error) {
+	var m models.WebhookCursor
+	if err := repo.db.WithContext(ctx).Where("id = ?", webhookCursorID).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return webhook.Cursor{}, nil
+		}
+		return webhook.Cursor{}, err
+	}
+
+	return webhook.Cursor{Cursor: m.Cursor, LastBlock: m.LastBlock}, nil
+}
+
+func (repo *webhookRepo) Enqueue(ctx context.Context, cursor webhook.Cursor, deliveries ...*webhook.Delivery) error {
+	return repo.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(deliveries) != 0 {
+			var ms = make([]*models.WebhookDelivery, 0, len(deliveries))
+			for _, entity := range deliveries {
+				ms = append(ms, acl.ConvertWebhookDeliveryEntityToModel(entity))
+			}
+
+			// 同一个区块对同一个回调地址只推送一次. 进度回退(例如进度保存失败)后重复入队时忽略
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(ms, 100).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"cursor", "last_block", "updated_at"}),
+		}).Create(&models.WebhookCursor{ID: webhookCursorID, Cursor: cursor.Cursor, LastBlock: cursor.LastBlock}).Error
+	})
+}
+
+func (repo *webhookRepo) FetchDue(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	var ms []*models.WebhookDelivery
+	err := repo.db.WithContext(ctx).
+		Where("status = ? and next_attempt_at <= ?", webhook.DeliveryPending, now).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&ms).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convertWebhookDeliveries(ms), nil
+}
+
+func (repo *webhookRepo) SaveDelivery(ctx context.Context, entity *webhook.Delivery) error {
+	m := acl.ConvertWebhookDeliveryEntityToModel(entity)
+	return repo.db.WithContext(ctx).
+		Model(m).
+		Select("status", "attempts", "next_attempt_at", "last_error", "delivered_at").
+		Updates(m).
+		Error
+}
+
+func (repo *webhookRepo) QueryDeliveries(ctx context.Context, query *webhook.DeliveryQuery) ([]*webhook.Delivery, error) {
+	db := repo.db.WithContext(ctx).Model(&models.WebhookDelivery{})
+	if query.WebhookID != 0 {
+		db = db.Where("webhook_id = ?", query.WebhookID)
+	}
+	if query.Status != 0 {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.Cursor != 0 {
+		db = db.Where("id < ?", query.Cursor)
+	}
+
+	var ms []*models.WebhookDelivery
+	if err := db.Order("id DESC").Limit(query.Limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+
+	return convertWebhookDeliveries(ms), nil
+}
+
+func (repo *webhookRepo) Replay(ctx context.Context, query *webhook.ReplayQuery, now time.Time) (int64, error) {
+	db := repo.db.WithContext(ctx).
+		Model(&models.WebhookDelivery{}).
+		Where("webhook_id = ?", query.WebhookID)
+
+	if len(query.IDs) != 0 {
+		db = db.Where("id in ? and status <> ?", query.IDs, webhook.DeliveryPending)
+	} else {
+		db = db.Where("status = ?", webhook.DeliveryDead)
+	}
+
+	result := db.Updates(map[string]any{
+		"status":          webhook.DeliveryPending,
+		"attempts":        0,
+		"next_attempt_at": now,
+	})
+
+	return result.RowsAffected, result.Error
+}
+
+func convertWebhookDeliveries(ms []*models.WebhookDelivery) []*webhook.Delivery {
+	var entities = make([]*webhook.Delivery, 0, len(ms))
+	for _, m := range ms {
+		entities = append(entities, acl.ConvertWebhookDeliveryModelToEntity(m))
+	}
+	return entities
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/kevin88886/eth_indexer/internal/conf"
 	"github.com/kevin88886/eth_indexer/internal/domain"
+	"github.com/kevin88886/eth_indexer/internal/domain/address"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol"
 	"github.com/kevin88886/eth_indexer/internal/domain/protocol/parser"
 	"github.com/shopspring/decimal"
@@ -126,14 +127,14 @@ func (e *EthereumFetcher) parseBlock(block *types.Block) (*domain.Block, error)
 		// TODO: z
 		to := protocol.ZeroAddress
 		if tx.To() != nil {
-			to = tx.To().String()
+			to = address.FromCommon(*tx.To()).String()
 		}
 
 		transactions = append(transactions, &domain.Transaction{
 			BlockNumber:     block.NumberU64(),
 			PositionInTxs:   int64(position),
 			Hash:            tx.Hash().String(),
-			From:            from.String(),
+			From:            address.FromCommon(from).String(),
 			To:              to,
 			TxData:          string(tx.Data()),
 			TxValue:         decimal.NewFromBigInt(tx.Value(), 0),
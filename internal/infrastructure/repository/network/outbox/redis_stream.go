@@ -0,0 +1,86 @@
+package outbox
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// stream 名称的默认前缀
+	defaultStreamPrefix = "eth_indexer:"
+	// 单次请求的默认超时时间
+	defaultRedisTimeout = time.Second * 5
+)
+
+// 发布到 Redis Streams. 每条消息写入一个 entry, 字段为 id、block_number、payload、created_at.
+// 接收方按 id 去重, 整批重试时已经写入的消息会重复写入
+type RedisStreamPublisher struct {
+	client *redis.Client
+	prefix string
+	maxLen int64
+}
+
+func NewRedisStreamPublisher(c *conf.Data_Outbox_RedisStream) (*RedisStreamPublisher, func(), error) {
+	timeout := defaultRedisTimeout
+	if c.GetTimeout() != nil {
+		timeout = c.GetTimeout().AsDuration()
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:         c.GetAddr(),
+		Username:     c.GetUsername(),
+		Password:     c.GetPassword(),
+		DB:           int(c.GetDb()),
+		DialTimeout:  timeout,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+	})
+
+	// 启动时检查连接, 配置错误时尽早失败
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, nil, err
+	}
+
+	prefix := c.GetStreamPrefix()
+	if prefix == "" {
+		prefix = defaultStreamPrefix
+	}
+
+	cleanup := func() {
+		_ = client.Close()
+	}
+
+	return &RedisStreamPublisher{client: client, prefix: prefix, maxLen: c.GetMaxLen()}, cleanup, nil
+}
+
+func (p *RedisStreamPublisher) Name() string { return "redis_stream" }
+
+// Publish 在一个 MULTI/EXEC 中按顺序写入整批消息
+func (p *RedisStreamPublisher) Publish(ctx context.Context, messages []*outbox.Message) error {
+	_, err := p.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, message := range messages {
+			pipe.XAdd(ctx, &redis.XAddArgs{
+				Stream: p.prefix + message.Topic,
+				MaxLen: p.maxLen,
+				Approx: p.maxLen > 0,
+				Values: map[string]interface{}{
+					"id":           strconv.FormatInt(message.ID, 10),
+					"block_number": strconv.FormatUint(message.BlockNumber, 10),
+					"payload":      message.Payload,
+					"created_at":   strconv.FormatInt(message.CreatedAt.UnixMilli(), 10),
+				},
+			})
+		}
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/kevin88886/eth_indexer/internal/conf"
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRedisStreamPublisher(t *testing.T) {
+	suite.Run(t, new(TestRedisStreamPublisherSuite))
+}
+
+type TestRedisStreamPublisherSuite struct {
+	suite.Suite
+	server *miniredis.Miniredis
+}
+
+func (s *TestRedisStreamPublisherSuite) SetupTest() {
+	s.server = miniredis.RunT(s.T())
+}
+
+func (s *TestRedisStreamPublisherSuite) TestPublish() {
+	publisher, cleanup, err := NewRedisStreamPublisher(&conf.Data_Outbox_RedisStream{Addr: s.server.Addr()})
+	s.Require().NoError(err)
+	defer cleanup()
+
+	createdAt := time.UnixMilli(1700000000000)
+	err = publisher.Publish(context.Background(), []*outbox.Message{
+		{ID: 1, Topic: outbox.TopicEvents, BlockNumber: 100, Payload: []byte(`{"block":100}`), CreatedAt: createdAt},
+		{ID: 2, Topic: outbox.TopicEvents, BlockNumber: 101, Payload: []byte(`{"block":101}`), CreatedAt: createdAt},
+	})
+	s.Require().NoError(err)
+
+	// 按顺序写入默认前缀的 stream
+	entries, err := s.server.Stream(defaultStreamPrefix + outbox.TopicEvents)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Equal([]string{"id", "1", "block_number", "100", "payload", `{"block":100}`, "created_at", "1700000000000"}, sortedValues(entries[0].Values))
+	s.Equal([]string{"id", "2", "block_number", "101", "payload", `{"block":101}`, "created_at", "1700000000000"}, sortedValues(entries[1].Values))
+}
+
+func (s *TestRedisStreamPublisherSuite) TestConnectFailed() {
+	addr := s.server.Addr()
+	s.server.Close()
+
+	_, _, err := NewRedisStreamPublisher(&conf.Data_Outbox_RedisStream{Addr: addr})
+	s.Error(err)
+}
+
+// XADD 的字段顺序不固定, 按 id、block_number、payload、created_at 排列
+func sortedValues(values []string) []string {
+	fields := make(map[string]string, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		fields[values[i]] = values[i+1]
+	}
+
+	var result []string
+	for _, key := range []string{"id", "block_number", "payload", "created_at"} {
+		result = append(result, key, fields[key])
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/webhook"
+)
+
+// 单次推送的超时时间
+const sendTimeout = time.Second * 10
+
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender() webhook.Sender {
+	return newHTTPSender(webhook.IsPublicIP)
+}
+
+// allowIP 在建立连接时检查实际连接的地址, 避免注册后域名被解析到内网地址.
+// 不使用环境变量中的代理, 否则检查的是代理的地址
+func newHTTPSender(allowIP func(ip net.IP) bool) *HTTPSender {
+	dialer := &net.Dialer{
+		Timeout: sendTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !allowIP(ip) {
+				return fmt.Errorf("%w: non-public address %s", webhook.ErrInvalidURL, host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPSender{client: &http.Client{
+		Timeout:   sendTimeout,
+		Transport: transport,
+		// 不跟随重定向, 重定向响应按失败处理
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send 以 POST 推送请求体, 响应 2xx 视为成功
+func (s *HTTPSender) Send(ctx context.Context, target *webhook.Webhook, delivery *webhook.Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(target.Secret, time.Now().Unix(), delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// 读完响应体以复用连接, 只保留一部分用于记录失败原因
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kevin88886/eth_indexer/internal/domain/webhook"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHTTPSender(t *testing.T) {
+	suite.Run(t, new(TestHTTPSenderSuite))
+}
+
+type TestHTTPSenderSuite struct {
+	suite.Suite
+}
+
+func (s *TestHTTPSenderSuite) TestSend() {
+	var (
+		target   = &webhook.Webhook{Secret: "secret"}
+		delivery = &webhook.Delivery{ID: 7, WebhookID: 1, BlockNumber: 100, Payload: []byte(`{"block_number":100}`)}
+		statuses = []int{http.StatusInternalServerError, http.StatusOK}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.NoError(err)
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal(delivery.Payload, body)
+		s.Equal("7", r.Header.Get(webhook.DeliveryHeader))
+		s.True(webhook.Verify(target.Secret, r.Header.Get(webhook.SignatureHeader), body, time.Now()))
+
+		w.WriteHeader(statuses[0])
+		statuses = statuses[1:]
+	}))
+	defer server.Close()
+
+	target.URL = server.URL
+	sender := newHTTPSender(func(net.IP) bool { return true })
+
+	// 非 2xx 视为失败
+	err := sender.Send(context.Background(), target, delivery)
+	s.ErrorContains(err, "unexpected status: 500")
+
+	s.NoError(sender.Send(context.Background(), target, delivery))
+	s.Empty(statuses)
+}
+
+func (s *TestHTTPSenderSuite) TestRedirect() {
+	var redirected bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			redirected = true
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusFound)
+	}))
+	defer server.Close()
+
+	// 重定向不跟随, 按失败处理
+	sender := newHTTPSender(func(net.IP) bool { return true })
+	err := sender.Send(context.Background(), &webhook.Webhook{URL: server.URL, Secret: "secret"}, &webhook.Delivery{ID: 1})
+	s.ErrorContains(err, "unexpected status: 302")
+	s.False(redirected)
+}
+
+func (s *TestHTTPSenderSuite) TestNonPublicAddress() {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	// 建立连接时拒绝回环地址
+	err := NewHTTPSender().Send(context.Background(), &webhook.Webhook{URL: server.URL, Secret: "secret"}, &webhook.Delivery{ID: 1})
+	s.ErrorIs(err, webhook.ErrInvalidURL)
+	s.False(called)
+}
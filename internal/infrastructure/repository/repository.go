@@ -2,14 +2,20 @@ package repository
 
 import (
 	"github.com/allegro/bigcache"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"github.com/kevin88886/eth_indexer/internal/conf"
 	"github.com/kevin88886/eth_indexer/internal/domain/balance"
-	"github.com/kevin88886/eth_indexer/internal/domain/protocol/parser"
+	"github.com/kevin88886/eth_indexer/internal/domain/holder"
+	"github.com/kevin88886/eth_indexer/internal/domain/module"
+	"github.com/kevin88886/eth_indexer/internal/domain/outbox"
 	"github.com/kevin88886/eth_indexer/internal/domain/staking"
 	"github.com/kevin88886/eth_indexer/internal/domain/tick"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/memory"
 	mysqlimpl "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/mysql"
 	"github.com/kevin88886/eth_indexer/internal/infrastructure/repository/network/ethereum"
+	outboxpublisher "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/network/outbox"
+	webhooksender "github.com/kevin88886/eth_indexer/internal/infrastructure/repository/network/webhook"
 	"gorm.io/gorm"
 )
 
@@ -25,13 +31,37 @@ var ProviderSet = wire.NewSet(
 	NewBalanceRepository,
 	NewEventRepository,
 	NewStakingRepository,
+	NewInvalidTxRepository,
+	NewAllowanceRepository,
+	NewVestingRepository,
+	NewRewardsRecordRepository,
+	NewMiningStatsRepository,
+	NewOrderRepository,
+	NewTradeRepository,
+	NewCandleRepository,
+	NewHolderRepository,
+	NewWebhookRepository,
+	NewWebhookSender,
+	NewOutboxRepository,
+	NewOutboxPublishers,
 )
 
 var (
-	NewProtocolParser  = parser.NewParser
-	NewEthereumFetcher = ethereum.NewEthereumFetcher
-	NewBlockRepository = mysqlimpl.NewBlockRepo
-	NewEventRepository = mysqlimpl.NewEventRepository
+	NewProtocolParser          = module.NewParser
+	NewEthereumFetcher         = ethereum.NewEthereumFetcher
+	NewBlockRepository         = mysqlimpl.NewBlockRepo
+	NewEventRepository         = mysqlimpl.NewEventRepository
+	NewInvalidTxRepository     = mysqlimpl.NewInvalidTxRepo
+	NewAllowanceRepository     = mysqlimpl.NewAllowanceRepo
+	NewVestingRepository       = mysqlimpl.NewVestingRepo
+	NewRewardsRecordRepository = mysqlimpl.NewRewardsRecordRepo
+	NewMiningStatsRepository   = mysqlimpl.NewMiningStatsRepo
+	NewOrderRepository         = mysqlimpl.NewOrderRepo
+	NewTradeRepository         = mysqlimpl.NewTradeRepo
+	NewCandleRepository        = mysqlimpl.NewCandleRepo
+	NewWebhookRepository       = mysqlimpl.NewWebhookRepo
+	NewWebhookSender           = webhooksender.NewHTTPSender
+	NewOutboxRepository        = mysqlimpl.NewOutboxRepo
 )
 
 func NewTickRepository(db *gorm.DB, cache *bigcache.BigCache) tick.TickRepository {
@@ -42,6 +72,41 @@ func NewBalanceRepository(db *gorm.DB, cache *bigcache.BigCache) balance.Balance
 	return memory.NewBalanceMemoryRepository(mysqlimpl.NewBalanceRepo(db), cache)
 }
 
+func NewHolderRepository(db *gorm.DB) holder.HolderRepository {
+	return memory.NewHolderMemoryRepository(mysqlimpl.NewHolderRepo(db))
+}
+
 func NewStakingRepository(db *gorm.DB) (staking.StakingRepository, error) {
 	return memory.NewStakingMemoryRepository(mysqlimpl.NewStakingRepository(db))
 }
+
+// 发件箱的消息发布方, 按配置注册. 没有发布方时不写入发件箱, 也不启动中继.
+// 接入其他消息队列时在这里注册
+func NewOutboxPublishers(c *conf.Config, l log.Logger) ([]outbox.Publisher, func(), error) {
+	var (
+		helper     = log.NewHelper(l)
+		config     = c.Bootstrap.GetData().GetOutbox()
+		publishers []outbox.Publisher
+		cleanups   []func()
+	)
+
+	cleanup := func() {
+		for _, fn := range cleanups {
+			fn()
+		}
+	}
+
+	if stream := config.GetRedisStream(); stream.GetAddr() != "" {
+		helper.Infof("initial outbox publisher: redis stream. addr: %s", stream.GetAddr())
+		publisher, fn, err := outboxpublisher.NewRedisStreamPublisher(stream)
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+
+		publishers = append(publishers, publisher)
+		cleanups = append(cleanups, fn)
+	}
+
+	return publishers, cleanup, nil
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var ErrUnauthorized = errors.Unauthorized("UNAUTHORIZED", "invalid token")
+
+// 校验请求头中的访问令牌: Authorization: Bearer <token>. token 为空时不校验
+func BearerToken(token string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if token == "" {
+				return handler(ctx, req)
+			}
+
+			ts, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrUnauthorized
+			}
+
+			got, ok := strings.CutPrefix(ts.RequestHeader().Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				return nil, ErrUnauthorized
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}